@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	admissioncmd "github.com/gardener/gardener-extension-provider-aws/pkg/admission/cmd"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/validator"
 	awsinstall "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/install"
 	provideraws "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
@@ -56,8 +57,10 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 		webhookServerOptions = &webhookcmd.ServerOptions{
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
 		}
-		webhookSwitches = admissioncmd.GardenWebhookSwitchOptions()
-		webhookOptions  = webhookcmd.NewAddToManagerOptions(
+		accessKeyAgeOpts = &admissioncmd.AccessKeyAgeOptions{}
+		permissionOpts   = &admissioncmd.PermissionCheckOptions{}
+		webhookSwitches  = admissioncmd.GardenWebhookSwitchOptions()
+		webhookOptions   = webhookcmd.NewAddToManagerOptions(
 			AdmissionName,
 			"",
 			nil,
@@ -69,6 +72,8 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 		aggOption = controllercmd.NewOptionAggregator(
 			restOpts,
 			mgrOpts,
+			accessKeyAgeOpts,
+			permissionOpts,
 			webhookOptions,
 		)
 	)
@@ -150,6 +155,9 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				}
 			}
 
+			accessKeyAgeOpts.Completed().Apply(&validator.DefaultAccessKeyAgeOptions)
+			permissionOpts.Completed().Apply(&validator.DefaultPermissionCheckOptions)
+
 			log.Info("Setting up webhook server")
 			if _, err := webhookOptions.Completed().AddToManager(ctx, mgr, sourceCluster); err != nil {
 				return err
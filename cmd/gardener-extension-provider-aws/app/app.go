@@ -38,6 +38,7 @@ import (
 
 	awsinstall "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/install"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	awscmd "github.com/gardener/gardener-extension-provider-aws/pkg/cmd"
 	awsbackupbucket "github.com/gardener/gardener-extension-provider-aws/pkg/controller/backupbucket"
 	awsbackupentry "github.com/gardener/gardener-extension-provider-aws/pkg/controller/backupentry"
@@ -46,6 +47,7 @@ import (
 	awsdnsrecord "github.com/gardener/gardener-extension-provider-aws/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/healthcheck"
 	awsinfrastructure "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure"
+	awsloadbalancercleanup "github.com/gardener/gardener-extension-provider-aws/pkg/controller/loadbalancercleanup"
 	awsworker "github.com/gardener/gardener-extension-provider-aws/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/features"
 	awsseedprovider "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/seedprovider"
@@ -115,6 +117,14 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		}
 		reconcileOpts = &controllercmd.ReconcilerOptions{}
 
+		// options for the load balancer cleanup controller
+		loadBalancerCleanupCtrlOpts = &awscmd.LoadBalancerCleanupControllerOptions{
+			ControllerOptions: controllercmd.ControllerOptions{
+				MaxConcurrentReconciles: 5,
+			},
+			DrainPeriod: awsloadbalancercleanup.DefaultAddOptions.DrainPeriod,
+		}
+
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
@@ -146,6 +156,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("dnsrecord-", dnsRecordCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
+			controllercmd.PrefixOption("loadbalancercleanup-", loadBalancerCleanupCtrlOpts),
 			controllercmd.PrefixOption("worker-", workerCtrlOpts),
 			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
 			controllercmd.PrefixOption("heartbeat-", heartbeatCtrlOpts),
@@ -212,6 +223,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			// add common meta types to schema for controller-runtime to use v1.ListOptions
 			metav1.AddToGroupVersion(scheme, machinev1alpha1.SchemeGroupVersion)
 
+			if err := configFileOpts.Completed().SetupTracing(ctx, mgr); err != nil {
+				return fmt.Errorf("could not set up tracing: %w", err)
+			}
+
 			log := mgr.GetLogger()
 			log.Info("Getting rest config for garden")
 			gardenRESTConfig, err := kubernetes.RESTConfigFromKubeconfigFile(os.Getenv("GARDEN_KUBECONFIG"), kubernetes.AuthTokenFile)
@@ -236,6 +251,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			log.Info("Adding controllers to manager")
 			configFileOpts.Completed().ApplyETCDStorage(&awsseedprovider.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
+			configFileOpts.Completed().ApplyAccessKeyRotation(&healthcheck.DefaultAddOptions.AccessKeyRotation)
+			configFileOpts.Completed().ApplyAPIRateLimiting(&awsclient.DefaultAPIRateLimiterOptions)
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&awsbackupbucket.DefaultAddOptions.Controller)
@@ -252,6 +269,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			reconcileOpts.Completed().Apply(&awsbackupbucket.DefaultAddOptions.IgnoreOperationAnnotation, &awsbackupbucket.DefaultAddOptions.ExtensionClasses)
 			reconcileOpts.Completed().Apply(&awsbackupentry.DefaultAddOptions.IgnoreOperationAnnotation, &awsbackupentry.DefaultAddOptions.ExtensionClasses)
 			reconcileOpts.Completed().Apply(&awsdnsrecord.DefaultAddOptions.IgnoreOperationAnnotation, &awsdnsrecord.DefaultAddOptions.ExtensionClasses)
+			reconcileOpts.Completed().Apply(nil, &awsloadbalancercleanup.DefaultAddOptions.ExtensionClasses)
+			loadBalancerCleanupCtrlOpts.Completed().Apply(&awsloadbalancercleanup.DefaultAddOptions)
 			workerCtrlOpts.Completed().Apply(&awsworker.DefaultAddOptions.Controller)
 			awsworker.DefaultAddOptions.GardenCluster = gardenCluster
 			awsworker.DefaultAddOptions.SelfHostedShootCluster = generalOpts.Completed().SelfHostedShootCluster
@@ -5,7 +5,10 @@
 package cmd
 
 import (
+	"time"
+
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	"github.com/spf13/pflag"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/mutator"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/validator"
@@ -19,3 +22,103 @@ func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(mutator.Name, mutator.New),
 	)
 }
+
+const (
+	// AccessKeyMaxAgeFlag is the name of the command line flag to specify the maximum age of static access keys
+	// referenced by new SecretBindings and CredentialsBindings.
+	AccessKeyMaxAgeFlag = "access-key-max-age"
+	// AccessKeyWarningPeriodFlag is the name of the command line flag to specify the period before the maximum age in
+	// which a warning is returned for static access keys referenced by new SecretBindings and CredentialsBindings.
+	AccessKeyWarningPeriodFlag = "access-key-warning-period"
+	// RejectExpiredAccessKeysFlag is the name of the command line flag to reject new SecretBindings and
+	// CredentialsBindings referring to static access keys older than the maximum age.
+	RejectExpiredAccessKeysFlag = "reject-expired-access-keys"
+)
+
+// AccessKeyAgeOptions are command line options for the validation of the age of static access keys.
+type AccessKeyAgeOptions struct {
+	// MaxAge is the maximum age of static access keys referenced by new SecretBindings and CredentialsBindings.
+	MaxAge time.Duration
+	// WarningPeriod is the period before the maximum age in which a warning is returned.
+	WarningPeriod time.Duration
+	// Reject specifies whether SecretBindings and CredentialsBindings referring to expired access keys are rejected.
+	Reject bool
+
+	config *AccessKeyAgeConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *AccessKeyAgeOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&o.MaxAge, AccessKeyMaxAgeFlag, o.MaxAge, "Warn about new SecretBindings and CredentialsBindings referring to static access keys older than this age. Zero disables the check.")
+	fs.DurationVar(&o.WarningPeriod, AccessKeyWarningPeriodFlag, 14*24*time.Hour, "Warn about new SecretBindings and CredentialsBindings referring to static access keys which reach the maximum age within this period.")
+	fs.BoolVar(&o.Reject, RejectExpiredAccessKeysFlag, o.Reject, "Reject new SecretBindings and CredentialsBindings referring to static access keys older than the maximum age instead of only warning about them.")
+}
+
+// Complete implements Completer.Complete.
+func (o *AccessKeyAgeOptions) Complete() error {
+	o.config = &AccessKeyAgeConfig{MaxAge: o.MaxAge, WarningPeriod: o.WarningPeriod, Reject: o.Reject}
+	return nil
+}
+
+// Completed returns the completed AccessKeyAgeConfig. Only call this if `Complete` was successful.
+func (o *AccessKeyAgeOptions) Completed() *AccessKeyAgeConfig {
+	return o.config
+}
+
+// AccessKeyAgeConfig is a completed access key age configuration.
+type AccessKeyAgeConfig struct {
+	// MaxAge is the maximum age of static access keys referenced by new SecretBindings and CredentialsBindings.
+	MaxAge time.Duration
+	// WarningPeriod is the period before the maximum age in which a warning is returned.
+	WarningPeriod time.Duration
+	// Reject specifies whether SecretBindings and CredentialsBindings referring to expired access keys are rejected.
+	Reject bool
+}
+
+// Apply sets the values of this AccessKeyAgeConfig in the given validator.AccessKeyAgeOptions.
+func (c *AccessKeyAgeConfig) Apply(opts *validator.AccessKeyAgeOptions) {
+	opts.MaxAge = c.MaxAge
+	opts.WarningPeriod = c.WarningPeriod
+	opts.Reject = c.Reject
+}
+
+// CheckCredentialsPermissionsFlag is the name of the command line flag to enable the permission check of the static
+// credentials referenced by new SecretBindings and CredentialsBindings.
+const CheckCredentialsPermissionsFlag = "check-credentials-permissions"
+
+// PermissionCheckOptions are command line options for the permission check of static credentials.
+type PermissionCheckOptions struct {
+	// Enabled enables the permission check of static credentials referenced by new SecretBindings and
+	// CredentialsBindings.
+	Enabled bool
+
+	config *PermissionCheckConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *PermissionCheckOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enabled, CheckCredentialsPermissionsFlag, o.Enabled, "Reject new SecretBindings and CredentialsBindings referring to static credentials which are not permitted to perform the actions needed by every infrastructure. Requires the iam:SimulatePrincipalPolicy permission, the check is skipped for credentials without it.")
+}
+
+// Complete implements Completer.Complete.
+func (o *PermissionCheckOptions) Complete() error {
+	o.config = &PermissionCheckConfig{Enabled: o.Enabled}
+	return nil
+}
+
+// Completed returns the completed PermissionCheckConfig. Only call this if `Complete` was successful.
+func (o *PermissionCheckOptions) Completed() *PermissionCheckConfig {
+	return o.config
+}
+
+// PermissionCheckConfig is a completed permission check configuration.
+type PermissionCheckConfig struct {
+	// Enabled enables the permission check of static credentials referenced by new SecretBindings and
+	// CredentialsBindings.
+	Enabled bool
+}
+
+// Apply sets the values of this PermissionCheckConfig in the given validator.PermissionCheckOptions.
+func (c *PermissionCheckConfig) Apply(opts *validator.PermissionCheckOptions) {
+	opts.Enabled = c.Enabled
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
@@ -167,9 +168,26 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 		return err
 	}
 
+	infraConfigModified := false
 	if infraConfig.EnableMTUCustomizer == nil && oldShoot == nil {
 		// New cluster: default to false
 		infraConfig.EnableMTUCustomizer = ptr.To(false)
+		infraConfigModified = true
+	}
+
+	if egress := infraConfig.Networks.Egress; egress != nil && egress.Mode == awsv1alpha1.EgressModeSingleNATGateway && egress.NATGatewayZone == nil && len(infraConfig.Networks.Zones) > 0 {
+		var oldInfraConfig *awsv1alpha1.InfrastructureConfig
+		if oldShoot != nil {
+			oldInfraConfig, err = s.decodeInfrastructureConfig(oldShoot.Spec.Provider.InfrastructureConfig)
+			if err != nil {
+				return err
+			}
+		}
+		egress.NATGatewayZone = ptr.To(natGatewayZone(infraConfig, oldInfraConfig))
+		infraConfigModified = true
+	}
+
+	if infraConfigModified {
 		shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
 			Object: infraConfig,
 		}
@@ -178,6 +196,21 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 	return nil
 }
 
+// natGatewayZone returns the zone of the single NAT gateway. The zone in which the NAT gateway is already running is
+// kept, so that adding zones does not move the NAT gateway and disrupt the egress traffic.
+func natGatewayZone(infraConfig, oldInfraConfig *awsv1alpha1.InfrastructureConfig) string {
+	if oldInfraConfig != nil && oldInfraConfig.Networks.Egress != nil && oldInfraConfig.Networks.Egress.Mode == awsv1alpha1.EgressModeSingleNATGateway {
+		oldZone := ptr.Deref(oldInfraConfig.Networks.Egress.NATGatewayZone, "")
+		if oldZone == "" && len(oldInfraConfig.Networks.Zones) > 0 {
+			oldZone = oldInfraConfig.Networks.Zones[0].Name
+		}
+		if slices.ContainsFunc(infraConfig.Networks.Zones, func(zone awsv1alpha1.Zone) bool { return zone.Name == oldZone }) {
+			return oldZone
+		}
+	}
+	return infraConfig.Networks.Zones[0].Name
+}
+
 func (s *shoot) decodeControlplaneConfig(controlPlaneConfig *runtime.RawExtension) (*awsv1alpha1.ControlPlaneConfig, error) {
 	cp := &awsv1alpha1.ControlPlaneConfig{
 		TypeMeta: metav1.TypeMeta{
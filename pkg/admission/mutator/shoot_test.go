@@ -7,6 +7,7 @@ package mutator_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
@@ -400,5 +401,49 @@ var _ = Describe("Shoot mutator", func() {
 				Expect(shoot.Spec.Provider.InfrastructureConfig.Raw).NotTo(BeNil())
 			})
 		})
+
+		Context("Mutate InfrastructureConfig NATGatewayZone default", func() {
+			infraConfigWithEgress := func(egress string, zones ...string) *runtime.RawExtension {
+				var zonesJSON []string
+				for _, zone := range zones {
+					zonesJSON = append(zonesJSON, fmt.Sprintf(`{"name":%q,"workers":"10.250.0.0/19","public":"10.250.32.0/20","internal":"10.250.48.0/20"}`, zone))
+				}
+				return &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vpc":{"cidr":"10.250.0.0/16"},"zones":[%s],"egress":%s},"enableMTUCustomizer":false}`, strings.Join(zonesJSON, ","), egress)),
+				}
+			}
+
+			natGatewayZone := func() *string {
+				infra, ok := shoot.Spec.Provider.InfrastructureConfig.Object.(*awsv1alpha1.InfrastructureConfig)
+				Expect(ok).To(BeTrue())
+				return infra.Networks.Egress.NATGatewayZone
+			}
+
+			It("should default the NAT gateway zone to the first zone for a new shoot", func() {
+				shoot.Spec.Provider.InfrastructureConfig = infraConfigWithEgress(`{"mode":"SingleNATGateway"}`, "eu-west-1a", "eu-west-1b")
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(natGatewayZone()).To(Equal(ptr.To("eu-west-1a")))
+			})
+
+			It("should keep the zone of the running NAT gateway for an existing shoot", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = infraConfigWithEgress(`{"mode":"SingleNATGateway"}`, "eu-west-1b")
+				shoot.Spec.Provider.InfrastructureConfig = infraConfigWithEgress(`{"mode":"SingleNATGateway"}`, "eu-west-1a", "eu-west-1b")
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(natGatewayZone()).To(Equal(ptr.To("eu-west-1b")))
+			})
+
+			It("should default the NAT gateway zone when switching to a single NAT gateway", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = infraConfigWithEgress(`{"mode":"NATGatewayPerZone"}`, "eu-west-1b", "eu-west-1a")
+				shoot.Spec.Provider.InfrastructureConfig = infraConfigWithEgress(`{"mode":"SingleNATGateway"}`, "eu-west-1b", "eu-west-1a")
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(natGatewayZone()).To(Equal(ptr.To("eu-west-1b")))
+			})
+
+			It("should not overwrite an explicitly set NAT gateway zone", func() {
+				shoot.Spec.Provider.InfrastructureConfig = infraConfigWithEgress(`{"mode":"SingleNATGateway","natGatewayZone":"eu-west-1b"}`, "eu-west-1a", "eu-west-1b")
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.InfrastructureConfig.Raw).NotTo(BeNil())
+			})
+		})
 	})
 })
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// AccessKeyAgeOptions are the options for warning about or rejecting new SecretBindings and CredentialsBindings which
+// refer to static access keys nearing or exceeding their maximum age.
+type AccessKeyAgeOptions struct {
+	// MaxAge is the maximum age of the access keys. The check is disabled if it is zero.
+	MaxAge time.Duration
+	// WarningPeriod is the period before the maximum age in which a warning is returned.
+	WarningPeriod time.Duration
+	// Reject specifies whether expired access keys are rejected. Otherwise, only a warning is returned.
+	Reject bool
+	// ClientFactory creates the AWS clients used to read the metadata of the access keys.
+	ClientFactory awsclient.Factory
+	// Clock is the clock used to determine the age of the access keys.
+	Clock clock.PassiveClock
+}
+
+// DefaultAccessKeyAgeOptions are the options used by the SecretBinding and CredentialsBinding validators.
+var DefaultAccessKeyAgeOptions = AccessKeyAgeOptions{
+	ClientFactory: awsclient.FactoryFunc(awsclient.NewInterface),
+	Clock:         clock.RealClock{},
+}
+
+// validateAccessKeyAge adds a warning if the static access key in the given secret reaches the maximum age within the
+// warning period or is older. It returns an error instead if expired access keys are rejected.
+func (o AccessKeyAgeOptions) validateAccessKeyAge(ctx context.Context, secret *corev1.Secret) error {
+	if o.MaxAge == 0 {
+		return nil
+	}
+
+	authConfig, err := aws.ReadCredentialsSecret(secret, false, "")
+	if err != nil {
+		return err
+	}
+	age, err := aws.GetAccessKeyAge(ctx, o.ClientFactory, *authConfig, o.MaxAge, o.Clock.Now())
+	if err != nil {
+		return err
+	}
+	switch {
+	case age == nil:
+	case age.Expired() && o.Reject:
+		return fmt.Errorf("%s and is older than the maximum age of %s, it must be rotated", age, o.MaxAge)
+	case age.Expired():
+		AddWarning(ctx, fmt.Sprintf("%s and is older than the maximum age of %s, it must be rotated", age, o.MaxAge))
+	case age.ExpiresWithin(o.WarningPeriod):
+		AddWarning(ctx, fmt.Sprintf("%s and reaches the maximum age of %s in %d days, it should be rotated", age, o.MaxAge, int(age.Remaining.Hours()/24)))
+	}
+	return nil
+}
@@ -22,13 +22,17 @@ import (
 )
 
 type credentialsBinding struct {
-	apiReader client.Reader
+	apiReader    client.Reader
+	accessKeyAge AccessKeyAgeOptions
+	permissions  PermissionCheckOptions
 }
 
 // NewCredentialsBindingValidator returns a new instance of a credentials binding validator.
 func NewCredentialsBindingValidator(mgr manager.Manager) extensionswebhook.Validator {
 	return &credentialsBinding{
-		apiReader: mgr.GetAPIReader(),
+		apiReader:    mgr.GetAPIReader(),
+		accessKeyAge: DefaultAccessKeyAgeOptions,
+		permissions:  DefaultPermissionCheckOptions,
 	}
 }
 
@@ -59,7 +63,13 @@ func (cb *credentialsBinding) Validate(ctx context.Context, newObj, oldObj clien
 			return err
 		}
 
-		return awsvalidation.ValidateCloudProviderSecret(secret, field.NewPath("secret")).ToAggregate()
+		if err := awsvalidation.ValidateCloudProviderSecret(secret, field.NewPath("secret")).ToAggregate(); err != nil {
+			return err
+		}
+		if err := cb.accessKeyAge.validateAccessKeyAge(ctx, secret); err != nil {
+			return err
+		}
+		return cb.permissions.validatePermissions(ctx, secret)
 	case credentialsBinding.CredentialsRef.APIVersion == securityv1alpha1.SchemeGroupVersion.String() && credentialsBinding.CredentialsRef.Kind == "WorkloadIdentity":
 		workloadIdentity := &securityv1alpha1.WorkloadIdentity{}
 		if err := cb.apiReader.Get(ctx, credentialsKey, workloadIdentity); err != nil {
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// PermissionCheckOptions are the options for rejecting new SecretBindings and CredentialsBindings which refer to
+// static credentials missing permissions needed by every infrastructure.
+type PermissionCheckOptions struct {
+	// Enabled enables the check.
+	Enabled bool
+	// ClientFactory creates the AWS clients used to simulate the policies of the credentials.
+	ClientFactory awsclient.Factory
+}
+
+// DefaultPermissionCheckOptions are the options used by the SecretBinding and CredentialsBinding validators.
+var DefaultPermissionCheckOptions = PermissionCheckOptions{
+	ClientFactory: awsclient.FactoryFunc(awsclient.NewInterface),
+}
+
+// validatePermissions returns an error if the static credentials in the given secret are not permitted to perform the
+// actions needed by every infrastructure. Actions of optional features are checked when the infrastructure is
+// reconciled, as the binding may be used by shoots with different configurations.
+func (o PermissionCheckOptions) validatePermissions(ctx context.Context, secret *corev1.Secret) error {
+	if !o.Enabled {
+		return nil
+	}
+
+	authConfig, err := aws.ReadCredentialsSecret(secret, false, "")
+	if err != nil {
+		return err
+	}
+	return aws.CheckCredentialsPermissions(ctx, o.ClientFactory, *authConfig, aws.FeatureCommon)
+}
@@ -20,13 +20,17 @@ import (
 )
 
 type secretBinding struct {
-	apiReader client.Reader
+	apiReader    client.Reader
+	accessKeyAge AccessKeyAgeOptions
+	permissions  PermissionCheckOptions
 }
 
 // NewSecretBindingValidator returns a new instance of a secret binding validator.
 func NewSecretBindingValidator(mgr manager.Manager) extensionswebhook.Validator {
 	return &secretBinding{
-		apiReader: mgr.GetAPIReader(),
+		apiReader:    mgr.GetAPIReader(),
+		accessKeyAge: DefaultAccessKeyAgeOptions,
+		permissions:  DefaultPermissionCheckOptions,
 	}
 }
 
@@ -59,5 +63,11 @@ func (sb *secretBinding) Validate(ctx context.Context, newObj, oldObj client.Obj
 		return err
 	}
 
-	return awsvalidation.ValidateCloudProviderSecret(secret, field.NewPath("secret")).ToAggregate()
+	if err := awsvalidation.ValidateCloudProviderSecret(secret, field.NewPath("secret")).ToAggregate(); err != nil {
+		return err
+	}
+	if err := sb.accessKeyAge.validateAccessKeyAge(ctx, secret); err != nil {
+		return err
+	}
+	return sb.permissions.validatePermissions(ctx, secret)
 }
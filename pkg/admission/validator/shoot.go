@@ -27,6 +27,7 @@ import (
 	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	awsvalidation "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 var (
@@ -162,6 +163,23 @@ func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.S
 		}
 	}
 
+	if oldShoot.Spec.Provider.ControlPlaneConfig != nil {
+		oldControlPlaneConfig, err := decodeControlPlaneConfig(s.lenientDecoder, oldShoot.Spec.Provider.ControlPlaneConfig, cpConfigPath)
+		if err != nil {
+			return err
+		}
+		controlPlaneConfig := &api.ControlPlaneConfig{}
+		if shoot.Spec.Provider.ControlPlaneConfig != nil {
+			if controlPlaneConfig, err = decodeControlPlaneConfig(s.decoder, shoot.Spec.Provider.ControlPlaneConfig, cpConfigPath); err != nil {
+				return err
+			}
+		}
+
+		if errList := awsvalidation.ValidateControlPlaneConfigUpdate(oldControlPlaneConfig, controlPlaneConfig, cpConfigPath); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
+	}
+
 	if errList := awsvalidation.ValidateWorkersUpdate(oldShoot.Spec.Provider.Workers, shoot.Spec.Provider.Workers, workersPath); len(errList) != 0 {
 		return errList.ToAggregate()
 	}
@@ -212,6 +230,14 @@ func (s *shoot) baseShootValidation(ctx context.Context, shoot *core.Shoot, clou
 		return nil, nil, err
 	}
 
+	partition := awsclient.PartitionForRegion(shoot.Spec.Region)
+	if awsCloudProfile.API != nil && awsCloudProfile.API.Partition != nil {
+		partition = *awsCloudProfile.API.Partition
+	}
+	if errList := awsvalidation.ValidateInfrastructureConfigPartition(infraConfig, partition, infraConfigPath); len(errList) != 0 {
+		return nil, nil, errList.ToAggregate()
+	}
+
 	return awsCloudProfile, infraConfig, nil
 }
 
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type warningsKey struct{}
+
+// warnings collects the admission warnings of the validators handling a request.
+type warnings struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+// WithWarnings returns a context which collects the warnings added by validators with AddWarning.
+func WithWarnings(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey{}, &warnings{})
+}
+
+// Warnings returns the warnings collected in the given context.
+func Warnings(ctx context.Context) []string {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+	if !ok {
+		return nil
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return append([]string(nil), w.messages...)
+}
+
+// AddWarning adds a warning which is returned to the client with the admission response. It is dropped if the
+// context does not collect warnings.
+func AddWarning(ctx context.Context, message string) {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+	if !ok {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.messages = append(w.messages, message)
+}
+
+// withWarnings wraps the given handler to return the warnings added by the validators with the admission response.
+func withWarnings(handler admission.Handler) admission.Handler {
+	return admission.HandlerFunc(func(ctx context.Context, req admission.Request) admission.Response {
+		ctx = WithWarnings(ctx)
+		resp := handler.Handle(ctx, req)
+		resp.Warnings = append(resp.Warnings, Warnings(ctx)...)
+		return resp
+	})
+}
@@ -30,7 +30,7 @@ var logger = log.Log.WithName("aws-validator-webhook")
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Setting up webhook", "name", Name)
 
-	return extensionswebhook.New(mgr, extensionswebhook.Args{
+	wh, err := extensionswebhook.New(mgr, extensionswebhook.Args{
 		Provider: aws.Type,
 		Name:     Name,
 		Path:     "/webhooks/validate",
@@ -49,6 +49,12 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 			MatchLabels: map[string]string{"provider.extensions.gardener.cloud/aws": "true"},
 		},
 	})
+	if err != nil {
+		return nil, err
+	}
+	// the validators of SecretBindings and CredentialsBindings warn about access keys nearing their maximum age
+	wh.Webhook.Handler = withWarnings(wh.Webhook.Handler)
+	return wh, nil
 }
 
 // NewSecretsWebhook creates a new validation webhook for Secrets.
@@ -20,6 +20,29 @@ const (
 	AnnotationAWSLBNLBTargetType = "service.beta.kubernetes.io/aws-load-balancer-nlb-target-type"
 	// AnnotationAWSLBType is the annotation key for AWS load balancer type.
 	AnnotationAWSLBType = "service.beta.kubernetes.io/aws-load-balancer-type"
+	// AnnotationLBSourceRanges is the annotation key for the client CIDRs allowed to access a load balancer.
+	AnnotationLBSourceRanges = "service.beta.kubernetes.io/load-balancer-source-ranges"
+	// AnnotationAWSLBAccessLogEnabled is the annotation key for enabling access logs of an AWS load balancer.
+	AnnotationAWSLBAccessLogEnabled = "service.beta.kubernetes.io/aws-load-balancer-access-log-enabled"
+	// AnnotationAWSLBAccessLogS3BucketName is the annotation key for the S3 bucket of the access logs of an AWS load balancer.
+	AnnotationAWSLBAccessLogS3BucketName = "service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name"
+	// AnnotationAWSLBAccessLogS3BucketPrefix is the annotation key for the S3 prefix of the access logs of an AWS load balancer.
+	AnnotationAWSLBAccessLogS3BucketPrefix = "service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-prefix"
+	// AnnotationAWSLBCrossZoneLoadBalancingEnabled is the annotation key for cross-zone load balancing of an AWS load balancer.
+	AnnotationAWSLBCrossZoneLoadBalancingEnabled = "service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"
+	// AnnotationAWSLBConnectionIdleTimeout is the annotation key for the connection idle timeout of an AWS load balancer.
+	AnnotationAWSLBConnectionIdleTimeout = "service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout"
+	// AnnotationAWSLBListenerAttributes is the prefix of the annotation keys for the attributes of the listeners of an
+	// AWS network load balancer, followed by the protocol and port of the listener, e.g. ".TCP-443".
+	AnnotationAWSLBListenerAttributes = "service.beta.kubernetes.io/aws-load-balancer-listener-attributes"
+	// AnnotationAWSLBAdditionalResourceTags is the annotation key for additional tags of an AWS load balancer.
+	AnnotationAWSLBAdditionalResourceTags = "service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags"
+	// AnnotationOldLoadBalancerName is the annotation key for the hostname of a load balancer which was superseded by
+	// the dual-stack migration of a service.
+	AnnotationOldLoadBalancerName = "gardener.cloud/old-load-balancer-name"
+	// AnnotationOldLoadBalancerSupersededAt is the annotation key for the time at which the load balancer referenced by
+	// AnnotationOldLoadBalancerName was first observed as no longer being in use.
+	AnnotationOldLoadBalancerSupersededAt = "aws.provider.extensions.gardener.cloud/old-load-balancer-superseded-at"
 
 	// ValueTrue is the string value "true" for annotation values.
 	ValueTrue = "true"
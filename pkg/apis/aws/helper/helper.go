@@ -208,6 +208,66 @@ func FindDataVolumeByName(dataVolumes []api.DataVolume, name string) *api.DataVo
 	return nil
 }
 
+// NATGatewayZone returns the name of the zone whose NAT gateway the private subnets of the given zone use for egress
+// traffic. It returns an empty string if the private subnets have no NAT gateway.
+func NATGatewayZone(config *api.InfrastructureConfig, zoneName string) string {
+	if config.Networks.Egress == nil {
+		return zoneName
+	}
+	switch config.Networks.Egress.Mode {
+	case api.EgressModeNone:
+		return ""
+	case api.EgressModeSingleNATGateway:
+		if config.Networks.Egress.NATGatewayZone != nil {
+			return *config.Networks.Egress.NATGatewayZone
+		}
+		if len(config.Networks.Zones) > 0 {
+			return config.Networks.Zones[0].Name
+		}
+		return ""
+	default:
+		return zoneName
+	}
+}
+
+// HasNATGateway returns true if a NAT gateway is created in the given zone.
+func HasNATGateway(config *api.InfrastructureConfig, zoneName string) bool {
+	return NATGatewayZone(config, zoneName) == zoneName
+}
+
+// FSxZone returns the name of the zone whose worker subnet the FSx file system is created in. It returns an empty
+// string if no FSx file system is configured.
+func FSxZone(config *api.InfrastructureConfig) string {
+	if config.FSx == nil {
+		return ""
+	}
+	if config.FSx.Zone != nil {
+		return *config.FSx.Zone
+	}
+	if len(config.Networks.Zones) > 0 {
+		return config.Networks.Zones[0].Name
+	}
+	return ""
+}
+
+// FSxDeploymentType returns the deployment type of the given FSx file system.
+func FSxDeploymentType(fsx *api.FSxConfig) string {
+	switch fsx.Type {
+	case api.FSxFileSystemTypeLustre:
+		if fsx.Lustre != nil && fsx.Lustre.DeploymentType != nil {
+			return *fsx.Lustre.DeploymentType
+		}
+		return "SCRATCH_2"
+	case api.FSxFileSystemTypeOpenZFS:
+		if fsx.OpenZFS != nil && fsx.OpenZFS.DeploymentType != nil {
+			return *fsx.OpenZFS.DeploymentType
+		}
+		return "SINGLE_AZ_1"
+	default:
+		return ""
+	}
+}
+
 // DecodeBackupBucketConfig decodes the `BackupBucketConfig` from the given `RawExtension`.
 func DecodeBackupBucketConfig(decoder runtime.Decoder, config *runtime.RawExtension) (*api.BackupBucketConfig, error) {
 	backupBucketConfig := &api.BackupBucketConfig{}
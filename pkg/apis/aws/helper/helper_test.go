@@ -161,6 +161,52 @@ var _ = Describe("Helper", func() {
 		Entry("volume found (multiple entries)", []api.DataVolume{{Name: "bar"}, {Name: "foo"}, {Name: "baz"}}, "foo", &api.DataVolume{Name: "foo"}),
 	)
 
+	DescribeTable("#NATGatewayZone",
+		func(egress *api.Egress, zoneName, expectedZone string) {
+			config := &api.InfrastructureConfig{
+				Networks: api.Networks{
+					Zones:  []api.Zone{{Name: "zone-a"}, {Name: "zone-b"}},
+					Egress: egress,
+				},
+			}
+			Expect(NATGatewayZone(config, zoneName)).To(Equal(expectedZone))
+			Expect(HasNATGateway(config, zoneName)).To(Equal(expectedZone == zoneName))
+		},
+
+		Entry("no egress config", nil, "zone-b", "zone-b"),
+		Entry("NAT gateway per zone", &api.Egress{Mode: api.EgressModeNATGatewayPerZone}, "zone-b", "zone-b"),
+		Entry("single NAT gateway in first zone", &api.Egress{Mode: api.EgressModeSingleNATGateway}, "zone-b", "zone-a"),
+		Entry("single NAT gateway in given zone", &api.Egress{Mode: api.EgressModeSingleNATGateway, NATGatewayZone: ptr.To("zone-b")}, "zone-a", "zone-b"),
+		Entry("no NAT gateway", &api.Egress{Mode: api.EgressModeNone}, "zone-a", ""),
+	)
+
+	DescribeTable("#FSxZone",
+		func(fsx *api.FSxConfig, expectedZone string) {
+			config := &api.InfrastructureConfig{
+				Networks: api.Networks{
+					Zones: []api.Zone{{Name: "zone-a"}, {Name: "zone-b"}},
+				},
+				FSx: fsx,
+			}
+			Expect(FSxZone(config)).To(Equal(expectedZone))
+		},
+
+		Entry("no FSx config", nil, ""),
+		Entry("first zone", &api.FSxConfig{Type: api.FSxFileSystemTypeLustre}, "zone-a"),
+		Entry("given zone", &api.FSxConfig{Type: api.FSxFileSystemTypeLustre, Zone: ptr.To("zone-b")}, "zone-b"),
+	)
+
+	DescribeTable("#FSxDeploymentType",
+		func(fsx *api.FSxConfig, expectedDeploymentType string) {
+			Expect(FSxDeploymentType(fsx)).To(Equal(expectedDeploymentType))
+		},
+
+		Entry("default Lustre deployment type", &api.FSxConfig{Type: api.FSxFileSystemTypeLustre}, "SCRATCH_2"),
+		Entry("Lustre deployment type", &api.FSxConfig{Type: api.FSxFileSystemTypeLustre, Lustre: &api.FSxLustreConfig{DeploymentType: ptr.To("PERSISTENT_2")}}, "PERSISTENT_2"),
+		Entry("default OpenZFS deployment type", &api.FSxConfig{Type: api.FSxFileSystemTypeOpenZFS, OpenZFS: &api.FSxOpenZFSConfig{}}, "SINGLE_AZ_1"),
+		Entry("OpenZFS deployment type", &api.FSxConfig{Type: api.FSxFileSystemTypeOpenZFS, OpenZFS: &api.FSxOpenZFSConfig{DeploymentType: ptr.To("SINGLE_AZ_2")}}, "SINGLE_AZ_2"),
+	)
+
 	Describe("Decode", func() {
 		var (
 			decoder runtime.Decoder
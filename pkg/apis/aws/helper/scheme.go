@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/install"
@@ -69,6 +70,18 @@ func InfrastructureConfigFromCluster(cluster *controller.Cluster) (*api.Infrastr
 	return infrastructureConfig, nil
 }
 
+// ControlPlaneConfigFromCluster decodes the control plane configuration for a cluster
+func ControlPlaneConfigFromCluster(cluster *controller.Cluster) (*api.ControlPlaneConfig, error) {
+	var controlPlaneConfig *api.ControlPlaneConfig
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		controlPlaneConfig = &api.ControlPlaneConfig{}
+		if _, _, err := decoder.Decode(cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, controlPlaneConfig); err != nil {
+			return nil, fmt.Errorf("could not decode controlPlaneConfig of shoot '%s': %w", k8sclient.ObjectKeyFromObject(cluster.Shoot), err)
+		}
+	}
+	return controlPlaneConfig, nil
+}
+
 // InfrastructureConfigFromInfrastructure extracts the InfrastructureConfig from the
 // ProviderConfig section of the given Infrastructure.
 func InfrastructureConfigFromInfrastructure(infra *extensionsv1alpha1.Infrastructure) (*api.InfrastructureConfig, error) {
@@ -140,6 +153,21 @@ func WorkloadIdentityConfigFromBytes(config []byte) (*api.WorkloadIdentityConfig
 	return workloadIdentityConfig, nil
 }
 
+// AssumeRoleChainFromBytes extracts the role chain from the provided JSON or YAML list of roles.
+func AssumeRoleChainFromBytes(data []byte) ([]api.AssumeRole, error) {
+	var external []apiv1alpha1.AssumeRole
+	if err := yaml.UnmarshalStrict(data, &external); err != nil {
+		return nil, err
+	}
+	chain := make([]api.AssumeRole, len(external))
+	for i := range external {
+		if err := apiv1alpha1.Convert_v1alpha1_AssumeRole_To_aws_AssumeRole(&external[i], &chain[i], nil); err != nil {
+			return nil, err
+		}
+	}
+	return chain, nil
+}
+
 // HasFlowState returns true if the group version of the State field in the provided
 // `extensionsv1alpha1.InfrastructureStatus` is aws.provider.extensions.gardener.cloud/v1alpha1.
 func HasFlowState(status extensionsv1alpha1.InfrastructureStatus) (bool, error) {
@@ -164,6 +192,15 @@ func HasFlowState(status extensionsv1alpha1.InfrastructureStatus) (bool, error)
 	}, nil
 }
 
+// InfrastructureInventoryFromRaw decodes the given infrastructure inventory.
+func InfrastructureInventoryFromRaw(raw []byte) (*api.InfrastructureInventory, error) {
+	inventory := &api.InfrastructureInventory{}
+	if _, _, err := decoder.Decode(raw, nil, inventory); err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure inventory: %w", err)
+	}
+	return inventory, nil
+}
+
 // InfrastructureStateFromRaw extracts the state from the Infrastructure. If no state was available, it returns a "zero" value InfrastructureState object.
 func InfrastructureStateFromRaw(raw *runtime.RawExtension) (*api.InfrastructureState, error) {
 	state := &api.InfrastructureState{}
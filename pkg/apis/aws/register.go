@@ -39,6 +39,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CloudProfileConfig{},
 		&ControlPlaneConfig{},
 		&InfrastructureConfig{},
+		&InfrastructureInventory{},
 		&InfrastructureState{},
 		&InfrastructureStatus{},
 		&WorkerConfig{},
@@ -18,6 +18,21 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages
+	// API contains settings for the AWS API used for all shoots of the cloud profile. The settings in the cloud provider
+	// secret of a shoot take precedence.
+	API *APIConfig
+}
+
+// APIConfig contains settings for the AWS API.
+type APIConfig struct {
+	// Partition is the ARN partition of the regions, e.g. "aws-cn" or "aws-us-gov". It is derived from the region if
+	// not set.
+	Partition *string
+	// Endpoint is the URL of the endpoint used for all AWS services instead of the default endpoints of the region.
+	// It is mutually exclusive with UseFIPSEndpoints.
+	Endpoint *string
+	// UseFIPSEndpoints selects the FIPS 140 validated endpoints of the AWS services.
+	UseFIPSEndpoints *bool
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
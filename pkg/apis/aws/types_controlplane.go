@@ -22,6 +22,9 @@ type ControlPlaneConfig struct {
 
 	// Storage contains configuration for storage in the cluster.
 	Storage *Storage
+
+	// ServiceLoadBalancerDefaults contains defaults for new Services of type LoadBalancer in the shoot cluster.
+	ServiceLoadBalancerDefaults *ServiceLoadBalancerDefaults
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -41,8 +44,34 @@ type LoadBalancerControllerConfig struct {
 	// IngressClassName is the name of the ingress class the ALB controller will target. Default value is 'alb'.
 	// If empty string is specified, it will match all ingresses without ingress class annotation and ingresses of type alb
 	IngressClassName *string
+	// DefaultTargetType is the default target type used for Ingresses and Services managed by the ALB controller.
+	// Possible values are "instance" and "ip". Defaults to "instance".
+	DefaultTargetType *string
+	// EnableWAFv2 controls if the WAFv2 integration of the ALB controller is enabled. Defaults to true.
+	EnableWAFv2 *bool
+	// EnableShield controls if the Shield Advanced integration of the ALB controller is enabled. Defaults to true.
+	EnableShield *bool
+	// DefaultSSLPolicy is the default SSL policy for HTTPS and TLS listeners.
+	DefaultSSLPolicy *string
+	// DefaultTags are additional tags applied to all AWS resources created by the ALB controller.
+	DefaultTags map[string]string
+	// ServiceLoadBalancerOwner determines which component provisions load balancers for new Services of type LoadBalancer.
+	// Defaults to "CloudControllerManager".
+	ServiceLoadBalancerOwner *ServiceLoadBalancerOwner
 }
 
+// ServiceLoadBalancerOwner is the component provisioning load balancers for Services of type LoadBalancer.
+type ServiceLoadBalancerOwner string
+
+const (
+	// ServiceLoadBalancerOwnerCloudControllerManager means that the cloud-controller-manager provisions load balancers
+	// for new Services of type LoadBalancer.
+	ServiceLoadBalancerOwnerCloudControllerManager ServiceLoadBalancerOwner = "CloudControllerManager"
+	// ServiceLoadBalancerOwnerLoadBalancerController means that the aws-load-balancer-controller provisions load
+	// balancers for new Services of type LoadBalancer.
+	ServiceLoadBalancerOwnerLoadBalancerController ServiceLoadBalancerOwner = "LoadBalancerController"
+)
+
 // IPAMControllerConfig contains configuration settings for the optional aws-ipam-controller.
 type IPAMControllerConfig struct {
 	// Enabled controls if the IPAM controller should be deployed.
@@ -57,3 +86,28 @@ type Storage struct {
 	// Defaults to true.
 	ManagedDefaultClass *bool
 }
+
+// ServiceLoadBalancerDefaults contains defaults for new Services of type LoadBalancer in the shoot cluster.
+// They are applied as annotations unless the corresponding annotation is already set on the Service.
+type ServiceLoadBalancerDefaults struct {
+	// Internal controls if load balancers are internal by default.
+	Internal *bool
+	// SourceRanges are the client CIDRs allowed to access load balancers by default.
+	SourceRanges []string
+	// AccessLogs contains the default access log configuration of load balancers.
+	AccessLogs *LoadBalancerAccessLogs
+	// CrossZoneLoadBalancing controls if cross-zone load balancing is enabled by default.
+	CrossZoneLoadBalancing *bool
+	// IdleTimeout is the default connection idle timeout of load balancers.
+	IdleTimeout *metav1.Duration
+	// AdditionalTags are added to load balancers by default.
+	AdditionalTags map[string]string
+}
+
+// LoadBalancerAccessLogs contains the access log configuration of load balancers.
+type LoadBalancerAccessLogs struct {
+	// BucketName is the name of the S3 bucket the access logs are stored in.
+	BucketName string
+	// BucketPrefix is the prefix of the access log objects in the S3 bucket.
+	BucketPrefix *string
+}
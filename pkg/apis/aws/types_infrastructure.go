@@ -38,14 +38,29 @@ type InfrastructureConfig struct {
 	// This setting is immutable and cannot be changed once the VPC has been created.
 	EnableDedicatedTenancyForVPC *bool
 
+	// FlowLogs contains the configuration of the VPC flow logs. It is only allowed for VPCs created by the extension.
+	FlowLogs *FlowLogs
+
 	// ElasticFileSystem contains optional information about the EFS that should be used.
-	// This field is immutable and cannot be changed once created.
+	// Enabled, ID, PerformanceMode and KMSKeyID cannot be changed once set.
 	ElasticFileSystem *ElasticFileSystemConfig
 
+	// FSx contains optional information about an FSx file system that should be created in the worker subnet of a zone.
+	// Its settings cannot be changed once set, but it can be removed again.
+	FSx *FSxConfig
+
 	// EnableMTUCustomizer controls whether the mtu-customizer systemd unit and script are deployed
 	// to the shoot worker nodes. When enabled, the MTU of all non-virtual network interfaces is set to 1460.
 	// default: true
 	EnableMTUCustomizer *bool
+
+	// ECR contains additional configuration of the ECR credential provider on the worker nodes.
+	// It is only considered if EnableECRAccess is not set to false.
+	ECR *ECRConfig
+
+	// DeletionPolicy controls which resources are kept when the infrastructure is deleted and whether the deletion of
+	// stateful resources has to be confirmed.
+	DeletionPolicy *DeletionPolicy
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -61,6 +76,11 @@ type InfrastructureStatus struct {
 	VPC VPCStatus
 	// ElasticFileSystem contains information about the created ElasticFileSystem.
 	ElasticFileSystem ElasticFileSystemStatus
+	// FSx contains information about the created FSx file system.
+	FSx *FSxStatus
+	// DeletionBlockers lists the resources which are not managed by the extension but prevent the deletion of the
+	// subnets, route tables or security groups of the shoot in an existing VPC.
+	DeletionBlockers []DeletionBlocker
 }
 
 // Networks holds information about the Kubernetes and infrastructure networks.
@@ -69,6 +89,122 @@ type Networks struct {
 	VPC VPC
 	// Zones belonging to the same region
 	Zones []Zone
+	// Egress configures how the private subnets reach the internet. Defaults to a NAT gateway per zone.
+	Egress *Egress
+	// ElasticIPPool is the pool from which the Elastic IPs of the NAT gateways are allocated if they are created by the
+	// extension. Defaults to Amazon's pool of public IPv4 addresses.
+	ElasticIPPool *ElasticIPPool
+	// NetworkACLs contains the network ACLs of the subnets per purpose. Subnets of a purpose without network ACL use the
+	// default network ACL of the VPC.
+	NetworkACLs *NetworkACLs
+}
+
+// ElasticIPPool references a pool of public IPv4 addresses. Exactly one of the fields must be set.
+type ElasticIPPool struct {
+	// PublicIPv4PoolID is the ID of a public IPv4 pool with addresses brought to AWS (BYOIP),
+	// e.g. `ipv4pool-ec2-0123456789abcdef0`.
+	PublicIPv4PoolID *string
+	// IPAMPoolID is the ID of an Amazon VPC IPAM pool with public IPv4 addresses, e.g. `ipam-pool-0123456789abcdef0`.
+	IPAMPoolID *string
+}
+
+// NetworkACLs contains the network ACLs of the subnets per purpose. The network ACL of a purpose is shared by the
+// subnets of this purpose in all zones.
+type NetworkACLs struct {
+	// Public is the network ACL of the public utility subnets.
+	Public *NetworkACL
+	// Internal is the network ACL of the internal utility subnets.
+	Internal *NetworkACL
+	// Workers is the network ACL of the workers subnets.
+	Workers *NetworkACL
+}
+
+// NetworkACL contains the rules of a network ACL. Traffic which is not allowed by any rule is denied.
+type NetworkACL struct {
+	// Ingress are the rules for inbound traffic.
+	Ingress []NetworkACLRule
+	// Egress are the rules for outbound traffic.
+	Egress []NetworkACLRule
+}
+
+// NetworkACLRuleAction is the action of a network ACL rule.
+type NetworkACLRuleAction string
+
+const (
+	// NetworkACLRuleActionAllow allows the traffic matching the rule.
+	NetworkACLRuleActionAllow NetworkACLRuleAction = "allow"
+	// NetworkACLRuleActionDeny denies the traffic matching the rule.
+	NetworkACLRuleActionDeny NetworkACLRuleAction = "deny"
+)
+
+// NetworkACLRule is a rule of a network ACL. The rules are evaluated in the order of their rule numbers and the first
+// matching rule is applied.
+type NetworkACLRule struct {
+	// RuleNumber is the number of the rule between 1 and 32766. It must be unique per direction.
+	RuleNumber int32
+	// Action is the action of the rule, either `allow` or `deny`.
+	Action NetworkACLRuleAction
+	// Protocol is the protocol of the rule, either `all`, `tcp`, `udp`, `icmp`, `icmpv6` or an IP protocol number.
+	Protocol string
+	// CIDR is the IPv4 or IPv6 CIDR of the source (ingress) or destination (egress) of the traffic.
+	CIDR string
+	// FromPort is the first port of the port range. It is required for the protocols `tcp` and `udp`.
+	FromPort *int32
+	// ToPort is the last port of the port range. It is required for the protocols `tcp` and `udp`.
+	ToPort *int32
+}
+
+// EgressMode is the mode of the egress traffic of the private subnets.
+type EgressMode string
+
+const (
+	// EgressModeNATGatewayPerZone creates a NAT gateway with an elastic IP in every zone. The private subnets of a zone
+	// use the NAT gateway of the same zone.
+	EgressModeNATGatewayPerZone EgressMode = "NATGatewayPerZone"
+	// EgressModeSingleNATGateway creates a single NAT gateway which is shared by the private subnets of all zones.
+	EgressModeSingleNATGateway EgressMode = "SingleNATGateway"
+	// EgressModeNone creates no NAT gateway. The private subnets have no route to the internet, i.e. the nodes can only
+	// reach AWS services and registries through VPC endpoints.
+	EgressModeNone EgressMode = "None"
+)
+
+// Egress contains the configuration of the egress traffic of the private subnets.
+type Egress struct {
+	// Mode is the egress mode of the private subnets.
+	Mode EgressMode
+	// NATGatewayZone is the zone of the NAT gateway in mode `SingleNATGateway`. Defaults to the first zone and cannot be
+	// changed afterwards.
+	NATGatewayZone *string
+}
+
+// FlowLogTrafficType is the type of traffic captured by VPC flow logs.
+type FlowLogTrafficType string
+
+const (
+	// FlowLogTrafficTypeAll captures accepted and rejected traffic.
+	FlowLogTrafficTypeAll FlowLogTrafficType = "ALL"
+	// FlowLogTrafficTypeAccept captures only accepted traffic.
+	FlowLogTrafficTypeAccept FlowLogTrafficType = "ACCEPT"
+	// FlowLogTrafficTypeReject captures only rejected traffic.
+	FlowLogTrafficTypeReject FlowLogTrafficType = "REJECT"
+)
+
+// FlowLogs contains the configuration of the VPC flow logs. Exactly one destination must be set.
+type FlowLogs struct {
+	// S3BucketARN is the ARN of the S3 bucket the flow logs are published to, optionally with a folder,
+	// e.g. `arn:aws:s3:::my-flow-logs/my-shoot`.
+	S3BucketARN *string
+	// CloudWatchLogGroup is the name of the CloudWatch Logs log group the flow logs are published to. The log group is
+	// created by the flow logs service if it does not exist.
+	CloudWatchLogGroup *string
+	// TrafficType is the type of the captured traffic. Defaults to `ALL`.
+	TrafficType *FlowLogTrafficType
+	// LogFormat is the format of the flow log records, e.g. `${srcaddr} ${dstaddr} ${action}`. Defaults to the
+	// default format of AWS.
+	LogFormat *string
+	// MaxAggregationInterval is the maximum interval in seconds during which a flow of packets is captured and
+	// aggregated into a flow log record, either 60 or 600. Defaults to 600.
+	MaxAggregationInterval *int32
 }
 
 // IgnoreTags holds information about ignored resource tags.
@@ -96,6 +232,17 @@ type Zone struct {
 	// (and potentially removed if it was created by this extension). Also, the NAT gateway will be deleted. This will
 	// disrupt egress traffic for a while.
 	ElasticIPAllocationID *string
+	// SecondaryElasticIPs configures additional Elastic IPs of the NAT gateway in this zone. Each Elastic IP allows
+	// about 55,000 further concurrent connections to the same destination.
+	SecondaryElasticIPs *SecondaryElasticIPs
+}
+
+// SecondaryElasticIPs contains the additional Elastic IPs of a NAT gateway. Either Count or AllocationIDs must be set.
+type SecondaryElasticIPs struct {
+	// Count is the number of additional Elastic IPs which are created by the extension.
+	Count *int32
+	// AllocationIDs contains the allocation IDs of existing additional Elastic IPs (e.g., `eipalloc-123456`).
+	AllocationIDs []string
 }
 
 // EC2 contains information about the AWS EC2 resources.
@@ -144,6 +291,44 @@ type VPCStatus struct {
 type ElasticFileSystemStatus struct {
 	// ID contains the Elastic Files System ID.
 	ID string
+	// AccessPoints contains the created EFS access points.
+	AccessPoints []EFSAccessPointStatus
+}
+
+// FSxStatus contains status info about an FSx file system.
+type FSxStatus struct {
+	// ID is the ID of the file system.
+	ID string
+	// Type is the type of the file system.
+	Type FSxFileSystemType
+	// DNSName is the DNS name of the file system.
+	DNSName string
+	// MountName is the mount name of a Lustre file system.
+	MountName string
+	// SecurityGroupID is the ID of the security group of the file system.
+	SecurityGroupID string
+}
+
+// DeletionBlocker is a resource which prevents the deletion of a resource of the infrastructure.
+type DeletionBlocker struct {
+	// Type is the type of the blocking resource, e.g. `network-interface` or `security-group`.
+	Type string
+	// ID is the ID of the blocking resource.
+	ID string
+	// Owner is the account ID or the service which owns the blocking resource, e.g. `amazon-rds`, if known.
+	Owner string
+	// Description is the description of the blocking resource.
+	Description string
+	// Dependency is the ID of the resource of the infrastructure which cannot be deleted.
+	Dependency string
+}
+
+// EFSAccessPointStatus contains status info about an EFS access point.
+type EFSAccessPointStatus struct {
+	// Name is the name of the access point in the configuration.
+	Name string
+	// ID is the ID of the access point.
+	ID string
 }
 
 const (
@@ -204,6 +389,26 @@ type InfrastructureState struct {
 	Data map[string]string
 }
 
+// ECRConfig contains the configuration of the ECR credential provider on the worker nodes.
+type ECRConfig struct {
+	// CacheDuration is the default duration the kubelet caches the credentials returned by the ECR credential provider.
+	// default: 1h
+	CacheDuration *metav1.Duration
+	// Registries contains additional ECR registries the credential provider is used for, e.g. registries in other AWS
+	// accounts or pull-through caches in a central account.
+	Registries []ECRRegistry
+}
+
+// ECRRegistry contains the configuration for a set of ECR registries.
+type ECRRegistry struct {
+	// MatchImages is a list of image patterns in the format of the kubelet CredentialProviderConfig, e.g.
+	// "123456789012.dkr.ecr.eu-west-1.amazonaws.com".
+	MatchImages []string
+	// RoleARN is the ARN of an IAM role which is assumed with the instance role of the nodes to retrieve the credentials
+	// for the matching registries. If not set, the instance role is used directly.
+	RoleARN *string
+}
+
 // ElasticFileSystemConfig holds config information about the EFS storage
 type ElasticFileSystemConfig struct {
 	// Enabled is the switch to install the CSI EFS driver
@@ -214,4 +419,144 @@ type ElasticFileSystemConfig struct {
 	Enabled bool
 	// ID of the EFS to use. For example: fs-0272b97527ed4de53.
 	ID *string
+	// PerformanceMode is the performance mode of the created EFS. It cannot be changed after the EFS has been created.
+	PerformanceMode *EFSPerformanceMode
+	// ThroughputMode is the throughput mode of the created EFS.
+	ThroughputMode *EFSThroughputMode
+	// ProvisionedThroughputInMibps is the throughput of the created EFS in MiB/s. It must be set if and only if the
+	// throughput mode is provisioned.
+	ProvisionedThroughputInMibps *int32
+	// KMSKeyID is the ID or ARN of the KMS key used to encrypt the created EFS. If not set, the AWS managed key is
+	// used. It cannot be changed after the EFS has been created.
+	KMSKeyID *string
+	// LifecyclePolicy configures when files of the created EFS are moved between the storage classes.
+	LifecyclePolicy *EFSLifecyclePolicy
+	// AutomaticBackups enables the automatic backups of the created EFS with AWS Backup.
+	AutomaticBackups *bool
+	// AccessPoints is a list of EFS access points which are created and exposed as storage classes in the shoot.
+	AccessPoints []EFSAccessPoint
+}
+
+// EFSPerformanceMode is the performance mode of an EFS.
+type EFSPerformanceMode string
+
+const (
+	// EFSPerformanceModeGeneralPurpose is the general purpose performance mode.
+	EFSPerformanceModeGeneralPurpose EFSPerformanceMode = "generalPurpose"
+	// EFSPerformanceModeMaxIO is the max I/O performance mode.
+	EFSPerformanceModeMaxIO EFSPerformanceMode = "maxIO"
+)
+
+// EFSThroughputMode is the throughput mode of an EFS.
+type EFSThroughputMode string
+
+const (
+	// EFSThroughputModeBursting scales the throughput with the size of the file system.
+	EFSThroughputModeBursting EFSThroughputMode = "bursting"
+	// EFSThroughputModeProvisioned uses a fixed throughput.
+	EFSThroughputModeProvisioned EFSThroughputMode = "provisioned"
+	// EFSThroughputModeElastic scales the throughput with the workload.
+	EFSThroughputModeElastic EFSThroughputMode = "elastic"
+)
+
+// EFSLifecyclePolicy configures when files are moved between the storage classes of an EFS.
+type EFSLifecyclePolicy struct {
+	// TransitionToIA is the time after the last access when a file is moved to the Infrequent Access storage class,
+	// e.g. "AFTER_30_DAYS".
+	TransitionToIA *string
+	// TransitionToArchive is the time after the last access when a file is moved to the Archive storage class,
+	// e.g. "AFTER_90_DAYS".
+	TransitionToArchive *string
+	// TransitionToPrimaryStorageClass is set to "AFTER_1_ACCESS" to move files back to the Standard storage class
+	// when they are accessed.
+	TransitionToPrimaryStorageClass *string
+}
+
+// EFSAccessPoint is an EFS access point.
+type EFSAccessPoint struct {
+	// Name is the name of the access point. It is used for the name of the storage class in the shoot.
+	Name string
+	// Path is the root directory of the access point. It is created if it does not exist.
+	Path string
+	// PosixUser is the POSIX user and group which are used for all file system requests through the access point.
+	PosixUser *EFSPosixUser
+	// Permissions are the POSIX permissions of the root directory if it is created, e.g. "0755". Defaults to "0755".
+	Permissions *string
 }
+
+// EFSPosixUser is a POSIX user of an EFS access point.
+type EFSPosixUser struct {
+	// UID is the POSIX user ID.
+	UID int64
+	// GID is the POSIX group ID.
+	GID int64
+	// SecondaryGIDs are additional POSIX group IDs.
+	SecondaryGIDs []int64
+}
+
+// FSxConfig holds config information about an FSx file system.
+type FSxConfig struct {
+	// Type is the type of the file system.
+	Type FSxFileSystemType
+	// Zone is the name of the zone whose worker subnet the file system is created in. Defaults to the first zone.
+	Zone *string
+	// StorageCapacityGiB is the storage capacity of the file system in GiB.
+	StorageCapacityGiB int32
+	// Lustre contains the settings of a Lustre file system.
+	Lustre *FSxLustreConfig
+	// OpenZFS contains the settings of an OpenZFS file system.
+	OpenZFS *FSxOpenZFSConfig
+}
+
+// FSxFileSystemType is the type of an FSx file system.
+type FSxFileSystemType string
+
+const (
+	// FSxFileSystemTypeLustre is an FSx for Lustre file system.
+	FSxFileSystemTypeLustre FSxFileSystemType = "Lustre"
+	// FSxFileSystemTypeOpenZFS is an FSx for OpenZFS file system.
+	FSxFileSystemTypeOpenZFS FSxFileSystemType = "OpenZFS"
+)
+
+// FSxLustreConfig holds the settings of an FSx for Lustre file system.
+type FSxLustreConfig struct {
+	// DeploymentType is the deployment type of the file system, one of SCRATCH_1, SCRATCH_2, PERSISTENT_1 or
+	// PERSISTENT_2. Defaults to SCRATCH_2.
+	DeploymentType *string
+	// PerUnitStorageThroughput is the throughput in MB/s per TiB of storage. It must be set if and only if the
+	// deployment type is persistent.
+	PerUnitStorageThroughput *int32
+}
+
+// FSxOpenZFSConfig holds the settings of an FSx for OpenZFS file system.
+type FSxOpenZFSConfig struct {
+	// DeploymentType is the deployment type of the file system, one of SINGLE_AZ_1 or SINGLE_AZ_2. Defaults to
+	// SINGLE_AZ_1.
+	DeploymentType *string
+	// ThroughputCapacity is the throughput of the file system in MB/s.
+	ThroughputCapacity int32
+}
+
+// DeletionPolicy controls how the resources of the infrastructure are handled when it is deleted.
+type DeletionPolicy struct {
+	// Retain lists the resources which are kept when the infrastructure is deleted. Retained resources are not managed
+	// by the extension anymore. Their cluster tag is replaced by tags with the name of the shoot and the deletion time.
+	Retain []RetainedResource
+	// RequireConfirmation specifies that the EFS and FSx file systems created by the extension are only deleted if the
+	// shoot is annotated with `aws.provider.extensions.gardener.cloud/confirm-stateful-resource-deletion=true`.
+	RequireConfirmation bool
+}
+
+// RetainedResource is a resource which can be kept when the infrastructure is deleted.
+type RetainedResource string
+
+const (
+	// RetainedResourceElasticFileSystem keeps the EFS file system created by the extension together with its access
+	// points.
+	RetainedResourceElasticFileSystem RetainedResource = "ElasticFileSystem"
+	// RetainedResourceElasticIPs keeps the elastic IPs allocated by the extension for the NAT gateways.
+	RetainedResourceElasticIPs RetainedResource = "ElasticIPs"
+	// RetainedResourceVPC keeps the VPC created by the extension together with all resources in it, e.g. the subnets,
+	// route tables and NAT gateways.
+	RetainedResourceVPC RetainedResource = "VPC"
+)
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InfrastructureInventory lists existing AWS resources which are adopted by the infrastructure of a shoot instead of
+// creating new ones. The resources are either given by their IDs, or by their addresses in a Terraform state.
+type InfrastructureInventory struct {
+	metav1.TypeMeta
+
+	// Resources are the IDs of the existing resources.
+	Resources *InventoryResources
+	// TerraformResourceAddresses are the addresses of the existing resources in the Terraform state which is stored
+	// next to the inventory, e.g. "module.network.aws_vpc.main". The IDs of the resources are read from the state.
+	// IDs given in Resources take precedence.
+	TerraformResourceAddresses *InventoryResources
+}
+
+// InventoryResources are the existing resources of an infrastructure by purpose.
+type InventoryResources struct {
+	// VPC is the VPC.
+	VPC *string
+	// DHCPOptions are the DHCP options of the VPC.
+	DHCPOptions *string
+	// DefaultSecurityGroup is the default security group of the VPC.
+	DefaultSecurityGroup *string
+	// InternetGateway is the internet gateway attached to the VPC.
+	InternetGateway *string
+	// EgressOnlyInternetGateway is the egress-only internet gateway attached to the VPC.
+	EgressOnlyInternetGateway *string
+	// MainRouteTable is the route table of the public subnets.
+	MainRouteTable *string
+	// NodesSecurityGroup is the security group of the nodes.
+	NodesSecurityGroup *string
+	// VPCEndpoints are the gateway endpoints of the VPC by the name of the service, e.g. "s3".
+	VPCEndpoints map[string]string
+	// Zones are the resources of the zones.
+	Zones []ZoneInventoryResources
+}
+
+// ZoneInventoryResources are the existing resources of a zone of an infrastructure by purpose.
+type ZoneInventoryResources struct {
+	// Name is the name of the zone.
+	Name string
+	// WorkersSubnet is the subnet of the nodes.
+	WorkersSubnet *string
+	// InternalSubnet is the private utility subnet.
+	InternalSubnet *string
+	// PublicSubnet is the public utility subnet.
+	PublicSubnet *string
+	// NATGateway is the NAT gateway.
+	NATGateway *string
+	// NATGatewayElasticIP is the allocation ID of the elastic IP of the NAT gateway.
+	NATGatewayElasticIP *string
+	// RouteTable is the route table of the workers and internal subnets.
+	RouteTable *string
+}
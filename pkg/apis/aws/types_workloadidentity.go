@@ -16,4 +16,21 @@ type WorkloadIdentityConfig struct {
 
 	// RoleARN is the identifier of the role that the workload identity will assume.
 	RoleARN string
+	// AssumeRoleChain is a list of roles which are assumed one after another after the role given by RoleARN, each
+	// with the credentials of the previous one.
+	AssumeRoleChain []AssumeRole
+}
+
+// AssumeRole is a role which is assumed with the credentials of the previous hop of a role chain.
+type AssumeRole struct {
+	// RoleARN is the identifier of the role.
+	RoleARN string
+	// ExternalID is the external ID required by the trust policy of the role.
+	ExternalID *string
+	// SessionName is the name of the role session.
+	SessionName *string
+	// Duration is the duration of the role session.
+	Duration *metav1.Duration
+	// SessionPolicy is an inline policy which further restricts the permissions of the role session.
+	SessionPolicy *string
 }
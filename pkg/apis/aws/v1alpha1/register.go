@@ -42,6 +42,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CloudProfileConfig{},
 		&ControlPlaneConfig{},
 		&InfrastructureConfig{},
+		&InfrastructureInventory{},
 		&InfrastructureState{},
 		&InfrastructureStatus{},
 		&WorkerConfig{},
@@ -19,6 +19,25 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages `json:"machineImages"`
+	// API contains settings for the AWS API used for all shoots of the cloud profile. The settings in the cloud provider
+	// secret of a shoot take precedence.
+	// +optional
+	API *APIConfig `json:"api,omitempty"`
+}
+
+// APIConfig contains settings for the AWS API.
+type APIConfig struct {
+	// Partition is the ARN partition of the regions, e.g. "aws-cn" or "aws-us-gov". It is derived from the region if
+	// not set.
+	// +optional
+	Partition *string `json:"partition,omitempty"`
+	// Endpoint is the URL of the endpoint used for all AWS services instead of the default endpoints of the region.
+	// It is mutually exclusive with useFIPSEndpoints.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+	// UseFIPSEndpoints selects the FIPS 140 validated endpoints of the AWS services.
+	// +optional
+	UseFIPSEndpoints *bool `json:"useFIPSEndpoints,omitempty"`
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
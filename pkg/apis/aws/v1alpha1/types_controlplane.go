@@ -26,6 +26,10 @@ type ControlPlaneConfig struct {
 	// Storage contains configuration for storage in the cluster.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
+
+	// ServiceLoadBalancerDefaults contains defaults for new Services of type LoadBalancer in the shoot cluster.
+	// +optional
+	ServiceLoadBalancerDefaults *ServiceLoadBalancerDefaults `json:"serviceLoadBalancerDefaults,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -49,8 +53,46 @@ type LoadBalancerControllerConfig struct {
 	// If empty string is specified, it will match all ingresses without ingress class annotation and ingresses of type alb
 	// +optional
 	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// DefaultTargetType is the default target type used for Ingresses and Services managed by the ALB controller.
+	// Possible values are "instance" and "ip". Defaults to "instance".
+	// +optional
+	DefaultTargetType *string `json:"defaultTargetType,omitempty"`
+
+	// EnableWAFv2 controls if the WAFv2 integration of the ALB controller is enabled. Defaults to true.
+	// +optional
+	EnableWAFv2 *bool `json:"enableWAFv2,omitempty"`
+
+	// EnableShield controls if the Shield Advanced integration of the ALB controller is enabled. Defaults to true.
+	// +optional
+	EnableShield *bool `json:"enableShield,omitempty"`
+
+	// DefaultSSLPolicy is the default SSL policy for HTTPS and TLS listeners.
+	// +optional
+	DefaultSSLPolicy *string `json:"defaultSSLPolicy,omitempty"`
+
+	// DefaultTags are additional tags applied to all AWS resources created by the ALB controller.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// ServiceLoadBalancerOwner determines which component provisions load balancers for new Services of type LoadBalancer.
+	// Defaults to "CloudControllerManager".
+	// +optional
+	ServiceLoadBalancerOwner *ServiceLoadBalancerOwner `json:"serviceLoadBalancerOwner,omitempty"`
 }
 
+// ServiceLoadBalancerOwner is the component provisioning load balancers for Services of type LoadBalancer.
+type ServiceLoadBalancerOwner string
+
+const (
+	// ServiceLoadBalancerOwnerCloudControllerManager means that the cloud-controller-manager provisions load balancers
+	// for new Services of type LoadBalancer.
+	ServiceLoadBalancerOwnerCloudControllerManager ServiceLoadBalancerOwner = "CloudControllerManager"
+	// ServiceLoadBalancerOwnerLoadBalancerController means that the aws-load-balancer-controller provisions load
+	// balancers for new Services of type LoadBalancer.
+	ServiceLoadBalancerOwnerLoadBalancerController ServiceLoadBalancerOwner = "LoadBalancerController"
+)
+
 // Storage contains configuration for storage in the cluster.
 type Storage struct {
 	// ManagedDefaultClass controls if the 'default' StorageClass and 'default' VolumeSnapshotClass
@@ -60,3 +102,41 @@ type Storage struct {
 	// +optional
 	ManagedDefaultClass *bool `json:"managedDefaultClass,omitempty"`
 }
+
+// ServiceLoadBalancerDefaults contains defaults for new Services of type LoadBalancer in the shoot cluster.
+// They are applied as annotations unless the corresponding annotation is already set on the Service.
+type ServiceLoadBalancerDefaults struct {
+	// Internal controls if load balancers are internal by default.
+	// +optional
+	Internal *bool `json:"internal,omitempty"`
+
+	// SourceRanges are the client CIDRs allowed to access load balancers by default.
+	// +optional
+	SourceRanges []string `json:"sourceRanges,omitempty"`
+
+	// AccessLogs contains the default access log configuration of load balancers.
+	// +optional
+	AccessLogs *LoadBalancerAccessLogs `json:"accessLogs,omitempty"`
+
+	// CrossZoneLoadBalancing controls if cross-zone load balancing is enabled by default.
+	// +optional
+	CrossZoneLoadBalancing *bool `json:"crossZoneLoadBalancing,omitempty"`
+
+	// IdleTimeout is the default connection idle timeout of load balancers.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// AdditionalTags are added to load balancers by default.
+	// +optional
+	AdditionalTags map[string]string `json:"additionalTags,omitempty"`
+}
+
+// LoadBalancerAccessLogs contains the access log configuration of load balancers.
+type LoadBalancerAccessLogs struct {
+	// BucketName is the name of the S3 bucket the access logs are stored in.
+	BucketName string `json:"bucketName"`
+
+	// BucketPrefix is the prefix of the access log objects in the S3 bucket.
+	// +optional
+	BucketPrefix *string `json:"bucketPrefix,omitempty"`
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InfrastructureInventory lists existing AWS resources which are adopted by the infrastructure of a shoot instead of
+// creating new ones. The resources are either given by their IDs, or by their addresses in a Terraform state.
+type InfrastructureInventory struct {
+	metav1.TypeMeta
+
+	// Resources are the IDs of the existing resources.
+	// +optional
+	Resources *InventoryResources `json:"resources,omitempty"`
+	// TerraformResourceAddresses are the addresses of the existing resources in the Terraform state which is stored
+	// next to the inventory, e.g. "module.network.aws_vpc.main". The IDs of the resources are read from the state.
+	// IDs given in Resources take precedence.
+	// +optional
+	TerraformResourceAddresses *InventoryResources `json:"terraformResourceAddresses,omitempty"`
+}
+
+// InventoryResources are the existing resources of an infrastructure by purpose.
+type InventoryResources struct {
+	// VPC is the VPC.
+	// +optional
+	VPC *string `json:"vpc,omitempty"`
+	// DHCPOptions are the DHCP options of the VPC.
+	// +optional
+	DHCPOptions *string `json:"dhcpOptions,omitempty"`
+	// DefaultSecurityGroup is the default security group of the VPC.
+	// +optional
+	DefaultSecurityGroup *string `json:"defaultSecurityGroup,omitempty"`
+	// InternetGateway is the internet gateway attached to the VPC.
+	// +optional
+	InternetGateway *string `json:"internetGateway,omitempty"`
+	// EgressOnlyInternetGateway is the egress-only internet gateway attached to the VPC.
+	// +optional
+	EgressOnlyInternetGateway *string `json:"egressOnlyInternetGateway,omitempty"`
+	// MainRouteTable is the route table of the public subnets.
+	// +optional
+	MainRouteTable *string `json:"mainRouteTable,omitempty"`
+	// NodesSecurityGroup is the security group of the nodes.
+	// +optional
+	NodesSecurityGroup *string `json:"nodesSecurityGroup,omitempty"`
+	// VPCEndpoints are the gateway endpoints of the VPC by the name of the service, e.g. "s3".
+	// +optional
+	VPCEndpoints map[string]string `json:"vpcEndpoints,omitempty"`
+	// Zones are the resources of the zones.
+	// +optional
+	Zones []ZoneInventoryResources `json:"zones,omitempty"`
+}
+
+// ZoneInventoryResources are the existing resources of a zone of an infrastructure by purpose.
+type ZoneInventoryResources struct {
+	// Name is the name of the zone.
+	Name string `json:"name"`
+	// WorkersSubnet is the subnet of the nodes.
+	// +optional
+	WorkersSubnet *string `json:"workersSubnet,omitempty"`
+	// InternalSubnet is the private utility subnet.
+	// +optional
+	InternalSubnet *string `json:"internalSubnet,omitempty"`
+	// PublicSubnet is the public utility subnet.
+	// +optional
+	PublicSubnet *string `json:"publicSubnet,omitempty"`
+	// NATGateway is the NAT gateway.
+	// +optional
+	NATGateway *string `json:"natGateway,omitempty"`
+	// NATGatewayElasticIP is the allocation ID of the elastic IP of the NAT gateway.
+	// +optional
+	NATGatewayElasticIP *string `json:"natGatewayElasticIP,omitempty"`
+	// RouteTable is the route table of the workers and internal subnets.
+	// +optional
+	RouteTable *string `json:"routeTable,omitempty"`
+}
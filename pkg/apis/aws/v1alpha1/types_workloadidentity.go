@@ -17,4 +17,26 @@ type WorkloadIdentityConfig struct {
 
 	// RoleARN is the identifier of the role that the workload identity will assume.
 	RoleARN string `json:"roleARN,omitempty"`
+	// AssumeRoleChain is a list of roles which are assumed one after another after the role given by RoleARN, each
+	// with the credentials of the previous one.
+	// +optional
+	AssumeRoleChain []AssumeRole `json:"assumeRoleChain,omitempty"`
+}
+
+// AssumeRole is a role which is assumed with the credentials of the previous hop of a role chain.
+type AssumeRole struct {
+	// RoleARN is the identifier of the role.
+	RoleARN string `json:"roleARN"`
+	// ExternalID is the external ID required by the trust policy of the role.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+	// SessionName is the name of the role session.
+	// +optional
+	SessionName *string `json:"sessionName,omitempty"`
+	// Duration is the duration of the role session.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// SessionPolicy is an inline policy which further restricts the permissions of the role session.
+	// +optional
+	SessionPolicy *string `json:"sessionPolicy,omitempty"`
 }
@@ -15,6 +15,7 @@ import (
 	aws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -26,6 +27,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIConfig)(nil), (*aws.APIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIConfig_To_aws_APIConfig(a.(*APIConfig), b.(*aws.APIConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.APIConfig)(nil), (*APIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_APIConfig_To_v1alpha1_APIConfig(a.(*aws.APIConfig), b.(*APIConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AssumeRole)(nil), (*aws.AssumeRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AssumeRole_To_aws_AssumeRole(a.(*AssumeRole), b.(*aws.AssumeRole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.AssumeRole)(nil), (*AssumeRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_AssumeRole_To_v1alpha1_AssumeRole(a.(*aws.AssumeRole), b.(*AssumeRole), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*aws.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(a.(*BackupBucketConfig), b.(*aws.BackupBucketConfig), scope)
 	}); err != nil {
@@ -96,6 +117,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeletionBlocker)(nil), (*aws.DeletionBlocker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeletionBlocker_To_aws_DeletionBlocker(a.(*DeletionBlocker), b.(*aws.DeletionBlocker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DeletionBlocker)(nil), (*DeletionBlocker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DeletionBlocker_To_v1alpha1_DeletionBlocker(a.(*aws.DeletionBlocker), b.(*DeletionBlocker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeletionPolicy)(nil), (*aws.DeletionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeletionPolicy_To_aws_DeletionPolicy(a.(*DeletionPolicy), b.(*aws.DeletionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DeletionPolicy)(nil), (*DeletionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DeletionPolicy_To_v1alpha1_DeletionPolicy(a.(*aws.DeletionPolicy), b.(*DeletionPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DualStack)(nil), (*aws.DualStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DualStack_To_aws_DualStack(a.(*DualStack), b.(*aws.DualStack), scope)
 	}); err != nil {
@@ -116,6 +157,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ECRConfig)(nil), (*aws.ECRConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ECRConfig_To_aws_ECRConfig(a.(*ECRConfig), b.(*aws.ECRConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ECRConfig)(nil), (*ECRConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ECRConfig_To_v1alpha1_ECRConfig(a.(*aws.ECRConfig), b.(*ECRConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ECRRegistry)(nil), (*aws.ECRRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ECRRegistry_To_aws_ECRRegistry(a.(*ECRRegistry), b.(*aws.ECRRegistry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ECRRegistry)(nil), (*ECRRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ECRRegistry_To_v1alpha1_ECRRegistry(a.(*aws.ECRRegistry), b.(*ECRRegistry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EFSAccessPoint)(nil), (*aws.EFSAccessPoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EFSAccessPoint_To_aws_EFSAccessPoint(a.(*EFSAccessPoint), b.(*aws.EFSAccessPoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.EFSAccessPoint)(nil), (*EFSAccessPoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EFSAccessPoint_To_v1alpha1_EFSAccessPoint(a.(*aws.EFSAccessPoint), b.(*EFSAccessPoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EFSAccessPointStatus)(nil), (*aws.EFSAccessPointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EFSAccessPointStatus_To_aws_EFSAccessPointStatus(a.(*EFSAccessPointStatus), b.(*aws.EFSAccessPointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.EFSAccessPointStatus)(nil), (*EFSAccessPointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EFSAccessPointStatus_To_v1alpha1_EFSAccessPointStatus(a.(*aws.EFSAccessPointStatus), b.(*EFSAccessPointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EFSLifecyclePolicy)(nil), (*aws.EFSLifecyclePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EFSLifecyclePolicy_To_aws_EFSLifecyclePolicy(a.(*EFSLifecyclePolicy), b.(*aws.EFSLifecyclePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.EFSLifecyclePolicy)(nil), (*EFSLifecyclePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EFSLifecyclePolicy_To_v1alpha1_EFSLifecyclePolicy(a.(*aws.EFSLifecyclePolicy), b.(*EFSLifecyclePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EFSPosixUser)(nil), (*aws.EFSPosixUser)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EFSPosixUser_To_aws_EFSPosixUser(a.(*EFSPosixUser), b.(*aws.EFSPosixUser), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.EFSPosixUser)(nil), (*EFSPosixUser)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EFSPosixUser_To_v1alpha1_EFSPosixUser(a.(*aws.EFSPosixUser), b.(*EFSPosixUser), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Egress)(nil), (*aws.Egress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Egress_To_aws_Egress(a.(*Egress), b.(*aws.Egress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Egress)(nil), (*Egress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Egress_To_v1alpha1_Egress(a.(*aws.Egress), b.(*Egress), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ElasticFileSystemConfig)(nil), (*aws.ElasticFileSystemConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig(a.(*ElasticFileSystemConfig), b.(*aws.ElasticFileSystemConfig), scope)
 	}); err != nil {
@@ -136,6 +247,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ElasticIPPool)(nil), (*aws.ElasticIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(a.(*ElasticIPPool), b.(*aws.ElasticIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ElasticIPPool)(nil), (*ElasticIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(a.(*aws.ElasticIPPool), b.(*ElasticIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FSxConfig)(nil), (*aws.FSxConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FSxConfig_To_aws_FSxConfig(a.(*FSxConfig), b.(*aws.FSxConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.FSxConfig)(nil), (*FSxConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_FSxConfig_To_v1alpha1_FSxConfig(a.(*aws.FSxConfig), b.(*FSxConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FSxLustreConfig)(nil), (*aws.FSxLustreConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FSxLustreConfig_To_aws_FSxLustreConfig(a.(*FSxLustreConfig), b.(*aws.FSxLustreConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.FSxLustreConfig)(nil), (*FSxLustreConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_FSxLustreConfig_To_v1alpha1_FSxLustreConfig(a.(*aws.FSxLustreConfig), b.(*FSxLustreConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FSxOpenZFSConfig)(nil), (*aws.FSxOpenZFSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FSxOpenZFSConfig_To_aws_FSxOpenZFSConfig(a.(*FSxOpenZFSConfig), b.(*aws.FSxOpenZFSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.FSxOpenZFSConfig)(nil), (*FSxOpenZFSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_FSxOpenZFSConfig_To_v1alpha1_FSxOpenZFSConfig(a.(*aws.FSxOpenZFSConfig), b.(*FSxOpenZFSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FSxStatus)(nil), (*aws.FSxStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FSxStatus_To_aws_FSxStatus(a.(*FSxStatus), b.(*aws.FSxStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.FSxStatus)(nil), (*FSxStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_FSxStatus_To_v1alpha1_FSxStatus(a.(*aws.FSxStatus), b.(*FSxStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlowLogs)(nil), (*aws.FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlowLogs_To_aws_FlowLogs(a.(*FlowLogs), b.(*aws.FlowLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.FlowLogs)(nil), (*FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_FlowLogs_To_v1alpha1_FlowLogs(a.(*aws.FlowLogs), b.(*FlowLogs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IAM)(nil), (*aws.IAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IAM_To_aws_IAM(a.(*IAM), b.(*aws.IAM), scope)
 	}); err != nil {
@@ -196,6 +367,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InfrastructureInventory)(nil), (*aws.InfrastructureInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureInventory_To_aws_InfrastructureInventory(a.(*InfrastructureInventory), b.(*aws.InfrastructureInventory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InfrastructureInventory)(nil), (*InfrastructureInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InfrastructureInventory_To_v1alpha1_InfrastructureInventory(a.(*aws.InfrastructureInventory), b.(*InfrastructureInventory), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureState)(nil), (*aws.InfrastructureState)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureState_To_aws_InfrastructureState(a.(*InfrastructureState), b.(*aws.InfrastructureState), scope)
 	}); err != nil {
@@ -236,6 +417,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InventoryResources)(nil), (*aws.InventoryResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InventoryResources_To_aws_InventoryResources(a.(*InventoryResources), b.(*aws.InventoryResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InventoryResources)(nil), (*InventoryResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InventoryResources_To_v1alpha1_InventoryResources(a.(*aws.InventoryResources), b.(*InventoryResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerAccessLogs)(nil), (*aws.LoadBalancerAccessLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(a.(*LoadBalancerAccessLogs), b.(*aws.LoadBalancerAccessLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerAccessLogs)(nil), (*LoadBalancerAccessLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(a.(*aws.LoadBalancerAccessLogs), b.(*LoadBalancerAccessLogs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadBalancerControllerConfig)(nil), (*aws.LoadBalancerControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(a.(*LoadBalancerControllerConfig), b.(*aws.LoadBalancerControllerConfig), scope)
 	}); err != nil {
@@ -286,6 +487,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkACL)(nil), (*aws.NetworkACL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkACL_To_aws_NetworkACL(a.(*NetworkACL), b.(*aws.NetworkACL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkACL)(nil), (*NetworkACL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkACL_To_v1alpha1_NetworkACL(a.(*aws.NetworkACL), b.(*NetworkACL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkACLRule)(nil), (*aws.NetworkACLRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(a.(*NetworkACLRule), b.(*aws.NetworkACLRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkACLRule)(nil), (*NetworkACLRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(a.(*aws.NetworkACLRule), b.(*NetworkACLRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkACLs)(nil), (*aws.NetworkACLs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(a.(*NetworkACLs), b.(*aws.NetworkACLs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkACLs)(nil), (*NetworkACLs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(a.(*aws.NetworkACLs), b.(*NetworkACLs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Networks)(nil), (*aws.Networks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Networks_To_aws_Networks(a.(*Networks), b.(*aws.Networks), scope)
 	}); err != nil {
@@ -316,6 +547,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecondaryElasticIPs)(nil), (*aws.SecondaryElasticIPs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecondaryElasticIPs_To_aws_SecondaryElasticIPs(a.(*SecondaryElasticIPs), b.(*aws.SecondaryElasticIPs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SecondaryElasticIPs)(nil), (*SecondaryElasticIPs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SecondaryElasticIPs_To_v1alpha1_SecondaryElasticIPs(a.(*aws.SecondaryElasticIPs), b.(*SecondaryElasticIPs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*aws.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(a.(*SecurityGroup), b.(*aws.SecurityGroup), scope)
 	}); err != nil {
@@ -326,6 +567,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServiceLoadBalancerDefaults)(nil), (*aws.ServiceLoadBalancerDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceLoadBalancerDefaults_To_aws_ServiceLoadBalancerDefaults(a.(*ServiceLoadBalancerDefaults), b.(*aws.ServiceLoadBalancerDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ServiceLoadBalancerDefaults)(nil), (*ServiceLoadBalancerDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ServiceLoadBalancerDefaults_To_v1alpha1_ServiceLoadBalancerDefaults(a.(*aws.ServiceLoadBalancerDefaults), b.(*ServiceLoadBalancerDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*aws.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Storage_To_aws_Storage(a.(*Storage), b.(*aws.Storage), scope)
 	}); err != nil {
@@ -416,9 +667,71 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ZoneInventoryResources)(nil), (*aws.ZoneInventoryResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneInventoryResources_To_aws_ZoneInventoryResources(a.(*ZoneInventoryResources), b.(*aws.ZoneInventoryResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ZoneInventoryResources)(nil), (*ZoneInventoryResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ZoneInventoryResources_To_v1alpha1_ZoneInventoryResources(a.(*aws.ZoneInventoryResources), b.(*ZoneInventoryResources), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
+func autoConvert_v1alpha1_APIConfig_To_aws_APIConfig(in *APIConfig, out *aws.APIConfig, s conversion.Scope) error {
+	out.Partition = (*string)(unsafe.Pointer(in.Partition))
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	out.UseFIPSEndpoints = (*bool)(unsafe.Pointer(in.UseFIPSEndpoints))
+	return nil
+}
+
+// Convert_v1alpha1_APIConfig_To_aws_APIConfig is an autogenerated conversion function.
+func Convert_v1alpha1_APIConfig_To_aws_APIConfig(in *APIConfig, out *aws.APIConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIConfig_To_aws_APIConfig(in, out, s)
+}
+
+func autoConvert_aws_APIConfig_To_v1alpha1_APIConfig(in *aws.APIConfig, out *APIConfig, s conversion.Scope) error {
+	out.Partition = (*string)(unsafe.Pointer(in.Partition))
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	out.UseFIPSEndpoints = (*bool)(unsafe.Pointer(in.UseFIPSEndpoints))
+	return nil
+}
+
+// Convert_aws_APIConfig_To_v1alpha1_APIConfig is an autogenerated conversion function.
+func Convert_aws_APIConfig_To_v1alpha1_APIConfig(in *aws.APIConfig, out *APIConfig, s conversion.Scope) error {
+	return autoConvert_aws_APIConfig_To_v1alpha1_APIConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_AssumeRole_To_aws_AssumeRole(in *AssumeRole, out *aws.AssumeRole, s conversion.Scope) error {
+	out.RoleARN = in.RoleARN
+	out.ExternalID = (*string)(unsafe.Pointer(in.ExternalID))
+	out.SessionName = (*string)(unsafe.Pointer(in.SessionName))
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	out.SessionPolicy = (*string)(unsafe.Pointer(in.SessionPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_AssumeRole_To_aws_AssumeRole is an autogenerated conversion function.
+func Convert_v1alpha1_AssumeRole_To_aws_AssumeRole(in *AssumeRole, out *aws.AssumeRole, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AssumeRole_To_aws_AssumeRole(in, out, s)
+}
+
+func autoConvert_aws_AssumeRole_To_v1alpha1_AssumeRole(in *aws.AssumeRole, out *AssumeRole, s conversion.Scope) error {
+	out.RoleARN = in.RoleARN
+	out.ExternalID = (*string)(unsafe.Pointer(in.ExternalID))
+	out.SessionName = (*string)(unsafe.Pointer(in.SessionName))
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	out.SessionPolicy = (*string)(unsafe.Pointer(in.SessionPolicy))
+	return nil
+}
+
+// Convert_aws_AssumeRole_To_v1alpha1_AssumeRole is an autogenerated conversion function.
+func Convert_aws_AssumeRole_To_v1alpha1_AssumeRole(in *aws.AssumeRole, out *AssumeRole, s conversion.Scope) error {
+	return autoConvert_aws_AssumeRole_To_v1alpha1_AssumeRole(in, out, s)
+}
+
 func autoConvert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in *BackupBucketConfig, out *aws.BackupBucketConfig, s conversion.Scope) error {
 	out.Immutability = (*aws.ImmutableConfig)(unsafe.Pointer(in.Immutability))
 	return nil
@@ -487,6 +800,7 @@ func Convert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManager
 
 func autoConvert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in *CloudProfileConfig, out *aws.CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]aws.MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.API = (*aws.APIConfig)(unsafe.Pointer(in.API))
 	return nil
 }
 
@@ -497,6 +811,7 @@ func Convert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in *CloudProf
 
 func autoConvert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *aws.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.API = (*APIConfig)(unsafe.Pointer(in.API))
 	return nil
 }
 
@@ -509,6 +824,7 @@ func autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *Contr
 	out.CloudControllerManager = (*aws.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.LoadBalancerController = (*aws.LoadBalancerControllerConfig)(unsafe.Pointer(in.LoadBalancerController))
 	out.Storage = (*aws.Storage)(unsafe.Pointer(in.Storage))
+	out.ServiceLoadBalancerDefaults = (*aws.ServiceLoadBalancerDefaults)(unsafe.Pointer(in.ServiceLoadBalancerDefaults))
 	return nil
 }
 
@@ -521,6 +837,7 @@ func autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.C
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.LoadBalancerController = (*LoadBalancerControllerConfig)(unsafe.Pointer(in.LoadBalancerController))
 	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.ServiceLoadBalancerDefaults = (*ServiceLoadBalancerDefaults)(unsafe.Pointer(in.ServiceLoadBalancerDefaults))
 	return nil
 }
 
@@ -581,6 +898,56 @@ func Convert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *Data
 	return autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeletionBlocker_To_aws_DeletionBlocker(in *DeletionBlocker, out *aws.DeletionBlocker, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ID = in.ID
+	out.Owner = in.Owner
+	out.Description = in.Description
+	out.Dependency = in.Dependency
+	return nil
+}
+
+// Convert_v1alpha1_DeletionBlocker_To_aws_DeletionBlocker is an autogenerated conversion function.
+func Convert_v1alpha1_DeletionBlocker_To_aws_DeletionBlocker(in *DeletionBlocker, out *aws.DeletionBlocker, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeletionBlocker_To_aws_DeletionBlocker(in, out, s)
+}
+
+func autoConvert_aws_DeletionBlocker_To_v1alpha1_DeletionBlocker(in *aws.DeletionBlocker, out *DeletionBlocker, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ID = in.ID
+	out.Owner = in.Owner
+	out.Description = in.Description
+	out.Dependency = in.Dependency
+	return nil
+}
+
+// Convert_aws_DeletionBlocker_To_v1alpha1_DeletionBlocker is an autogenerated conversion function.
+func Convert_aws_DeletionBlocker_To_v1alpha1_DeletionBlocker(in *aws.DeletionBlocker, out *DeletionBlocker, s conversion.Scope) error {
+	return autoConvert_aws_DeletionBlocker_To_v1alpha1_DeletionBlocker(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeletionPolicy_To_aws_DeletionPolicy(in *DeletionPolicy, out *aws.DeletionPolicy, s conversion.Scope) error {
+	out.Retain = *(*[]aws.RetainedResource)(unsafe.Pointer(&in.Retain))
+	out.RequireConfirmation = in.RequireConfirmation
+	return nil
+}
+
+// Convert_v1alpha1_DeletionPolicy_To_aws_DeletionPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_DeletionPolicy_To_aws_DeletionPolicy(in *DeletionPolicy, out *aws.DeletionPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeletionPolicy_To_aws_DeletionPolicy(in, out, s)
+}
+
+func autoConvert_aws_DeletionPolicy_To_v1alpha1_DeletionPolicy(in *aws.DeletionPolicy, out *DeletionPolicy, s conversion.Scope) error {
+	out.Retain = *(*[]RetainedResource)(unsafe.Pointer(&in.Retain))
+	out.RequireConfirmation = in.RequireConfirmation
+	return nil
+}
+
+// Convert_aws_DeletionPolicy_To_v1alpha1_DeletionPolicy is an autogenerated conversion function.
+func Convert_aws_DeletionPolicy_To_v1alpha1_DeletionPolicy(in *aws.DeletionPolicy, out *DeletionPolicy, s conversion.Scope) error {
+	return autoConvert_aws_DeletionPolicy_To_v1alpha1_DeletionPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_DualStack_To_aws_DualStack(in *DualStack, out *aws.DualStack, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -621,9 +988,178 @@ func Convert_aws_EC2_To_v1alpha1_EC2(in *aws.EC2, out *EC2, s conversion.Scope)
 	return autoConvert_aws_EC2_To_v1alpha1_EC2(in, out, s)
 }
 
+func autoConvert_v1alpha1_ECRConfig_To_aws_ECRConfig(in *ECRConfig, out *aws.ECRConfig, s conversion.Scope) error {
+	out.CacheDuration = (*v1.Duration)(unsafe.Pointer(in.CacheDuration))
+	out.Registries = *(*[]aws.ECRRegistry)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_v1alpha1_ECRConfig_To_aws_ECRConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ECRConfig_To_aws_ECRConfig(in *ECRConfig, out *aws.ECRConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ECRConfig_To_aws_ECRConfig(in, out, s)
+}
+
+func autoConvert_aws_ECRConfig_To_v1alpha1_ECRConfig(in *aws.ECRConfig, out *ECRConfig, s conversion.Scope) error {
+	out.CacheDuration = (*v1.Duration)(unsafe.Pointer(in.CacheDuration))
+	out.Registries = *(*[]ECRRegistry)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_aws_ECRConfig_To_v1alpha1_ECRConfig is an autogenerated conversion function.
+func Convert_aws_ECRConfig_To_v1alpha1_ECRConfig(in *aws.ECRConfig, out *ECRConfig, s conversion.Scope) error {
+	return autoConvert_aws_ECRConfig_To_v1alpha1_ECRConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ECRRegistry_To_aws_ECRRegistry(in *ECRRegistry, out *aws.ECRRegistry, s conversion.Scope) error {
+	out.MatchImages = *(*[]string)(unsafe.Pointer(&in.MatchImages))
+	out.RoleARN = (*string)(unsafe.Pointer(in.RoleARN))
+	return nil
+}
+
+// Convert_v1alpha1_ECRRegistry_To_aws_ECRRegistry is an autogenerated conversion function.
+func Convert_v1alpha1_ECRRegistry_To_aws_ECRRegistry(in *ECRRegistry, out *aws.ECRRegistry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ECRRegistry_To_aws_ECRRegistry(in, out, s)
+}
+
+func autoConvert_aws_ECRRegistry_To_v1alpha1_ECRRegistry(in *aws.ECRRegistry, out *ECRRegistry, s conversion.Scope) error {
+	out.MatchImages = *(*[]string)(unsafe.Pointer(&in.MatchImages))
+	out.RoleARN = (*string)(unsafe.Pointer(in.RoleARN))
+	return nil
+}
+
+// Convert_aws_ECRRegistry_To_v1alpha1_ECRRegistry is an autogenerated conversion function.
+func Convert_aws_ECRRegistry_To_v1alpha1_ECRRegistry(in *aws.ECRRegistry, out *ECRRegistry, s conversion.Scope) error {
+	return autoConvert_aws_ECRRegistry_To_v1alpha1_ECRRegistry(in, out, s)
+}
+
+func autoConvert_v1alpha1_EFSAccessPoint_To_aws_EFSAccessPoint(in *EFSAccessPoint, out *aws.EFSAccessPoint, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.PosixUser = (*aws.EFSPosixUser)(unsafe.Pointer(in.PosixUser))
+	out.Permissions = (*string)(unsafe.Pointer(in.Permissions))
+	return nil
+}
+
+// Convert_v1alpha1_EFSAccessPoint_To_aws_EFSAccessPoint is an autogenerated conversion function.
+func Convert_v1alpha1_EFSAccessPoint_To_aws_EFSAccessPoint(in *EFSAccessPoint, out *aws.EFSAccessPoint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EFSAccessPoint_To_aws_EFSAccessPoint(in, out, s)
+}
+
+func autoConvert_aws_EFSAccessPoint_To_v1alpha1_EFSAccessPoint(in *aws.EFSAccessPoint, out *EFSAccessPoint, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.PosixUser = (*EFSPosixUser)(unsafe.Pointer(in.PosixUser))
+	out.Permissions = (*string)(unsafe.Pointer(in.Permissions))
+	return nil
+}
+
+// Convert_aws_EFSAccessPoint_To_v1alpha1_EFSAccessPoint is an autogenerated conversion function.
+func Convert_aws_EFSAccessPoint_To_v1alpha1_EFSAccessPoint(in *aws.EFSAccessPoint, out *EFSAccessPoint, s conversion.Scope) error {
+	return autoConvert_aws_EFSAccessPoint_To_v1alpha1_EFSAccessPoint(in, out, s)
+}
+
+func autoConvert_v1alpha1_EFSAccessPointStatus_To_aws_EFSAccessPointStatus(in *EFSAccessPointStatus, out *aws.EFSAccessPointStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_EFSAccessPointStatus_To_aws_EFSAccessPointStatus is an autogenerated conversion function.
+func Convert_v1alpha1_EFSAccessPointStatus_To_aws_EFSAccessPointStatus(in *EFSAccessPointStatus, out *aws.EFSAccessPointStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EFSAccessPointStatus_To_aws_EFSAccessPointStatus(in, out, s)
+}
+
+func autoConvert_aws_EFSAccessPointStatus_To_v1alpha1_EFSAccessPointStatus(in *aws.EFSAccessPointStatus, out *EFSAccessPointStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_aws_EFSAccessPointStatus_To_v1alpha1_EFSAccessPointStatus is an autogenerated conversion function.
+func Convert_aws_EFSAccessPointStatus_To_v1alpha1_EFSAccessPointStatus(in *aws.EFSAccessPointStatus, out *EFSAccessPointStatus, s conversion.Scope) error {
+	return autoConvert_aws_EFSAccessPointStatus_To_v1alpha1_EFSAccessPointStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_EFSLifecyclePolicy_To_aws_EFSLifecyclePolicy(in *EFSLifecyclePolicy, out *aws.EFSLifecyclePolicy, s conversion.Scope) error {
+	out.TransitionToIA = (*string)(unsafe.Pointer(in.TransitionToIA))
+	out.TransitionToArchive = (*string)(unsafe.Pointer(in.TransitionToArchive))
+	out.TransitionToPrimaryStorageClass = (*string)(unsafe.Pointer(in.TransitionToPrimaryStorageClass))
+	return nil
+}
+
+// Convert_v1alpha1_EFSLifecyclePolicy_To_aws_EFSLifecyclePolicy is an autogenerated conversion function.
+func Convert_v1alpha1_EFSLifecyclePolicy_To_aws_EFSLifecyclePolicy(in *EFSLifecyclePolicy, out *aws.EFSLifecyclePolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EFSLifecyclePolicy_To_aws_EFSLifecyclePolicy(in, out, s)
+}
+
+func autoConvert_aws_EFSLifecyclePolicy_To_v1alpha1_EFSLifecyclePolicy(in *aws.EFSLifecyclePolicy, out *EFSLifecyclePolicy, s conversion.Scope) error {
+	out.TransitionToIA = (*string)(unsafe.Pointer(in.TransitionToIA))
+	out.TransitionToArchive = (*string)(unsafe.Pointer(in.TransitionToArchive))
+	out.TransitionToPrimaryStorageClass = (*string)(unsafe.Pointer(in.TransitionToPrimaryStorageClass))
+	return nil
+}
+
+// Convert_aws_EFSLifecyclePolicy_To_v1alpha1_EFSLifecyclePolicy is an autogenerated conversion function.
+func Convert_aws_EFSLifecyclePolicy_To_v1alpha1_EFSLifecyclePolicy(in *aws.EFSLifecyclePolicy, out *EFSLifecyclePolicy, s conversion.Scope) error {
+	return autoConvert_aws_EFSLifecyclePolicy_To_v1alpha1_EFSLifecyclePolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_EFSPosixUser_To_aws_EFSPosixUser(in *EFSPosixUser, out *aws.EFSPosixUser, s conversion.Scope) error {
+	out.UID = in.UID
+	out.GID = in.GID
+	out.SecondaryGIDs = *(*[]int64)(unsafe.Pointer(&in.SecondaryGIDs))
+	return nil
+}
+
+// Convert_v1alpha1_EFSPosixUser_To_aws_EFSPosixUser is an autogenerated conversion function.
+func Convert_v1alpha1_EFSPosixUser_To_aws_EFSPosixUser(in *EFSPosixUser, out *aws.EFSPosixUser, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EFSPosixUser_To_aws_EFSPosixUser(in, out, s)
+}
+
+func autoConvert_aws_EFSPosixUser_To_v1alpha1_EFSPosixUser(in *aws.EFSPosixUser, out *EFSPosixUser, s conversion.Scope) error {
+	out.UID = in.UID
+	out.GID = in.GID
+	out.SecondaryGIDs = *(*[]int64)(unsafe.Pointer(&in.SecondaryGIDs))
+	return nil
+}
+
+// Convert_aws_EFSPosixUser_To_v1alpha1_EFSPosixUser is an autogenerated conversion function.
+func Convert_aws_EFSPosixUser_To_v1alpha1_EFSPosixUser(in *aws.EFSPosixUser, out *EFSPosixUser, s conversion.Scope) error {
+	return autoConvert_aws_EFSPosixUser_To_v1alpha1_EFSPosixUser(in, out, s)
+}
+
+func autoConvert_v1alpha1_Egress_To_aws_Egress(in *Egress, out *aws.Egress, s conversion.Scope) error {
+	out.Mode = aws.EgressMode(in.Mode)
+	out.NATGatewayZone = (*string)(unsafe.Pointer(in.NATGatewayZone))
+	return nil
+}
+
+// Convert_v1alpha1_Egress_To_aws_Egress is an autogenerated conversion function.
+func Convert_v1alpha1_Egress_To_aws_Egress(in *Egress, out *aws.Egress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Egress_To_aws_Egress(in, out, s)
+}
+
+func autoConvert_aws_Egress_To_v1alpha1_Egress(in *aws.Egress, out *Egress, s conversion.Scope) error {
+	out.Mode = EgressMode(in.Mode)
+	out.NATGatewayZone = (*string)(unsafe.Pointer(in.NATGatewayZone))
+	return nil
+}
+
+// Convert_aws_Egress_To_v1alpha1_Egress is an autogenerated conversion function.
+func Convert_aws_Egress_To_v1alpha1_Egress(in *aws.Egress, out *Egress, s conversion.Scope) error {
+	return autoConvert_aws_Egress_To_v1alpha1_Egress(in, out, s)
+}
+
 func autoConvert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig(in *ElasticFileSystemConfig, out *aws.ElasticFileSystemConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.PerformanceMode = (*aws.EFSPerformanceMode)(unsafe.Pointer(in.PerformanceMode))
+	out.ThroughputMode = (*aws.EFSThroughputMode)(unsafe.Pointer(in.ThroughputMode))
+	out.ProvisionedThroughputInMibps = (*int32)(unsafe.Pointer(in.ProvisionedThroughputInMibps))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	out.LifecyclePolicy = (*aws.EFSLifecyclePolicy)(unsafe.Pointer(in.LifecyclePolicy))
+	out.AutomaticBackups = (*bool)(unsafe.Pointer(in.AutomaticBackups))
+	out.AccessPoints = *(*[]aws.EFSAccessPoint)(unsafe.Pointer(&in.AccessPoints))
 	return nil
 }
 
@@ -635,6 +1171,13 @@ func Convert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig(in
 func autoConvert_aws_ElasticFileSystemConfig_To_v1alpha1_ElasticFileSystemConfig(in *aws.ElasticFileSystemConfig, out *ElasticFileSystemConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.PerformanceMode = (*EFSPerformanceMode)(unsafe.Pointer(in.PerformanceMode))
+	out.ThroughputMode = (*EFSThroughputMode)(unsafe.Pointer(in.ThroughputMode))
+	out.ProvisionedThroughputInMibps = (*int32)(unsafe.Pointer(in.ProvisionedThroughputInMibps))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	out.LifecyclePolicy = (*EFSLifecyclePolicy)(unsafe.Pointer(in.LifecyclePolicy))
+	out.AutomaticBackups = (*bool)(unsafe.Pointer(in.AutomaticBackups))
+	out.AccessPoints = *(*[]EFSAccessPoint)(unsafe.Pointer(&in.AccessPoints))
 	return nil
 }
 
@@ -645,6 +1188,7 @@ func Convert_aws_ElasticFileSystemConfig_To_v1alpha1_ElasticFileSystemConfig(in
 
 func autoConvert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus(in *ElasticFileSystemStatus, out *aws.ElasticFileSystemStatus, s conversion.Scope) error {
 	out.ID = in.ID
+	out.AccessPoints = *(*[]aws.EFSAccessPointStatus)(unsafe.Pointer(&in.AccessPoints))
 	return nil
 }
 
@@ -655,6 +1199,7 @@ func Convert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus(in
 
 func autoConvert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(in *aws.ElasticFileSystemStatus, out *ElasticFileSystemStatus, s conversion.Scope) error {
 	out.ID = in.ID
+	out.AccessPoints = *(*[]EFSAccessPointStatus)(unsafe.Pointer(&in.AccessPoints))
 	return nil
 }
 
@@ -663,6 +1208,156 @@ func Convert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(in
 	return autoConvert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(in *ElasticIPPool, out *aws.ElasticIPPool, s conversion.Scope) error {
+	out.PublicIPv4PoolID = (*string)(unsafe.Pointer(in.PublicIPv4PoolID))
+	out.IPAMPoolID = (*string)(unsafe.Pointer(in.IPAMPoolID))
+	return nil
+}
+
+// Convert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool is an autogenerated conversion function.
+func Convert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(in *ElasticIPPool, out *aws.ElasticIPPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(in, out, s)
+}
+
+func autoConvert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(in *aws.ElasticIPPool, out *ElasticIPPool, s conversion.Scope) error {
+	out.PublicIPv4PoolID = (*string)(unsafe.Pointer(in.PublicIPv4PoolID))
+	out.IPAMPoolID = (*string)(unsafe.Pointer(in.IPAMPoolID))
+	return nil
+}
+
+// Convert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool is an autogenerated conversion function.
+func Convert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(in *aws.ElasticIPPool, out *ElasticIPPool, s conversion.Scope) error {
+	return autoConvert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_FSxConfig_To_aws_FSxConfig(in *FSxConfig, out *aws.FSxConfig, s conversion.Scope) error {
+	out.Type = aws.FSxFileSystemType(in.Type)
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.StorageCapacityGiB = in.StorageCapacityGiB
+	out.Lustre = (*aws.FSxLustreConfig)(unsafe.Pointer(in.Lustre))
+	out.OpenZFS = (*aws.FSxOpenZFSConfig)(unsafe.Pointer(in.OpenZFS))
+	return nil
+}
+
+// Convert_v1alpha1_FSxConfig_To_aws_FSxConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FSxConfig_To_aws_FSxConfig(in *FSxConfig, out *aws.FSxConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FSxConfig_To_aws_FSxConfig(in, out, s)
+}
+
+func autoConvert_aws_FSxConfig_To_v1alpha1_FSxConfig(in *aws.FSxConfig, out *FSxConfig, s conversion.Scope) error {
+	out.Type = FSxFileSystemType(in.Type)
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.StorageCapacityGiB = in.StorageCapacityGiB
+	out.Lustre = (*FSxLustreConfig)(unsafe.Pointer(in.Lustre))
+	out.OpenZFS = (*FSxOpenZFSConfig)(unsafe.Pointer(in.OpenZFS))
+	return nil
+}
+
+// Convert_aws_FSxConfig_To_v1alpha1_FSxConfig is an autogenerated conversion function.
+func Convert_aws_FSxConfig_To_v1alpha1_FSxConfig(in *aws.FSxConfig, out *FSxConfig, s conversion.Scope) error {
+	return autoConvert_aws_FSxConfig_To_v1alpha1_FSxConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FSxLustreConfig_To_aws_FSxLustreConfig(in *FSxLustreConfig, out *aws.FSxLustreConfig, s conversion.Scope) error {
+	out.DeploymentType = (*string)(unsafe.Pointer(in.DeploymentType))
+	out.PerUnitStorageThroughput = (*int32)(unsafe.Pointer(in.PerUnitStorageThroughput))
+	return nil
+}
+
+// Convert_v1alpha1_FSxLustreConfig_To_aws_FSxLustreConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FSxLustreConfig_To_aws_FSxLustreConfig(in *FSxLustreConfig, out *aws.FSxLustreConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FSxLustreConfig_To_aws_FSxLustreConfig(in, out, s)
+}
+
+func autoConvert_aws_FSxLustreConfig_To_v1alpha1_FSxLustreConfig(in *aws.FSxLustreConfig, out *FSxLustreConfig, s conversion.Scope) error {
+	out.DeploymentType = (*string)(unsafe.Pointer(in.DeploymentType))
+	out.PerUnitStorageThroughput = (*int32)(unsafe.Pointer(in.PerUnitStorageThroughput))
+	return nil
+}
+
+// Convert_aws_FSxLustreConfig_To_v1alpha1_FSxLustreConfig is an autogenerated conversion function.
+func Convert_aws_FSxLustreConfig_To_v1alpha1_FSxLustreConfig(in *aws.FSxLustreConfig, out *FSxLustreConfig, s conversion.Scope) error {
+	return autoConvert_aws_FSxLustreConfig_To_v1alpha1_FSxLustreConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FSxOpenZFSConfig_To_aws_FSxOpenZFSConfig(in *FSxOpenZFSConfig, out *aws.FSxOpenZFSConfig, s conversion.Scope) error {
+	out.DeploymentType = (*string)(unsafe.Pointer(in.DeploymentType))
+	out.ThroughputCapacity = in.ThroughputCapacity
+	return nil
+}
+
+// Convert_v1alpha1_FSxOpenZFSConfig_To_aws_FSxOpenZFSConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FSxOpenZFSConfig_To_aws_FSxOpenZFSConfig(in *FSxOpenZFSConfig, out *aws.FSxOpenZFSConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FSxOpenZFSConfig_To_aws_FSxOpenZFSConfig(in, out, s)
+}
+
+func autoConvert_aws_FSxOpenZFSConfig_To_v1alpha1_FSxOpenZFSConfig(in *aws.FSxOpenZFSConfig, out *FSxOpenZFSConfig, s conversion.Scope) error {
+	out.DeploymentType = (*string)(unsafe.Pointer(in.DeploymentType))
+	out.ThroughputCapacity = in.ThroughputCapacity
+	return nil
+}
+
+// Convert_aws_FSxOpenZFSConfig_To_v1alpha1_FSxOpenZFSConfig is an autogenerated conversion function.
+func Convert_aws_FSxOpenZFSConfig_To_v1alpha1_FSxOpenZFSConfig(in *aws.FSxOpenZFSConfig, out *FSxOpenZFSConfig, s conversion.Scope) error {
+	return autoConvert_aws_FSxOpenZFSConfig_To_v1alpha1_FSxOpenZFSConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FSxStatus_To_aws_FSxStatus(in *FSxStatus, out *aws.FSxStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Type = aws.FSxFileSystemType(in.Type)
+	out.DNSName = in.DNSName
+	out.MountName = in.MountName
+	out.SecurityGroupID = in.SecurityGroupID
+	return nil
+}
+
+// Convert_v1alpha1_FSxStatus_To_aws_FSxStatus is an autogenerated conversion function.
+func Convert_v1alpha1_FSxStatus_To_aws_FSxStatus(in *FSxStatus, out *aws.FSxStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FSxStatus_To_aws_FSxStatus(in, out, s)
+}
+
+func autoConvert_aws_FSxStatus_To_v1alpha1_FSxStatus(in *aws.FSxStatus, out *FSxStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Type = FSxFileSystemType(in.Type)
+	out.DNSName = in.DNSName
+	out.MountName = in.MountName
+	out.SecurityGroupID = in.SecurityGroupID
+	return nil
+}
+
+// Convert_aws_FSxStatus_To_v1alpha1_FSxStatus is an autogenerated conversion function.
+func Convert_aws_FSxStatus_To_v1alpha1_FSxStatus(in *aws.FSxStatus, out *FSxStatus, s conversion.Scope) error {
+	return autoConvert_aws_FSxStatus_To_v1alpha1_FSxStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_FlowLogs_To_aws_FlowLogs(in *FlowLogs, out *aws.FlowLogs, s conversion.Scope) error {
+	out.S3BucketARN = (*string)(unsafe.Pointer(in.S3BucketARN))
+	out.CloudWatchLogGroup = (*string)(unsafe.Pointer(in.CloudWatchLogGroup))
+	out.TrafficType = (*aws.FlowLogTrafficType)(unsafe.Pointer(in.TrafficType))
+	out.LogFormat = (*string)(unsafe.Pointer(in.LogFormat))
+	out.MaxAggregationInterval = (*int32)(unsafe.Pointer(in.MaxAggregationInterval))
+	return nil
+}
+
+// Convert_v1alpha1_FlowLogs_To_aws_FlowLogs is an autogenerated conversion function.
+func Convert_v1alpha1_FlowLogs_To_aws_FlowLogs(in *FlowLogs, out *aws.FlowLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlowLogs_To_aws_FlowLogs(in, out, s)
+}
+
+func autoConvert_aws_FlowLogs_To_v1alpha1_FlowLogs(in *aws.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	out.S3BucketARN = (*string)(unsafe.Pointer(in.S3BucketARN))
+	out.CloudWatchLogGroup = (*string)(unsafe.Pointer(in.CloudWatchLogGroup))
+	out.TrafficType = (*FlowLogTrafficType)(unsafe.Pointer(in.TrafficType))
+	out.LogFormat = (*string)(unsafe.Pointer(in.LogFormat))
+	out.MaxAggregationInterval = (*int32)(unsafe.Pointer(in.MaxAggregationInterval))
+	return nil
+}
+
+// Convert_aws_FlowLogs_To_v1alpha1_FlowLogs is an autogenerated conversion function.
+func Convert_aws_FlowLogs_To_v1alpha1_FlowLogs(in *aws.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	return autoConvert_aws_FlowLogs_To_v1alpha1_FlowLogs(in, out, s)
+}
+
 func autoConvert_v1alpha1_IAM_To_aws_IAM(in *IAM, out *aws.IAM, s conversion.Scope) error {
 	out.InstanceProfiles = *(*[]aws.InstanceProfile)(unsafe.Pointer(&in.InstanceProfiles))
 	out.Roles = *(*[]aws.Role)(unsafe.Pointer(&in.Roles))
@@ -781,8 +1476,12 @@ func autoConvert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(in *I
 	}
 	out.IgnoreTags = (*aws.IgnoreTags)(unsafe.Pointer(in.IgnoreTags))
 	out.EnableDedicatedTenancyForVPC = (*bool)(unsafe.Pointer(in.EnableDedicatedTenancyForVPC))
+	out.FlowLogs = (*aws.FlowLogs)(unsafe.Pointer(in.FlowLogs))
 	out.ElasticFileSystem = (*aws.ElasticFileSystemConfig)(unsafe.Pointer(in.ElasticFileSystem))
+	out.FSx = (*aws.FSxConfig)(unsafe.Pointer(in.FSx))
 	out.EnableMTUCustomizer = (*bool)(unsafe.Pointer(in.EnableMTUCustomizer))
+	out.ECR = (*aws.ECRConfig)(unsafe.Pointer(in.ECR))
+	out.DeletionPolicy = (*aws.DeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
 	return nil
 }
 
@@ -799,8 +1498,12 @@ func autoConvert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *a
 	}
 	out.IgnoreTags = (*IgnoreTags)(unsafe.Pointer(in.IgnoreTags))
 	out.EnableDedicatedTenancyForVPC = (*bool)(unsafe.Pointer(in.EnableDedicatedTenancyForVPC))
+	out.FlowLogs = (*FlowLogs)(unsafe.Pointer(in.FlowLogs))
 	out.ElasticFileSystem = (*ElasticFileSystemConfig)(unsafe.Pointer(in.ElasticFileSystem))
+	out.FSx = (*FSxConfig)(unsafe.Pointer(in.FSx))
 	out.EnableMTUCustomizer = (*bool)(unsafe.Pointer(in.EnableMTUCustomizer))
+	out.ECR = (*ECRConfig)(unsafe.Pointer(in.ECR))
+	out.DeletionPolicy = (*DeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
 	return nil
 }
 
@@ -809,6 +1512,28 @@ func Convert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *aws.I
 	return autoConvert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_InfrastructureInventory_To_aws_InfrastructureInventory(in *InfrastructureInventory, out *aws.InfrastructureInventory, s conversion.Scope) error {
+	out.Resources = (*aws.InventoryResources)(unsafe.Pointer(in.Resources))
+	out.TerraformResourceAddresses = (*aws.InventoryResources)(unsafe.Pointer(in.TerraformResourceAddresses))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureInventory_To_aws_InfrastructureInventory is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureInventory_To_aws_InfrastructureInventory(in *InfrastructureInventory, out *aws.InfrastructureInventory, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureInventory_To_aws_InfrastructureInventory(in, out, s)
+}
+
+func autoConvert_aws_InfrastructureInventory_To_v1alpha1_InfrastructureInventory(in *aws.InfrastructureInventory, out *InfrastructureInventory, s conversion.Scope) error {
+	out.Resources = (*InventoryResources)(unsafe.Pointer(in.Resources))
+	out.TerraformResourceAddresses = (*InventoryResources)(unsafe.Pointer(in.TerraformResourceAddresses))
+	return nil
+}
+
+// Convert_aws_InfrastructureInventory_To_v1alpha1_InfrastructureInventory is an autogenerated conversion function.
+func Convert_aws_InfrastructureInventory_To_v1alpha1_InfrastructureInventory(in *aws.InfrastructureInventory, out *InfrastructureInventory, s conversion.Scope) error {
+	return autoConvert_aws_InfrastructureInventory_To_v1alpha1_InfrastructureInventory(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureState_To_aws_InfrastructureState(in *InfrastructureState, out *aws.InfrastructureState, s conversion.Scope) error {
 	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
 	return nil
@@ -842,6 +1567,8 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(in *I
 	if err := Convert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus(&in.ElasticFileSystem, &out.ElasticFileSystem, s); err != nil {
 		return err
 	}
+	out.FSx = (*aws.FSxStatus)(unsafe.Pointer(in.FSx))
+	out.DeletionBlockers = *(*[]aws.DeletionBlocker)(unsafe.Pointer(&in.DeletionBlockers))
 	return nil
 }
 
@@ -863,6 +1590,8 @@ func autoConvert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *a
 	if err := Convert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(&in.ElasticFileSystem, &out.ElasticFileSystem, s); err != nil {
 		return err
 	}
+	out.FSx = (*FSxStatus)(unsafe.Pointer(in.FSx))
+	out.DeletionBlockers = *(*[]DeletionBlocker)(unsafe.Pointer(&in.DeletionBlockers))
 	return nil
 }
 
@@ -915,9 +1644,73 @@ func Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstancePro
 	return autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in, out, s)
 }
 
+func autoConvert_v1alpha1_InventoryResources_To_aws_InventoryResources(in *InventoryResources, out *aws.InventoryResources, s conversion.Scope) error {
+	out.VPC = (*string)(unsafe.Pointer(in.VPC))
+	out.DHCPOptions = (*string)(unsafe.Pointer(in.DHCPOptions))
+	out.DefaultSecurityGroup = (*string)(unsafe.Pointer(in.DefaultSecurityGroup))
+	out.InternetGateway = (*string)(unsafe.Pointer(in.InternetGateway))
+	out.EgressOnlyInternetGateway = (*string)(unsafe.Pointer(in.EgressOnlyInternetGateway))
+	out.MainRouteTable = (*string)(unsafe.Pointer(in.MainRouteTable))
+	out.NodesSecurityGroup = (*string)(unsafe.Pointer(in.NodesSecurityGroup))
+	out.VPCEndpoints = *(*map[string]string)(unsafe.Pointer(&in.VPCEndpoints))
+	out.Zones = *(*[]aws.ZoneInventoryResources)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_v1alpha1_InventoryResources_To_aws_InventoryResources is an autogenerated conversion function.
+func Convert_v1alpha1_InventoryResources_To_aws_InventoryResources(in *InventoryResources, out *aws.InventoryResources, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InventoryResources_To_aws_InventoryResources(in, out, s)
+}
+
+func autoConvert_aws_InventoryResources_To_v1alpha1_InventoryResources(in *aws.InventoryResources, out *InventoryResources, s conversion.Scope) error {
+	out.VPC = (*string)(unsafe.Pointer(in.VPC))
+	out.DHCPOptions = (*string)(unsafe.Pointer(in.DHCPOptions))
+	out.DefaultSecurityGroup = (*string)(unsafe.Pointer(in.DefaultSecurityGroup))
+	out.InternetGateway = (*string)(unsafe.Pointer(in.InternetGateway))
+	out.EgressOnlyInternetGateway = (*string)(unsafe.Pointer(in.EgressOnlyInternetGateway))
+	out.MainRouteTable = (*string)(unsafe.Pointer(in.MainRouteTable))
+	out.NodesSecurityGroup = (*string)(unsafe.Pointer(in.NodesSecurityGroup))
+	out.VPCEndpoints = *(*map[string]string)(unsafe.Pointer(&in.VPCEndpoints))
+	out.Zones = *(*[]ZoneInventoryResources)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_aws_InventoryResources_To_v1alpha1_InventoryResources is an autogenerated conversion function.
+func Convert_aws_InventoryResources_To_v1alpha1_InventoryResources(in *aws.InventoryResources, out *InventoryResources, s conversion.Scope) error {
+	return autoConvert_aws_InventoryResources_To_v1alpha1_InventoryResources(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(in *LoadBalancerAccessLogs, out *aws.LoadBalancerAccessLogs, s conversion.Scope) error {
+	out.BucketName = in.BucketName
+	out.BucketPrefix = (*string)(unsafe.Pointer(in.BucketPrefix))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(in *LoadBalancerAccessLogs, out *aws.LoadBalancerAccessLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(in, out, s)
+}
+
+func autoConvert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(in *aws.LoadBalancerAccessLogs, out *LoadBalancerAccessLogs, s conversion.Scope) error {
+	out.BucketName = in.BucketName
+	out.BucketPrefix = (*string)(unsafe.Pointer(in.BucketPrefix))
+	return nil
+}
+
+// Convert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs is an autogenerated conversion function.
+func Convert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(in *aws.LoadBalancerAccessLogs, out *LoadBalancerAccessLogs, s conversion.Scope) error {
+	return autoConvert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(in, out, s)
+}
+
 func autoConvert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(in *LoadBalancerControllerConfig, out *aws.LoadBalancerControllerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
+	out.DefaultTargetType = (*string)(unsafe.Pointer(in.DefaultTargetType))
+	out.EnableWAFv2 = (*bool)(unsafe.Pointer(in.EnableWAFv2))
+	out.EnableShield = (*bool)(unsafe.Pointer(in.EnableShield))
+	out.DefaultSSLPolicy = (*string)(unsafe.Pointer(in.DefaultSSLPolicy))
+	out.DefaultTags = *(*map[string]string)(unsafe.Pointer(&in.DefaultTags))
+	out.ServiceLoadBalancerOwner = (*aws.ServiceLoadBalancerOwner)(unsafe.Pointer(in.ServiceLoadBalancerOwner))
 	return nil
 }
 
@@ -929,6 +1722,12 @@ func Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerController
 func autoConvert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(in *aws.LoadBalancerControllerConfig, out *LoadBalancerControllerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
+	out.DefaultTargetType = (*string)(unsafe.Pointer(in.DefaultTargetType))
+	out.EnableWAFv2 = (*bool)(unsafe.Pointer(in.EnableWAFv2))
+	out.EnableShield = (*bool)(unsafe.Pointer(in.EnableShield))
+	out.DefaultSSLPolicy = (*string)(unsafe.Pointer(in.DefaultSSLPolicy))
+	out.DefaultTags = *(*map[string]string)(unsafe.Pointer(&in.DefaultTags))
+	out.ServiceLoadBalancerOwner = (*ServiceLoadBalancerOwner)(unsafe.Pointer(in.ServiceLoadBalancerOwner))
 	return nil
 }
 
@@ -1033,11 +1832,90 @@ func Convert_aws_MachineImages_To_v1alpha1_MachineImages(in *aws.MachineImages,
 	return autoConvert_aws_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_NetworkACL_To_aws_NetworkACL(in *NetworkACL, out *aws.NetworkACL, s conversion.Scope) error {
+	out.Ingress = *(*[]aws.NetworkACLRule)(unsafe.Pointer(&in.Ingress))
+	out.Egress = *(*[]aws.NetworkACLRule)(unsafe.Pointer(&in.Egress))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkACL_To_aws_NetworkACL is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkACL_To_aws_NetworkACL(in *NetworkACL, out *aws.NetworkACL, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkACL_To_aws_NetworkACL(in, out, s)
+}
+
+func autoConvert_aws_NetworkACL_To_v1alpha1_NetworkACL(in *aws.NetworkACL, out *NetworkACL, s conversion.Scope) error {
+	out.Ingress = *(*[]NetworkACLRule)(unsafe.Pointer(&in.Ingress))
+	out.Egress = *(*[]NetworkACLRule)(unsafe.Pointer(&in.Egress))
+	return nil
+}
+
+// Convert_aws_NetworkACL_To_v1alpha1_NetworkACL is an autogenerated conversion function.
+func Convert_aws_NetworkACL_To_v1alpha1_NetworkACL(in *aws.NetworkACL, out *NetworkACL, s conversion.Scope) error {
+	return autoConvert_aws_NetworkACL_To_v1alpha1_NetworkACL(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(in *NetworkACLRule, out *aws.NetworkACLRule, s conversion.Scope) error {
+	out.RuleNumber = in.RuleNumber
+	out.Action = aws.NetworkACLRuleAction(in.Action)
+	out.Protocol = in.Protocol
+	out.CIDR = in.CIDR
+	out.FromPort = (*int32)(unsafe.Pointer(in.FromPort))
+	out.ToPort = (*int32)(unsafe.Pointer(in.ToPort))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(in *NetworkACLRule, out *aws.NetworkACLRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(in, out, s)
+}
+
+func autoConvert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(in *aws.NetworkACLRule, out *NetworkACLRule, s conversion.Scope) error {
+	out.RuleNumber = in.RuleNumber
+	out.Action = NetworkACLRuleAction(in.Action)
+	out.Protocol = in.Protocol
+	out.CIDR = in.CIDR
+	out.FromPort = (*int32)(unsafe.Pointer(in.FromPort))
+	out.ToPort = (*int32)(unsafe.Pointer(in.ToPort))
+	return nil
+}
+
+// Convert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule is an autogenerated conversion function.
+func Convert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(in *aws.NetworkACLRule, out *NetworkACLRule, s conversion.Scope) error {
+	return autoConvert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(in *NetworkACLs, out *aws.NetworkACLs, s conversion.Scope) error {
+	out.Public = (*aws.NetworkACL)(unsafe.Pointer(in.Public))
+	out.Internal = (*aws.NetworkACL)(unsafe.Pointer(in.Internal))
+	out.Workers = (*aws.NetworkACL)(unsafe.Pointer(in.Workers))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkACLs_To_aws_NetworkACLs is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(in *NetworkACLs, out *aws.NetworkACLs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(in, out, s)
+}
+
+func autoConvert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(in *aws.NetworkACLs, out *NetworkACLs, s conversion.Scope) error {
+	out.Public = (*NetworkACL)(unsafe.Pointer(in.Public))
+	out.Internal = (*NetworkACL)(unsafe.Pointer(in.Internal))
+	out.Workers = (*NetworkACL)(unsafe.Pointer(in.Workers))
+	return nil
+}
+
+// Convert_aws_NetworkACLs_To_v1alpha1_NetworkACLs is an autogenerated conversion function.
+func Convert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(in *aws.NetworkACLs, out *NetworkACLs, s conversion.Scope) error {
+	return autoConvert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(in, out, s)
+}
+
 func autoConvert_v1alpha1_Networks_To_aws_Networks(in *Networks, out *aws.Networks, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VPC_To_aws_VPC(&in.VPC, &out.VPC, s); err != nil {
 		return err
 	}
 	out.Zones = *(*[]aws.Zone)(unsafe.Pointer(&in.Zones))
+	out.Egress = (*aws.Egress)(unsafe.Pointer(in.Egress))
+	out.ElasticIPPool = (*aws.ElasticIPPool)(unsafe.Pointer(in.ElasticIPPool))
+	out.NetworkACLs = (*aws.NetworkACLs)(unsafe.Pointer(in.NetworkACLs))
 	return nil
 }
 
@@ -1051,6 +1929,9 @@ func autoConvert_aws_Networks_To_v1alpha1_Networks(in *aws.Networks, out *Networ
 		return err
 	}
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.Egress = (*Egress)(unsafe.Pointer(in.Egress))
+	out.ElasticIPPool = (*ElasticIPPool)(unsafe.Pointer(in.ElasticIPPool))
+	out.NetworkACLs = (*NetworkACLs)(unsafe.Pointer(in.NetworkACLs))
 	return nil
 }
 
@@ -1105,6 +1986,28 @@ func Convert_aws_Role_To_v1alpha1_Role(in *aws.Role, out *Role, s conversion.Sco
 	return autoConvert_aws_Role_To_v1alpha1_Role(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecondaryElasticIPs_To_aws_SecondaryElasticIPs(in *SecondaryElasticIPs, out *aws.SecondaryElasticIPs, s conversion.Scope) error {
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	out.AllocationIDs = *(*[]string)(unsafe.Pointer(&in.AllocationIDs))
+	return nil
+}
+
+// Convert_v1alpha1_SecondaryElasticIPs_To_aws_SecondaryElasticIPs is an autogenerated conversion function.
+func Convert_v1alpha1_SecondaryElasticIPs_To_aws_SecondaryElasticIPs(in *SecondaryElasticIPs, out *aws.SecondaryElasticIPs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecondaryElasticIPs_To_aws_SecondaryElasticIPs(in, out, s)
+}
+
+func autoConvert_aws_SecondaryElasticIPs_To_v1alpha1_SecondaryElasticIPs(in *aws.SecondaryElasticIPs, out *SecondaryElasticIPs, s conversion.Scope) error {
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	out.AllocationIDs = *(*[]string)(unsafe.Pointer(&in.AllocationIDs))
+	return nil
+}
+
+// Convert_aws_SecondaryElasticIPs_To_v1alpha1_SecondaryElasticIPs is an autogenerated conversion function.
+func Convert_aws_SecondaryElasticIPs_To_v1alpha1_SecondaryElasticIPs(in *aws.SecondaryElasticIPs, out *SecondaryElasticIPs, s conversion.Scope) error {
+	return autoConvert_aws_SecondaryElasticIPs_To_v1alpha1_SecondaryElasticIPs(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(in *SecurityGroup, out *aws.SecurityGroup, s conversion.Scope) error {
 	out.Purpose = in.Purpose
 	out.ID = in.ID
@@ -1127,6 +2030,36 @@ func Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in *aws.SecurityGroup,
 	return autoConvert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_ServiceLoadBalancerDefaults_To_aws_ServiceLoadBalancerDefaults(in *ServiceLoadBalancerDefaults, out *aws.ServiceLoadBalancerDefaults, s conversion.Scope) error {
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	out.AccessLogs = (*aws.LoadBalancerAccessLogs)(unsafe.Pointer(in.AccessLogs))
+	out.CrossZoneLoadBalancing = (*bool)(unsafe.Pointer(in.CrossZoneLoadBalancing))
+	out.IdleTimeout = (*v1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_v1alpha1_ServiceLoadBalancerDefaults_To_aws_ServiceLoadBalancerDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceLoadBalancerDefaults_To_aws_ServiceLoadBalancerDefaults(in *ServiceLoadBalancerDefaults, out *aws.ServiceLoadBalancerDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceLoadBalancerDefaults_To_aws_ServiceLoadBalancerDefaults(in, out, s)
+}
+
+func autoConvert_aws_ServiceLoadBalancerDefaults_To_v1alpha1_ServiceLoadBalancerDefaults(in *aws.ServiceLoadBalancerDefaults, out *ServiceLoadBalancerDefaults, s conversion.Scope) error {
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	out.AccessLogs = (*LoadBalancerAccessLogs)(unsafe.Pointer(in.AccessLogs))
+	out.CrossZoneLoadBalancing = (*bool)(unsafe.Pointer(in.CrossZoneLoadBalancing))
+	out.IdleTimeout = (*v1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_aws_ServiceLoadBalancerDefaults_To_v1alpha1_ServiceLoadBalancerDefaults is an autogenerated conversion function.
+func Convert_aws_ServiceLoadBalancerDefaults_To_v1alpha1_ServiceLoadBalancerDefaults(in *aws.ServiceLoadBalancerDefaults, out *ServiceLoadBalancerDefaults, s conversion.Scope) error {
+	return autoConvert_aws_ServiceLoadBalancerDefaults_To_v1alpha1_ServiceLoadBalancerDefaults(in, out, s)
+}
+
 func autoConvert_v1alpha1_Storage_To_aws_Storage(in *Storage, out *aws.Storage, s conversion.Scope) error {
 	out.ManagedDefaultClass = (*bool)(unsafe.Pointer(in.ManagedDefaultClass))
 	return nil
@@ -1297,6 +2230,7 @@ func Convert_aws_WorkerStatus_To_v1alpha1_WorkerStatus(in *aws.WorkerStatus, out
 
 func autoConvert_v1alpha1_WorkloadIdentityConfig_To_aws_WorkloadIdentityConfig(in *WorkloadIdentityConfig, out *aws.WorkloadIdentityConfig, s conversion.Scope) error {
 	out.RoleARN = in.RoleARN
+	out.AssumeRoleChain = *(*[]aws.AssumeRole)(unsafe.Pointer(&in.AssumeRoleChain))
 	return nil
 }
 
@@ -1307,6 +2241,7 @@ func Convert_v1alpha1_WorkloadIdentityConfig_To_aws_WorkloadIdentityConfig(in *W
 
 func autoConvert_aws_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(in *aws.WorkloadIdentityConfig, out *WorkloadIdentityConfig, s conversion.Scope) error {
 	out.RoleARN = in.RoleARN
+	out.AssumeRoleChain = *(*[]AssumeRole)(unsafe.Pointer(&in.AssumeRoleChain))
 	return nil
 }
 
@@ -1321,6 +2256,7 @@ func autoConvert_v1alpha1_Zone_To_aws_Zone(in *Zone, out *aws.Zone, s conversion
 	out.Public = in.Public
 	out.Workers = in.Workers
 	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.SecondaryElasticIPs = (*aws.SecondaryElasticIPs)(unsafe.Pointer(in.SecondaryElasticIPs))
 	return nil
 }
 
@@ -1335,6 +2271,7 @@ func autoConvert_aws_Zone_To_v1alpha1_Zone(in *aws.Zone, out *Zone, s conversion
 	out.Public = in.Public
 	out.Workers = in.Workers
 	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.SecondaryElasticIPs = (*SecondaryElasticIPs)(unsafe.Pointer(in.SecondaryElasticIPs))
 	return nil
 }
 
@@ -1342,3 +2279,35 @@ func autoConvert_aws_Zone_To_v1alpha1_Zone(in *aws.Zone, out *Zone, s conversion
 func Convert_aws_Zone_To_v1alpha1_Zone(in *aws.Zone, out *Zone, s conversion.Scope) error {
 	return autoConvert_aws_Zone_To_v1alpha1_Zone(in, out, s)
 }
+
+func autoConvert_v1alpha1_ZoneInventoryResources_To_aws_ZoneInventoryResources(in *ZoneInventoryResources, out *aws.ZoneInventoryResources, s conversion.Scope) error {
+	out.Name = in.Name
+	out.WorkersSubnet = (*string)(unsafe.Pointer(in.WorkersSubnet))
+	out.InternalSubnet = (*string)(unsafe.Pointer(in.InternalSubnet))
+	out.PublicSubnet = (*string)(unsafe.Pointer(in.PublicSubnet))
+	out.NATGateway = (*string)(unsafe.Pointer(in.NATGateway))
+	out.NATGatewayElasticIP = (*string)(unsafe.Pointer(in.NATGatewayElasticIP))
+	out.RouteTable = (*string)(unsafe.Pointer(in.RouteTable))
+	return nil
+}
+
+// Convert_v1alpha1_ZoneInventoryResources_To_aws_ZoneInventoryResources is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneInventoryResources_To_aws_ZoneInventoryResources(in *ZoneInventoryResources, out *aws.ZoneInventoryResources, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneInventoryResources_To_aws_ZoneInventoryResources(in, out, s)
+}
+
+func autoConvert_aws_ZoneInventoryResources_To_v1alpha1_ZoneInventoryResources(in *aws.ZoneInventoryResources, out *ZoneInventoryResources, s conversion.Scope) error {
+	out.Name = in.Name
+	out.WorkersSubnet = (*string)(unsafe.Pointer(in.WorkersSubnet))
+	out.InternalSubnet = (*string)(unsafe.Pointer(in.InternalSubnet))
+	out.PublicSubnet = (*string)(unsafe.Pointer(in.PublicSubnet))
+	out.NATGateway = (*string)(unsafe.Pointer(in.NATGateway))
+	out.NATGatewayElasticIP = (*string)(unsafe.Pointer(in.NATGatewayElasticIP))
+	out.RouteTable = (*string)(unsafe.Pointer(in.RouteTable))
+	return nil
+}
+
+// Convert_aws_ZoneInventoryResources_To_v1alpha1_ZoneInventoryResources is an autogenerated conversion function.
+func Convert_aws_ZoneInventoryResources_To_v1alpha1_ZoneInventoryResources(in *aws.ZoneInventoryResources, out *ZoneInventoryResources, s conversion.Scope) error {
+	return autoConvert_aws_ZoneInventoryResources_To_v1alpha1_ZoneInventoryResources(in, out, s)
+}
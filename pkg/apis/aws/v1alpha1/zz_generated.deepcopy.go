@@ -12,9 +12,77 @@ package v1alpha1
 import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIConfig) DeepCopyInto(out *APIConfig) {
+	*out = *in
+	if in.Partition != nil {
+		in, out := &in.Partition, &out.Partition
+		*out = new(string)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseFIPSEndpoints != nil {
+		in, out := &in.UseFIPSEndpoints, &out.UseFIPSEndpoints
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIConfig.
+func (in *APIConfig) DeepCopy() *APIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRole) DeepCopyInto(out *AssumeRole) {
+	*out = *in
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionName != nil {
+		in, out := &in.SessionName, &out.SessionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SessionPolicy != nil {
+		in, out := &in.SessionPolicy, &out.SessionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRole.
+func (in *AssumeRole) DeepCopy() *AssumeRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
 	*out = *in
@@ -115,6 +183,11 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(APIConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -155,6 +228,11 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceLoadBalancerDefaults != nil {
+		in, out := &in.ServiceLoadBalancerDefaults, &out.ServiceLoadBalancerDefaults
+		*out = new(ServiceLoadBalancerDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -229,6 +307,43 @@ func (in *DataVolume) DeepCopy() *DataVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionBlocker) DeepCopyInto(out *DeletionBlocker) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionBlocker.
+func (in *DeletionBlocker) DeepCopy() *DeletionBlocker {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionBlocker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionPolicy) DeepCopyInto(out *DeletionPolicy) {
+	*out = *in
+	if in.Retain != nil {
+		in, out := &in.Retain, &out.Retain
+		*out = make([]RetainedResource, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionPolicy.
+func (in *DeletionPolicy) DeepCopy() *DeletionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DualStack) DeepCopyInto(out *DualStack) {
 	*out = *in
@@ -261,6 +376,175 @@ func (in *EC2) DeepCopy() *EC2 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRConfig) DeepCopyInto(out *ECRConfig) {
+	*out = *in
+	if in.CacheDuration != nil {
+		in, out := &in.CacheDuration, &out.CacheDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]ECRRegistry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRConfig.
+func (in *ECRConfig) DeepCopy() *ECRConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRRegistry) DeepCopyInto(out *ECRRegistry) {
+	*out = *in
+	if in.MatchImages != nil {
+		in, out := &in.MatchImages, &out.MatchImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRRegistry.
+func (in *ECRRegistry) DeepCopy() *ECRRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSAccessPoint) DeepCopyInto(out *EFSAccessPoint) {
+	*out = *in
+	if in.PosixUser != nil {
+		in, out := &in.PosixUser, &out.PosixUser
+		*out = new(EFSPosixUser)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSAccessPoint.
+func (in *EFSAccessPoint) DeepCopy() *EFSAccessPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSAccessPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSAccessPointStatus) DeepCopyInto(out *EFSAccessPointStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSAccessPointStatus.
+func (in *EFSAccessPointStatus) DeepCopy() *EFSAccessPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSAccessPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSLifecyclePolicy) DeepCopyInto(out *EFSLifecyclePolicy) {
+	*out = *in
+	if in.TransitionToIA != nil {
+		in, out := &in.TransitionToIA, &out.TransitionToIA
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitionToArchive != nil {
+		in, out := &in.TransitionToArchive, &out.TransitionToArchive
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitionToPrimaryStorageClass != nil {
+		in, out := &in.TransitionToPrimaryStorageClass, &out.TransitionToPrimaryStorageClass
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSLifecyclePolicy.
+func (in *EFSLifecyclePolicy) DeepCopy() *EFSLifecyclePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSLifecyclePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSPosixUser) DeepCopyInto(out *EFSPosixUser) {
+	*out = *in
+	if in.SecondaryGIDs != nil {
+		in, out := &in.SecondaryGIDs, &out.SecondaryGIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSPosixUser.
+func (in *EFSPosixUser) DeepCopy() *EFSPosixUser {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSPosixUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Egress) DeepCopyInto(out *Egress) {
+	*out = *in
+	if in.NATGatewayZone != nil {
+		in, out := &in.NATGatewayZone, &out.NATGatewayZone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Egress.
+func (in *Egress) DeepCopy() *Egress {
+	if in == nil {
+		return nil
+	}
+	out := new(Egress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticFileSystemConfig) DeepCopyInto(out *ElasticFileSystemConfig) {
 	*out = *in
@@ -269,6 +553,43 @@ func (in *ElasticFileSystemConfig) DeepCopyInto(out *ElasticFileSystemConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PerformanceMode != nil {
+		in, out := &in.PerformanceMode, &out.PerformanceMode
+		*out = new(EFSPerformanceMode)
+		**out = **in
+	}
+	if in.ThroughputMode != nil {
+		in, out := &in.ThroughputMode, &out.ThroughputMode
+		*out = new(EFSThroughputMode)
+		**out = **in
+	}
+	if in.ProvisionedThroughputInMibps != nil {
+		in, out := &in.ProvisionedThroughputInMibps, &out.ProvisionedThroughputInMibps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LifecyclePolicy != nil {
+		in, out := &in.LifecyclePolicy, &out.LifecyclePolicy
+		*out = new(EFSLifecyclePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutomaticBackups != nil {
+		in, out := &in.AutomaticBackups, &out.AutomaticBackups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccessPoints != nil {
+		in, out := &in.AccessPoints, &out.AccessPoints
+		*out = make([]EFSAccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -285,6 +606,11 @@ func (in *ElasticFileSystemConfig) DeepCopy() *ElasticFileSystemConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticFileSystemStatus) DeepCopyInto(out *ElasticFileSystemStatus) {
 	*out = *in
+	if in.AccessPoints != nil {
+		in, out := &in.AccessPoints, &out.AccessPoints
+		*out = make([]EFSAccessPointStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -298,6 +624,167 @@ func (in *ElasticFileSystemStatus) DeepCopy() *ElasticFileSystemStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
+	*out = *in
+	if in.PublicIPv4PoolID != nil {
+		in, out := &in.PublicIPv4PoolID, &out.PublicIPv4PoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMPoolID != nil {
+		in, out := &in.IPAMPoolID, &out.IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPPool.
+func (in *ElasticIPPool) DeepCopy() *ElasticIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FSxConfig) DeepCopyInto(out *FSxConfig) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Lustre != nil {
+		in, out := &in.Lustre, &out.Lustre
+		*out = new(FSxLustreConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenZFS != nil {
+		in, out := &in.OpenZFS, &out.OpenZFS
+		*out = new(FSxOpenZFSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FSxConfig.
+func (in *FSxConfig) DeepCopy() *FSxConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FSxConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FSxLustreConfig) DeepCopyInto(out *FSxLustreConfig) {
+	*out = *in
+	if in.DeploymentType != nil {
+		in, out := &in.DeploymentType, &out.DeploymentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerUnitStorageThroughput != nil {
+		in, out := &in.PerUnitStorageThroughput, &out.PerUnitStorageThroughput
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FSxLustreConfig.
+func (in *FSxLustreConfig) DeepCopy() *FSxLustreConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FSxLustreConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FSxOpenZFSConfig) DeepCopyInto(out *FSxOpenZFSConfig) {
+	*out = *in
+	if in.DeploymentType != nil {
+		in, out := &in.DeploymentType, &out.DeploymentType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FSxOpenZFSConfig.
+func (in *FSxOpenZFSConfig) DeepCopy() *FSxOpenZFSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FSxOpenZFSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FSxStatus) DeepCopyInto(out *FSxStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FSxStatus.
+func (in *FSxStatus) DeepCopy() *FSxStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FSxStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogs) DeepCopyInto(out *FlowLogs) {
+	*out = *in
+	if in.S3BucketARN != nil {
+		in, out := &in.S3BucketARN, &out.S3BucketARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CloudWatchLogGroup != nil {
+		in, out := &in.CloudWatchLogGroup, &out.CloudWatchLogGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrafficType != nil {
+		in, out := &in.TrafficType, &out.TrafficType
+		*out = new(FlowLogTrafficType)
+		**out = **in
+	}
+	if in.LogFormat != nil {
+		in, out := &in.LogFormat, &out.LogFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxAggregationInterval != nil {
+		in, out := &in.MaxAggregationInterval, &out.MaxAggregationInterval
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogs.
+func (in *FlowLogs) DeepCopy() *FlowLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAM) DeepCopyInto(out *IAM) {
 	*out = *in
@@ -439,16 +926,36 @@ func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogs)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ElasticFileSystem != nil {
 		in, out := &in.ElasticFileSystem, &out.ElasticFileSystem
 		*out = new(ElasticFileSystemConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FSx != nil {
+		in, out := &in.FSx, &out.FSx
+		*out = new(FSxConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnableMTUCustomizer != nil {
 		in, out := &in.EnableMTUCustomizer, &out.EnableMTUCustomizer
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ECR != nil {
+		in, out := &in.ECR, &out.ECR
+		*out = new(ECRConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -470,6 +977,41 @@ func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureInventory) DeepCopyInto(out *InfrastructureInventory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(InventoryResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformResourceAddresses != nil {
+		in, out := &in.TerraformResourceAddresses, &out.TerraformResourceAddresses
+		*out = new(InventoryResources)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureInventory.
+func (in *InfrastructureInventory) DeepCopy() *InfrastructureInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureInventory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureState) DeepCopyInto(out *InfrastructureState) {
 	*out = *in
@@ -509,7 +1051,17 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 	out.EC2 = in.EC2
 	in.IAM.DeepCopyInto(&out.IAM)
 	in.VPC.DeepCopyInto(&out.VPC)
-	out.ElasticFileSystem = in.ElasticFileSystem
+	in.ElasticFileSystem.DeepCopyInto(&out.ElasticFileSystem)
+	if in.FSx != nil {
+		in, out := &in.FSx, &out.FSx
+		*out = new(FSxStatus)
+		**out = **in
+	}
+	if in.DeletionBlockers != nil {
+		in, out := &in.DeletionBlockers, &out.DeletionBlockers
+		*out = make([]DeletionBlocker, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -573,6 +1125,92 @@ func (in *InstanceProfile) DeepCopy() *InstanceProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryResources) DeepCopyInto(out *InventoryResources) {
+	*out = *in
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(string)
+		**out = **in
+	}
+	if in.DHCPOptions != nil {
+		in, out := &in.DHCPOptions, &out.DHCPOptions
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultSecurityGroup != nil {
+		in, out := &in.DefaultSecurityGroup, &out.DefaultSecurityGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.InternetGateway != nil {
+		in, out := &in.InternetGateway, &out.InternetGateway
+		*out = new(string)
+		**out = **in
+	}
+	if in.EgressOnlyInternetGateway != nil {
+		in, out := &in.EgressOnlyInternetGateway, &out.EgressOnlyInternetGateway
+		*out = new(string)
+		**out = **in
+	}
+	if in.MainRouteTable != nil {
+		in, out := &in.MainRouteTable, &out.MainRouteTable
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodesSecurityGroup != nil {
+		in, out := &in.NodesSecurityGroup, &out.NodesSecurityGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneInventoryResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryResources.
+func (in *InventoryResources) DeepCopy() *InventoryResources {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAccessLogs) DeepCopyInto(out *LoadBalancerAccessLogs) {
+	*out = *in
+	if in.BucketPrefix != nil {
+		in, out := &in.BucketPrefix, &out.BucketPrefix
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAccessLogs.
+func (in *LoadBalancerAccessLogs) DeepCopy() *LoadBalancerAccessLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAccessLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerControllerConfig) DeepCopyInto(out *LoadBalancerControllerConfig) {
 	*out = *in
@@ -581,6 +1219,38 @@ func (in *LoadBalancerControllerConfig) DeepCopyInto(out *LoadBalancerController
 		*out = new(string)
 		**out = **in
 	}
+	if in.DefaultTargetType != nil {
+		in, out := &in.DefaultTargetType, &out.DefaultTargetType
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableWAFv2 != nil {
+		in, out := &in.EnableWAFv2, &out.EnableWAFv2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableShield != nil {
+		in, out := &in.EnableShield, &out.EnableShield
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultSSLPolicy != nil {
+		in, out := &in.DefaultSSLPolicy, &out.DefaultSSLPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceLoadBalancerOwner != nil {
+		in, out := &in.ServiceLoadBalancerOwner, &out.ServiceLoadBalancerOwner
+		*out = new(ServiceLoadBalancerOwner)
+		**out = **in
+	}
 	return
 }
 
@@ -721,6 +1391,93 @@ func (in *MachineImages) DeepCopy() *MachineImages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLRule) DeepCopyInto(out *NetworkACLRule) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLRule.
+func (in *NetworkACLRule) DeepCopy() *NetworkACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLs) DeepCopyInto(out *NetworkACLs) {
+	*out = *in
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLs.
+func (in *NetworkACLs) DeepCopy() *NetworkACLs {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Networks) DeepCopyInto(out *Networks) {
 	*out = *in
@@ -732,6 +1489,21 @@ func (in *Networks) DeepCopyInto(out *Networks) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(Egress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticIPPool != nil {
+		in, out := &in.ElasticIPPool, &out.ElasticIPPool
+		*out = new(ElasticIPPool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkACLs != nil {
+		in, out := &in.NetworkACLs, &out.NetworkACLs
+		*out = new(NetworkACLs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -782,6 +1554,32 @@ func (in *Role) DeepCopy() *Role {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryElasticIPs) DeepCopyInto(out *SecondaryElasticIPs) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllocationIDs != nil {
+		in, out := &in.AllocationIDs, &out.AllocationIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryElasticIPs.
+func (in *SecondaryElasticIPs) DeepCopy() *SecondaryElasticIPs {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryElasticIPs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -798,6 +1596,54 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancerDefaults) DeepCopyInto(out *ServiceLoadBalancerDefaults) {
+	*out = *in
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SourceRanges != nil {
+		in, out := &in.SourceRanges, &out.SourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(LoadBalancerAccessLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CrossZoneLoadBalancing != nil {
+		in, out := &in.CrossZoneLoadBalancing, &out.CrossZoneLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerDefaults.
+func (in *ServiceLoadBalancerDefaults) DeepCopy() *ServiceLoadBalancerDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancerDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Storage) DeepCopyInto(out *Storage) {
 	*out = *in
@@ -1021,6 +1867,13 @@ func (in *WorkerStatus) DeepCopyObject() runtime.Object {
 func (in *WorkloadIdentityConfig) DeepCopyInto(out *WorkloadIdentityConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.AssumeRoleChain != nil {
+		in, out := &in.AssumeRoleChain, &out.AssumeRoleChain
+		*out = make([]AssumeRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1050,6 +1903,11 @@ func (in *Zone) DeepCopyInto(out *Zone) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SecondaryElasticIPs != nil {
+		in, out := &in.SecondaryElasticIPs, &out.SecondaryElasticIPs
+		*out = new(SecondaryElasticIPs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1062,3 +1920,49 @@ func (in *Zone) DeepCopy() *Zone {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneInventoryResources) DeepCopyInto(out *ZoneInventoryResources) {
+	*out = *in
+	if in.WorkersSubnet != nil {
+		in, out := &in.WorkersSubnet, &out.WorkersSubnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.InternalSubnet != nil {
+		in, out := &in.InternalSubnet, &out.InternalSubnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicSubnet != nil {
+		in, out := &in.PublicSubnet, &out.PublicSubnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.NATGateway != nil {
+		in, out := &in.NATGateway, &out.NATGateway
+		*out = new(string)
+		**out = **in
+	}
+	if in.NATGatewayElasticIP != nil {
+		in, out := &in.NATGatewayElasticIP, &out.NATGatewayElasticIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteTable != nil {
+		in, out := &in.RouteTable, &out.RouteTable
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneInventoryResources.
+func (in *ZoneInventoryResources) DeepCopy() *ZoneInventoryResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneInventoryResources)
+	in.DeepCopyInto(out)
+	return out
+}
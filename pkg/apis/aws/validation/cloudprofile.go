@@ -33,6 +33,27 @@ func ValidateCloudProfileConfig(cpConfig *apisaws.CloudProfileConfig, machineIma
 	// Validate machine image mappings
 	allErrs = append(allErrs, validateMachineImageMapping(machineImages, cpConfig, capabilityDefinitions, field.NewPath("spec").Child("machineImages"))...)
 
+	if cpConfig.API != nil {
+		allErrs = append(allErrs, validateAPIConfig(cpConfig.API, fldPath.Child("api"))...)
+	}
+
+	return allErrs
+}
+
+// validateAPIConfig validates the API section of CloudProfileConfig
+func validateAPIConfig(config *apisaws.APIConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.Partition != nil {
+		allErrs = append(allErrs, validatePartition(*config.Partition, fldPath.Child("partition"))...)
+	}
+	if config.Endpoint != nil {
+		allErrs = append(allErrs, validateEndpoint(*config.Endpoint, fldPath.Child("endpoint"))...)
+		if ptr.Deref(config.UseFIPSEndpoints, false) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("useFIPSEndpoints"), "cannot be enabled together with endpoint"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -301,6 +301,33 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				}))))
 			})
 		})
+
+		Context("API validation", func() {
+			It("should pass validation with a partition and an endpoint", func() {
+				cloudProfileConfig.API = &apisaws.APIConfig{
+					Partition: ptr.To("aws-cn"),
+					Endpoint:  ptr.To("https://aws.example.com"),
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should reject invalid settings", func() {
+				cloudProfileConfig.API = &apisaws.APIConfig{
+					Partition:        ptr.To("aws_cn"),
+					Endpoint:         ptr.To("ftp://aws.example.com"),
+					UseFIPSEndpoints: ptr.To(true),
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("api.partition")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("api.endpoint")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("api.useFIPSEndpoints")})),
+				))
+			})
+		})
 	},
 		Entry("CloudProfile uses regions only", false),
 		Entry("CloudProfile uses capabilities", true))
@@ -5,12 +5,37 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 )
 
+const (
+	minLoadBalancerIdleTimeout = time.Second
+	maxLoadBalancerIdleTimeout = 4000 * time.Second
+)
+
+var (
+	availableTargetTypes = sets.New(
+		"instance",
+		"ip",
+	)
+	availableServiceLoadBalancerOwners = sets.New(
+		string(apisaws.ServiceLoadBalancerOwnerCloudControllerManager),
+		string(apisaws.ServiceLoadBalancerOwnerLoadBalancerController),
+	)
+	// reservedLoadBalancerTagKeys are set on every load balancer managed by the cloud-controller-manager or the ALB controller.
+	reservedLoadBalancerTagKeys = []string{"KubernetesCluster"}
+)
+
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
 func ValidateControlPlaneConfig(cpConfig *apisaws.ControlPlaneConfig, version string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -19,11 +44,118 @@ func ValidateControlPlaneConfig(cpConfig *apisaws.ControlPlaneConfig, version st
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(cpConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
 	}
 
-	if cpConfig.LoadBalancerController != nil && cpConfig.LoadBalancerController.IngressClassName != nil {
-		ingressClassName := *cpConfig.LoadBalancerController.IngressClassName
-		ingressPath := fldPath.Child("loadBalancerController", "ingressClassName")
-		allErrs = append(allErrs, validateK8sResourceName(ingressClassName, ingressPath)...)
+	if cpConfig.LoadBalancerController != nil {
+		allErrs = append(allErrs, validateLoadBalancerControllerConfig(cpConfig.LoadBalancerController, fldPath.Child("loadBalancerController"))...)
+	}
+
+	if cpConfig.ServiceLoadBalancerDefaults != nil {
+		allErrs = append(allErrs, validateServiceLoadBalancerDefaults(cpConfig.ServiceLoadBalancerDefaults, fldPath.Child("serviceLoadBalancerDefaults"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object on update.
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisaws.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Services created while the ALB controller owned Services of type LoadBalancer carry its load balancer class, which
+	// is immutable. Disabling the controller in the same step would orphan their load balancers, hence the ownership
+	// has to be handed back to the cloud-controller-manager first.
+	if ownsServiceLoadBalancers(oldConfig.LoadBalancerController) && !isLoadBalancerControllerEnabled(newConfig.LoadBalancerController) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("loadBalancerController", "enabled"),
+			fmt.Sprintf("cannot disable the load balancer controller while it is the owner of Service load balancers, set serviceLoadBalancerOwner to %q first", apisaws.ServiceLoadBalancerOwnerCloudControllerManager)))
 	}
 
 	return allErrs
 }
+
+func validateLoadBalancerControllerConfig(config *apisaws.LoadBalancerControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.IngressClassName != nil {
+		allErrs = append(allErrs, validateK8sResourceName(*config.IngressClassName, fldPath.Child("ingressClassName"))...)
+	}
+
+	if config.DefaultTargetType != nil && !availableTargetTypes.Has(*config.DefaultTargetType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("defaultTargetType"), *config.DefaultTargetType, sets.List(availableTargetTypes)))
+	}
+
+	if config.DefaultSSLPolicy != nil {
+		allErrs = append(allErrs, validateSSLPolicy(*config.DefaultSSLPolicy, fldPath.Child("defaultSSLPolicy"))...)
+	}
+
+	allErrs = append(allErrs, validateLoadBalancerTags(config.DefaultTags, fldPath.Child("defaultTags"))...)
+
+	if config.ServiceLoadBalancerOwner != nil {
+		ownerPath := fldPath.Child("serviceLoadBalancerOwner")
+		if !availableServiceLoadBalancerOwners.Has(string(*config.ServiceLoadBalancerOwner)) {
+			allErrs = append(allErrs, field.NotSupported(ownerPath, *config.ServiceLoadBalancerOwner, sets.List(availableServiceLoadBalancerOwners)))
+		} else if ownsServiceLoadBalancers(config) && !config.Enabled {
+			allErrs = append(allErrs, field.Invalid(ownerPath, *config.ServiceLoadBalancerOwner, "requires the load balancer controller to be enabled"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateServiceLoadBalancerDefaults(defaults *apisaws.ServiceLoadBalancerDefaults, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	sourceRangesPath := fldPath.Child("sourceRanges")
+	for i, sourceRange := range defaults.SourceRanges {
+		allErrs = append(allErrs, cidrvalidation.NewCIDR(sourceRange, sourceRangesPath.Index(i)).ValidateParse()...)
+	}
+
+	if defaults.AccessLogs != nil {
+		allErrs = append(allErrs, validateS3BucketName(defaults.AccessLogs.BucketName, fldPath.Child("accessLogs", "bucketName"))...)
+	}
+
+	if defaults.IdleTimeout != nil {
+		if idleTimeout := defaults.IdleTimeout.Duration; idleTimeout < minLoadBalancerIdleTimeout || idleTimeout > maxLoadBalancerIdleTimeout {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeout"), idleTimeout.String(), fmt.Sprintf("must be between %s and %s", minLoadBalancerIdleTimeout, maxLoadBalancerIdleTimeout)))
+		}
+	}
+
+	allErrs = append(allErrs, validateLoadBalancerTags(defaults.AdditionalTags, fldPath.Child("additionalTags"))...)
+
+	return allErrs
+}
+
+// validateLoadBalancerTags validates tags which are passed to the load balancer controllers as comma separated
+// key=value pairs.
+func validateLoadBalancerTags(tags map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for key, value := range tags {
+		keyPath := fldPath.Key(key)
+		if errs := validateTagKey(key, keyPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		if strings.Contains(key, "=") {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, "must not contain '='"))
+		}
+		if slices.Contains(reservedLoadBalancerTagKeys, key) {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, "must not use reserved key"))
+		}
+		for _, reserved := range reservedTagKeyPrefixes {
+			if strings.HasPrefix(key, reserved) {
+				allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("must not use reserved key prefix %q", reserved)))
+				break
+			}
+		}
+		allErrs = append(allErrs, validateTagValue(value, keyPath)...)
+	}
+
+	return allErrs
+}
+
+func isLoadBalancerControllerEnabled(config *apisaws.LoadBalancerControllerConfig) bool {
+	return config != nil && config.Enabled
+}
+
+func ownsServiceLoadBalancers(config *apisaws.LoadBalancerControllerConfig) bool {
+	return config != nil && config.ServiceLoadBalancerOwner != nil &&
+		*config.ServiceLoadBalancerOwner == apisaws.ServiceLoadBalancerOwnerLoadBalancerController
+}
@@ -5,9 +5,12 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -67,6 +70,164 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 					"Detail": Equal("does not match expected regex ^[a-z0-9.-]+$"),
 				}))))
 			})
+
+			It("should pass for valid controller settings", func() {
+				controlPlane.LoadBalancerController = &apisaws.LoadBalancerControllerConfig{
+					Enabled:           true,
+					DefaultTargetType: ptr.To("ip"),
+					EnableWAFv2:       ptr.To(false),
+					EnableShield:      ptr.To(true),
+					DefaultSSLPolicy:  ptr.To("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+					DefaultTags: map[string]string{
+						"team":        "platform",
+						"cost-center": "",
+					},
+					ServiceLoadBalancerOwner: ptr.To(apisaws.ServiceLoadBalancerOwnerLoadBalancerController),
+				}
+				Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+			})
+
+			It("should fail for invalid controller settings", func() {
+				controlPlane.LoadBalancerController = &apisaws.LoadBalancerControllerConfig{
+					DefaultTargetType: ptr.To("pod"),
+					DefaultSSLPolicy:  ptr.To("ELBSecurityPolicy TLS"),
+					DefaultTags: map[string]string{
+						"a=b":                      "foo",
+						"KubernetesCluster":        "foo",
+						"kubernetes.io/cluster/me": "owned",
+						"team":                     "a,b",
+					},
+					ServiceLoadBalancerOwner: ptr.To(apisaws.ServiceLoadBalancerOwnerLoadBalancerController),
+				}
+				errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("loadBalancerController.defaultTargetType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancerController.defaultSSLPolicy"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("loadBalancerController.defaultTags[a=b]"),
+						"Detail": Equal("must not contain '='"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("loadBalancerController.defaultTags[KubernetesCluster]"),
+						"Detail": Equal("must not use reserved key"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("loadBalancerController.defaultTags[kubernetes.io/cluster/me]"),
+						"Detail": Equal("must not use reserved key prefix \"kubernetes.io\""),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancerController.defaultTags[team]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("loadBalancerController.serviceLoadBalancerOwner"),
+						"Detail": Equal("requires the load balancer controller to be enabled"),
+					})),
+				))
+			})
+
+			It("should fail for unknown service load balancer owner", func() {
+				controlPlane.LoadBalancerController = &apisaws.LoadBalancerControllerConfig{
+					Enabled:                  true,
+					ServiceLoadBalancerOwner: ptr.To(apisaws.ServiceLoadBalancerOwner("foo")),
+				}
+				errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancerController.serviceLoadBalancerOwner"),
+				}))))
+			})
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig ServiceLoadBalancerDefaults", func() {
+		It("should pass for valid defaults", func() {
+			controlPlane.ServiceLoadBalancerDefaults = &apisaws.ServiceLoadBalancerDefaults{
+				Internal:     ptr.To(true),
+				SourceRanges: []string{"10.0.0.0/8", "2001:db8::/32"},
+				AccessLogs: &apisaws.LoadBalancerAccessLogs{
+					BucketName:   "my-access-logs",
+					BucketPrefix: ptr.To("shoot"),
+				},
+				CrossZoneLoadBalancing: ptr.To(false),
+				IdleTimeout:            &metav1.Duration{Duration: 5 * time.Minute},
+				AdditionalTags:         map[string]string{"team": "platform"},
+			}
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail for invalid defaults", func() {
+			controlPlane.ServiceLoadBalancerDefaults = &apisaws.ServiceLoadBalancerDefaults{
+				SourceRanges: []string{"10.0.0.0/33"},
+				AccessLogs: &apisaws.LoadBalancerAccessLogs{
+					BucketName: "Invalid_Bucket",
+				},
+				IdleTimeout:    &metav1.Duration{Duration: 2 * time.Hour},
+				AdditionalTags: map[string]string{"gardener.cloud/foo": "bar"},
+			}
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("serviceLoadBalancerDefaults.sourceRanges[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("serviceLoadBalancerDefaults.accessLogs.bucketName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("serviceLoadBalancerDefaults.idleTimeout"),
+					"Detail": Equal("must be between 1s and 1h6m40s"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("serviceLoadBalancerDefaults.additionalTags[gardener.cloud/foo]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfigUpdate", func() {
+		var oldControlPlane *apisaws.ControlPlaneConfig
+
+		BeforeEach(func() {
+			oldControlPlane = &apisaws.ControlPlaneConfig{
+				LoadBalancerController: &apisaws.LoadBalancerControllerConfig{
+					Enabled:                  true,
+					ServiceLoadBalancerOwner: ptr.To(apisaws.ServiceLoadBalancerOwnerLoadBalancerController),
+				},
+			}
+		})
+
+		It("should allow handing the ownership back to the cloud-controller-manager", func() {
+			controlPlane.LoadBalancerController = &apisaws.LoadBalancerControllerConfig{
+				Enabled:                  true,
+				ServiceLoadBalancerOwner: ptr.To(apisaws.ServiceLoadBalancerOwnerCloudControllerManager),
+			}
+			Expect(ValidateControlPlaneConfigUpdate(oldControlPlane, controlPlane, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow disabling the controller if it does not own service load balancers", func() {
+			oldControlPlane.LoadBalancerController.ServiceLoadBalancerOwner = nil
+			Expect(ValidateControlPlaneConfigUpdate(oldControlPlane, controlPlane, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid disabling the controller while it owns service load balancers", func() {
+			errorList := ValidateControlPlaneConfigUpdate(oldControlPlane, controlPlane, fldPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("loadBalancerController.enabled"),
+			}))))
 		})
 	})
 })
@@ -6,7 +6,9 @@ package validation
 
 import (
 	"fmt"
+	"net"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -16,10 +18,22 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	apisawshelper "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 )
 
+// maxSecondaryElasticIPs is the maximum number of secondary Elastic IPs of a NAT gateway, which supports up to 8 Elastic
+// IPs in total.
+const maxSecondaryElasticIPs = 7
+
+// maxNetworkACLRules is the maximum number of rules per direction of a network ACL, which is the default quota of AWS.
+const maxNetworkACLRules = 20
+
+// efsDefaultKMSKeyID is the AWS managed key which encrypts an EFS if no KMS key is specified.
+const efsDefaultKMSKeyID = "alias/aws/elasticfilesystem"
+
 // ValidateInfrastructureConfigAgainstCloudProfile validates the given `InfrastructureConfig` against the given `CloudProfile`.
 func ValidateInfrastructureConfigAgainstCloudProfile(oldInfra, infra *apisaws.InfrastructureConfig, shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -133,9 +147,30 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, ipFamilie
 			}
 			referencedElasticIPAllocationIDs = append(referencedElasticIPAllocationIDs, *zone.ElasticIPAllocationID)
 			allErrs = append(allErrs, validateEipAllocationID(*zone.ElasticIPAllocationID, zonePath.Child("elasticIPAllocationID"))...)
+			if !apisawshelper.HasNATGateway(infra, zone.Name) {
+				allErrs = append(allErrs, field.Forbidden(zonePath.Child("elasticIPAllocationID"), "must not be set for zones without NAT gateway"))
+			}
+		}
+
+		if zone.SecondaryElasticIPs != nil {
+			secondaryPath := zonePath.Child("secondaryElasticIPs")
+			allErrs = append(allErrs, validateSecondaryElasticIPs(zone.SecondaryElasticIPs, secondaryPath)...)
+			for j, id := range zone.SecondaryElasticIPs.AllocationIDs {
+				if slices.Contains(referencedElasticIPAllocationIDs, id) {
+					allErrs = append(allErrs, field.Duplicate(secondaryPath.Child("allocationIDs").Index(j), id))
+				}
+				referencedElasticIPAllocationIDs = append(referencedElasticIPAllocationIDs, id)
+			}
+			if !apisawshelper.HasNATGateway(infra, zone.Name) {
+				allErrs = append(allErrs, field.Forbidden(secondaryPath, "must not be set for zones without NAT gateway"))
+			}
 		}
 	}
 
+	allErrs = append(allErrs, validateEgress(infra, networksPath.Child("egress"))...)
+	allErrs = append(allErrs, validateElasticIPPool(infra.Networks.ElasticIPPool, networksPath.Child("elasticIPPool"))...)
+	allErrs = append(allErrs, validateNetworkACLs(infra.Networks.NetworkACLs, networksPath.Child("networkACLs"))...)
+
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidrs...)...)
 
 	if nodes != nil {
@@ -171,6 +206,503 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, ipFamilie
 	}
 
 	allErrs = append(allErrs, ValidateIgnoreTags(field.NewPath("ignoreTags"), infra.IgnoreTags)...)
+	allErrs = append(allErrs, validateFlowLogs(infra, field.NewPath("flowLogs"))...)
+	allErrs = append(allErrs, validateECRConfig(infra.ECR, field.NewPath("ecr"))...)
+	allErrs = append(allErrs, validateElasticFileSystem(infra.ElasticFileSystem, field.NewPath("elasticFileSystem"))...)
+	allErrs = append(allErrs, validateFSx(infra, ipFamilies, field.NewPath("fsx"))...)
+	allErrs = append(allErrs, validateDeletionPolicy(infra, field.NewPath("deletionPolicy"))...)
+
+	return allErrs
+}
+
+func validateEgress(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	egress := infra.Networks.Egress
+	if egress == nil {
+		return allErrs
+	}
+
+	supportedModes := []string{string(apisaws.EgressModeNATGatewayPerZone), string(apisaws.EgressModeSingleNATGateway), string(apisaws.EgressModeNone)}
+	if !slices.Contains(supportedModes, string(egress.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), egress.Mode, supportedModes))
+	}
+
+	// without NAT gateway the nodes pull the layers of the images from ECR through the S3 gateway endpoint, the other
+	// services and the kube-apiserver have to be reachable via interface endpoints or other routes of existing VPCs
+	if egress.Mode == apisaws.EgressModeNone && infra.Networks.VPC.ID == nil && !slices.Contains(infra.Networks.VPC.GatewayEndpoints, "s3") {
+		allErrs = append(allErrs, field.Required(field.NewPath("networks", "vpc", "gatewayEndpoints"), fmt.Sprintf("must contain the s3 gateway endpoint in mode %s", apisaws.EgressModeNone)))
+	}
+
+	if egress.NATGatewayZone != nil {
+		natGatewayZonePath := fldPath.Child("natGatewayZone")
+		if egress.Mode != apisaws.EgressModeSingleNATGateway {
+			allErrs = append(allErrs, field.Forbidden(natGatewayZonePath, fmt.Sprintf("is only allowed in mode %s", apisaws.EgressModeSingleNATGateway)))
+		} else if !slices.ContainsFunc(infra.Networks.Zones, func(zone apisaws.Zone) bool { return zone.Name == *egress.NATGatewayZone }) {
+			allErrs = append(allErrs, field.Invalid(natGatewayZonePath, *egress.NATGatewayZone, "must be one of the configured zones"))
+		}
+	}
+
+	return allErrs
+}
+
+func isSingleNATGateway(infra *apisaws.InfrastructureConfig) bool {
+	return infra.Networks.Egress != nil && infra.Networks.Egress.Mode == apisaws.EgressModeSingleNATGateway
+}
+
+func validateElasticIPPool(pool *apisaws.ElasticIPPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if pool == nil {
+		return allErrs
+	}
+
+	switch {
+	case pool.PublicIPv4PoolID == nil && pool.IPAMPoolID == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify either a public IPv4 pool id or an IPAM pool id"))
+	case pool.PublicIPv4PoolID != nil && pool.IPAMPoolID != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, pool, "cannot specify both public IPv4 pool id and IPAM pool id"))
+	case pool.PublicIPv4PoolID != nil:
+		allErrs = append(allErrs, validatePublicIPv4PoolID(*pool.PublicIPv4PoolID, fldPath.Child("publicIPv4PoolID"))...)
+	default:
+		allErrs = append(allErrs, validateIPAMPoolID(*pool.IPAMPoolID, fldPath.Child("ipamPoolID"))...)
+	}
+
+	return allErrs
+}
+
+func validateNetworkACLs(acls *apisaws.NetworkACLs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if acls == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateNetworkACL(acls.Public, fldPath.Child("public"))...)
+	allErrs = append(allErrs, validateNetworkACL(acls.Internal, fldPath.Child("internal"))...)
+	allErrs = append(allErrs, validateNetworkACL(acls.Workers, fldPath.Child("workers"))...)
+
+	return allErrs
+}
+
+func validateNetworkACL(acl *apisaws.NetworkACL, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if acl == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateNetworkACLRules(acl.Ingress, fldPath.Child("ingress"))...)
+	allErrs = append(allErrs, validateNetworkACLRules(acl.Egress, fldPath.Child("egress"))...)
+
+	return allErrs
+}
+
+func validateNetworkACLRules(rules []apisaws.NetworkACLRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(rules) > maxNetworkACLRules {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(rules), maxNetworkACLRules))
+	}
+
+	ruleNumbers := sets.New[int32]()
+	for i, rule := range rules {
+		rulePath := fldPath.Index(i)
+
+		if rule.RuleNumber < 1 || rule.RuleNumber > 32766 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("ruleNumber"), rule.RuleNumber, "must be between 1 and 32766"))
+		} else if ruleNumbers.Has(rule.RuleNumber) {
+			allErrs = append(allErrs, field.Duplicate(rulePath.Child("ruleNumber"), rule.RuleNumber))
+		}
+		ruleNumbers.Insert(rule.RuleNumber)
+
+		supportedActions := []string{string(apisaws.NetworkACLRuleActionAllow), string(apisaws.NetworkACLRuleActionDeny)}
+		if !slices.Contains(supportedActions, string(rule.Action)) {
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("action"), rule.Action, supportedActions))
+		}
+
+		switch rule.Protocol {
+		case "all", "tcp", "udp", "icmp", "icmpv6":
+		default:
+			if n, err := strconv.Atoi(rule.Protocol); err != nil || n < 0 || n > 255 {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("protocol"), rule.Protocol, "must be one of all, tcp, udp, icmp, icmpv6 or an IP protocol number between 0 and 255"))
+			}
+		}
+
+		if _, _, err := net.ParseCIDR(rule.CIDR); err != nil {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("cidr"), rule.CIDR, "must be a valid IPv4 or IPv6 CIDR"))
+		}
+
+		if rule.Protocol == "tcp" || rule.Protocol == "udp" {
+			if rule.FromPort == nil {
+				allErrs = append(allErrs, field.Required(rulePath.Child("fromPort"), fmt.Sprintf("must be set for protocol %s", rule.Protocol)))
+			} else if *rule.FromPort < 0 || *rule.FromPort > 65535 {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("fromPort"), *rule.FromPort, "must be between 0 and 65535"))
+			}
+			if rule.ToPort == nil {
+				allErrs = append(allErrs, field.Required(rulePath.Child("toPort"), fmt.Sprintf("must be set for protocol %s", rule.Protocol)))
+			} else if *rule.ToPort < 0 || *rule.ToPort > 65535 {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("toPort"), *rule.ToPort, "must be between 0 and 65535"))
+			} else if rule.FromPort != nil && *rule.FromPort > *rule.ToPort {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("toPort"), *rule.ToPort, "must not be less than fromPort"))
+			}
+		} else {
+			if rule.FromPort != nil {
+				allErrs = append(allErrs, field.Forbidden(rulePath.Child("fromPort"), "is only allowed for protocols tcp and udp"))
+			}
+			if rule.ToPort != nil {
+				allErrs = append(allErrs, field.Forbidden(rulePath.Child("toPort"), "is only allowed for protocols tcp and udp"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateSecondaryElasticIPs(secondary *apisaws.SecondaryElasticIPs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case secondary.Count == nil && len(secondary.AllocationIDs) == 0:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify either a count or allocation ids"))
+	case secondary.Count != nil && len(secondary.AllocationIDs) > 0:
+		allErrs = append(allErrs, field.Invalid(fldPath, secondary, "cannot specify both count and allocation ids"))
+	case secondary.Count != nil:
+		if *secondary.Count < 1 || *secondary.Count > maxSecondaryElasticIPs {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("count"), *secondary.Count, fmt.Sprintf("must be between 1 and %d", maxSecondaryElasticIPs)))
+		}
+	default:
+		allocationIDsPath := fldPath.Child("allocationIDs")
+		if len(secondary.AllocationIDs) > maxSecondaryElasticIPs {
+			allErrs = append(allErrs, field.TooMany(allocationIDsPath, len(secondary.AllocationIDs), maxSecondaryElasticIPs))
+		}
+		for i, id := range secondary.AllocationIDs {
+			allErrs = append(allErrs, validateEipAllocationID(id, allocationIDsPath.Index(i))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateFlowLogs(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	flowLogs := infra.FlowLogs
+	if flowLogs == nil {
+		return allErrs
+	}
+
+	if infra.Networks.VPC.ID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "flow logs can only be configured for a VPC created by the extension"))
+	}
+
+	switch {
+	case flowLogs.S3BucketARN == nil && flowLogs.CloudWatchLogGroup == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify either an S3 bucket ARN or a CloudWatch log group"))
+	case flowLogs.S3BucketARN != nil && flowLogs.CloudWatchLogGroup != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, flowLogs, "cannot specify both S3 bucket ARN and CloudWatch log group"))
+	case flowLogs.S3BucketARN != nil:
+		allErrs = append(allErrs, validateS3BucketArn(*flowLogs.S3BucketARN, fldPath.Child("s3BucketARN"))...)
+	default:
+		allErrs = append(allErrs, validateCloudWatchLogGroupName(*flowLogs.CloudWatchLogGroup, fldPath.Child("cloudWatchLogGroup"))...)
+	}
+
+	if flowLogs.TrafficType != nil {
+		supportedTrafficTypes := []string{string(apisaws.FlowLogTrafficTypeAll), string(apisaws.FlowLogTrafficTypeAccept), string(apisaws.FlowLogTrafficTypeReject)}
+		if !slices.Contains(supportedTrafficTypes, string(*flowLogs.TrafficType)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("trafficType"), *flowLogs.TrafficType, supportedTrafficTypes))
+		}
+	}
+	if flowLogs.LogFormat != nil {
+		allErrs = append(allErrs, validateFlowLogFormat(*flowLogs.LogFormat, fldPath.Child("logFormat"))...)
+	}
+	if flowLogs.MaxAggregationInterval != nil && *flowLogs.MaxAggregationInterval != 60 && *flowLogs.MaxAggregationInterval != 600 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAggregationInterval"), *flowLogs.MaxAggregationInterval, "must be either 60 or 600"))
+	}
+
+	return allErrs
+}
+
+func validateDeletionPolicy(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	policy := infra.DeletionPolicy
+	if policy == nil {
+		return allErrs
+	}
+
+	supportedResources := []string{string(apisaws.RetainedResourceElasticFileSystem), string(apisaws.RetainedResourceElasticIPs), string(apisaws.RetainedResourceVPC)}
+	retained := sets.New[apisaws.RetainedResource]()
+	for i, resource := range policy.Retain {
+		resourcePath := fldPath.Child("retain").Index(i)
+		switch {
+		case !slices.Contains(supportedResources, string(resource)):
+			allErrs = append(allErrs, field.NotSupported(resourcePath, resource, supportedResources))
+		case retained.Has(resource):
+			allErrs = append(allErrs, field.Duplicate(resourcePath, resource))
+		case resource == apisaws.RetainedResourceVPC && infra.Networks.VPC.ID != nil:
+			allErrs = append(allErrs, field.Forbidden(resourcePath, "an existing VPC is never deleted by the extension"))
+		}
+		retained.Insert(resource)
+	}
+
+	return allErrs
+}
+
+func validateECRConfig(ecr *apisaws.ECRConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ecr == nil {
+		return allErrs
+	}
+
+	if ecr.CacheDuration != nil && ecr.CacheDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cacheDuration"), ecr.CacheDuration.Duration.String(), "must not be negative"))
+	}
+
+	for i, registry := range ecr.Registries {
+		registryPath := fldPath.Child("registries").Index(i)
+		if len(registry.MatchImages) == 0 {
+			allErrs = append(allErrs, field.Required(registryPath.Child("matchImages"), "must specify at least one image pattern"))
+		}
+		for j, pattern := range registry.MatchImages {
+			allErrs = append(allErrs, validateECRImagePattern(pattern, registryPath.Child("matchImages").Index(j))...)
+		}
+		if registry.RoleARN != nil {
+			allErrs = append(allErrs, validateIamRoleArn(*registry.RoleARN, registryPath.Child("roleARN"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateElasticFileSystem(efs *apisaws.ElasticFileSystemConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if efs == nil {
+		return allErrs
+	}
+
+	if efs.ID != nil {
+		// the settings of a user provided EFS are not managed
+		for _, setting := range []struct {
+			name string
+			set  bool
+		}{
+			{"performanceMode", efs.PerformanceMode != nil},
+			{"throughputMode", efs.ThroughputMode != nil},
+			{"provisionedThroughputInMibps", efs.ProvisionedThroughputInMibps != nil},
+			{"kmsKeyID", efs.KMSKeyID != nil},
+			{"lifecyclePolicy", efs.LifecyclePolicy != nil},
+			{"automaticBackups", efs.AutomaticBackups != nil},
+		} {
+			if setting.set {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child(setting.name), "must not be set if the id of an existing EFS is specified"))
+			}
+		}
+	}
+
+	if efs.PerformanceMode != nil {
+		supportedModes := []string{string(apisaws.EFSPerformanceModeGeneralPurpose), string(apisaws.EFSPerformanceModeMaxIO)}
+		if !slices.Contains(supportedModes, string(*efs.PerformanceMode)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("performanceMode"), *efs.PerformanceMode, supportedModes))
+		}
+	}
+
+	throughputMode := ptr.Deref(efs.ThroughputMode, apisaws.EFSThroughputModeBursting)
+	supportedThroughputModes := []string{string(apisaws.EFSThroughputModeBursting), string(apisaws.EFSThroughputModeProvisioned), string(apisaws.EFSThroughputModeElastic)}
+	if !slices.Contains(supportedThroughputModes, string(throughputMode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("throughputMode"), throughputMode, supportedThroughputModes))
+	}
+	if throughputMode == apisaws.EFSThroughputModeElastic && ptr.Deref(efs.PerformanceMode, "") == apisaws.EFSPerformanceModeMaxIO {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("throughputMode"), throughputMode, fmt.Sprintf("is not supported with performance mode %s", apisaws.EFSPerformanceModeMaxIO)))
+	}
+	provisionedPath := fldPath.Child("provisionedThroughputInMibps")
+	switch {
+	case throughputMode == apisaws.EFSThroughputModeProvisioned && efs.ProvisionedThroughputInMibps == nil:
+		allErrs = append(allErrs, field.Required(provisionedPath, fmt.Sprintf("must be set for throughput mode %s", apisaws.EFSThroughputModeProvisioned)))
+	case throughputMode != apisaws.EFSThroughputModeProvisioned && efs.ProvisionedThroughputInMibps != nil:
+		allErrs = append(allErrs, field.Forbidden(provisionedPath, fmt.Sprintf("is only allowed for throughput mode %s", apisaws.EFSThroughputModeProvisioned)))
+	case efs.ProvisionedThroughputInMibps != nil && *efs.ProvisionedThroughputInMibps < 1:
+		allErrs = append(allErrs, field.Invalid(provisionedPath, *efs.ProvisionedThroughputInMibps, "must be at least 1"))
+	}
+
+	if efs.KMSKeyID != nil {
+		allErrs = append(allErrs, validateKMSKeyID(*efs.KMSKeyID, fldPath.Child("kmsKeyID"))...)
+	}
+
+	if policy := efs.LifecyclePolicy; policy != nil {
+		policyPath := fldPath.Child("lifecyclePolicy")
+		transitionValues := []string{"AFTER_1_DAY", "AFTER_7_DAYS", "AFTER_14_DAYS", "AFTER_30_DAYS", "AFTER_60_DAYS", "AFTER_90_DAYS", "AFTER_180_DAYS", "AFTER_270_DAYS", "AFTER_365_DAYS"}
+		if policy.TransitionToIA != nil && !slices.Contains(transitionValues, *policy.TransitionToIA) {
+			allErrs = append(allErrs, field.NotSupported(policyPath.Child("transitionToIA"), *policy.TransitionToIA, transitionValues))
+		}
+		if policy.TransitionToArchive != nil {
+			if !slices.Contains(transitionValues, *policy.TransitionToArchive) {
+				allErrs = append(allErrs, field.NotSupported(policyPath.Child("transitionToArchive"), *policy.TransitionToArchive, transitionValues))
+			}
+			if throughputMode != apisaws.EFSThroughputModeElastic {
+				allErrs = append(allErrs, field.Forbidden(policyPath.Child("transitionToArchive"), fmt.Sprintf("is only allowed for throughput mode %s", apisaws.EFSThroughputModeElastic)))
+			}
+		}
+		if policy.TransitionToPrimaryStorageClass != nil && *policy.TransitionToPrimaryStorageClass != "AFTER_1_ACCESS" {
+			allErrs = append(allErrs, field.NotSupported(policyPath.Child("transitionToPrimaryStorageClass"), *policy.TransitionToPrimaryStorageClass, []string{"AFTER_1_ACCESS"}))
+		}
+	}
+
+	names := sets.New[string]()
+	for i, accessPoint := range efs.AccessPoints {
+		accessPointPath := fldPath.Child("accessPoints").Index(i)
+		namePath := accessPointPath.Child("name")
+		// the name is used as suffix of the persistent volume and storage class names in the shoot
+		for _, msg := range validation.IsDNS1123Label(accessPoint.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, accessPoint.Name, msg))
+		}
+		if names.Has(accessPoint.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, accessPoint.Name))
+		}
+		names.Insert(accessPoint.Name)
+
+		pathPath := accessPointPath.Child("path")
+		allErrs = append(allErrs, validateEFSAccessPointPath(accessPoint.Path, pathPath)...)
+		if slices.Contains(strings.Split(accessPoint.Path, "/"), "..") {
+			allErrs = append(allErrs, field.Invalid(pathPath, accessPoint.Path, "must not contain '..'"))
+		}
+
+		if user := accessPoint.PosixUser; user != nil {
+			userPath := accessPointPath.Child("posixUser")
+			if user.UID < 0 {
+				allErrs = append(allErrs, field.Invalid(userPath.Child("uid"), user.UID, "must not be negative"))
+			}
+			if user.GID < 0 {
+				allErrs = append(allErrs, field.Invalid(userPath.Child("gid"), user.GID, "must not be negative"))
+			}
+			for j, gid := range user.SecondaryGIDs {
+				if gid < 0 {
+					allErrs = append(allErrs, field.Invalid(userPath.Child("secondaryGIDs").Index(j), gid, "must not be negative"))
+				}
+			}
+		}
+
+		if accessPoint.Permissions != nil {
+			allErrs = append(allErrs, validateEFSPermissions(*accessPoint.Permissions, accessPointPath.Child("permissions"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateFSx(infra *apisaws.InfrastructureConfig, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fsx := infra.FSx
+	if fsx == nil {
+		return allErrs
+	}
+
+	// the file system is created in a worker subnet, which only exists with IPv4
+	if ipFamilies != nil && !slices.Contains(ipFamilies, core.IPFamilyIPv4) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "is only supported with IPv4"))
+	}
+
+	if fsx.Zone != nil && !slices.ContainsFunc(infra.Networks.Zones, func(zone apisaws.Zone) bool { return zone.Name == *fsx.Zone }) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("zone"), *fsx.Zone, "must be one of the configured zones"))
+	}
+
+	var (
+		deploymentType  = apisawshelper.FSxDeploymentType(fsx)
+		capacityPath    = fldPath.Child("storageCapacityGiB")
+		lustrePath      = fldPath.Child("lustre")
+		openZFSPath     = fldPath.Child("openZFS")
+		deploymentTypes []string
+	)
+
+	switch fsx.Type {
+	case apisaws.FSxFileSystemTypeLustre:
+		deploymentTypes = []string{"SCRATCH_1", "SCRATCH_2", "PERSISTENT_1", "PERSISTENT_2"}
+		if fsx.OpenZFS != nil {
+			allErrs = append(allErrs, field.Forbidden(openZFSPath, fmt.Sprintf("is only allowed for type %s", apisaws.FSxFileSystemTypeOpenZFS)))
+		}
+
+		// see https://docs.aws.amazon.com/fsx/latest/LustreGuide/managing-storage-capacity.html
+		increment := int32(2400)
+		if deploymentType == "SCRATCH_1" {
+			increment = 3600
+		}
+		if capacity := fsx.StorageCapacityGiB; capacity != 1200 && capacity != 2400 && (capacity <= 0 || capacity%increment != 0) {
+			allErrs = append(allErrs, field.Invalid(capacityPath, capacity, fmt.Sprintf("must be 1200, 2400 or a multiple of %d", increment)))
+		}
+
+		var throughput *int32
+		if fsx.Lustre != nil {
+			throughput = fsx.Lustre.PerUnitStorageThroughput
+		}
+		throughputPath := lustrePath.Child("perUnitStorageThroughput")
+		supportedThroughputs := map[string][]string{
+			"PERSISTENT_1": {"50", "100", "200"},
+			"PERSISTENT_2": {"125", "250", "500", "1000"},
+		}[deploymentType]
+		switch {
+		case supportedThroughputs != nil && throughput == nil:
+			allErrs = append(allErrs, field.Required(throughputPath, fmt.Sprintf("must be set for deployment type %s", deploymentType)))
+		case supportedThroughputs == nil && throughput != nil:
+			allErrs = append(allErrs, field.Forbidden(throughputPath, "is only allowed for persistent deployment types"))
+		case throughput != nil && !slices.Contains(supportedThroughputs, fmt.Sprint(*throughput)):
+			allErrs = append(allErrs, field.NotSupported(throughputPath, *throughput, supportedThroughputs))
+		}
+	case apisaws.FSxFileSystemTypeOpenZFS:
+		deploymentTypes = []string{"SINGLE_AZ_1", "SINGLE_AZ_2"}
+		if fsx.Lustre != nil {
+			allErrs = append(allErrs, field.Forbidden(lustrePath, fmt.Sprintf("is only allowed for type %s", apisaws.FSxFileSystemTypeLustre)))
+		}
+
+		if capacity := fsx.StorageCapacityGiB; capacity < 64 || capacity > 524288 {
+			allErrs = append(allErrs, field.Invalid(capacityPath, capacity, "must be between 64 and 524288"))
+		}
+
+		if fsx.OpenZFS == nil {
+			allErrs = append(allErrs, field.Required(openZFSPath, fmt.Sprintf("must be set for type %s", apisaws.FSxFileSystemTypeOpenZFS)))
+		} else {
+			throughputPath := openZFSPath.Child("throughputCapacity")
+			supportedThroughputs := map[string][]string{
+				"SINGLE_AZ_1": {"64", "128", "256", "512", "1024", "2048", "3072", "4096"},
+				"SINGLE_AZ_2": {"160", "320", "640", "1280", "2560", "3840", "5120", "7680", "10240"},
+			}[deploymentType]
+			if supportedThroughputs != nil && !slices.Contains(supportedThroughputs, fmt.Sprint(fsx.OpenZFS.ThroughputCapacity)) {
+				allErrs = append(allErrs, field.NotSupported(throughputPath, fsx.OpenZFS.ThroughputCapacity, supportedThroughputs))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), fsx.Type, []string{string(apisaws.FSxFileSystemTypeLustre), string(apisaws.FSxFileSystemTypeOpenZFS)}))
+	}
+
+	if deploymentTypes != nil && !slices.Contains(deploymentTypes, deploymentType) {
+		deploymentTypePath := lustrePath.Child("deploymentType")
+		if fsx.Type == apisaws.FSxFileSystemTypeOpenZFS {
+			deploymentTypePath = openZFSPath.Child("deploymentType")
+		}
+		allErrs = append(allErrs, field.NotSupported(deploymentTypePath, deploymentType, deploymentTypes))
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigPartition validates that the ARNs in the InfrastructureConfig belong to the given
+// partition of the shoot region.
+func ValidateInfrastructureConfigPartition(infra *apisaws.InfrastructureConfig, partition string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if infra.ECR == nil {
+		return allErrs
+	}
+	for i, registry := range infra.ECR.Registries {
+		if registry.RoleARN == nil {
+			continue
+		}
+		if arnPartition, _, _ := strings.Cut(strings.TrimPrefix(*registry.RoleARN, "arn:"), ":"); arnPartition != partition {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ecr", "registries").Index(i).Child("roleARN"), *registry.RoleARN, fmt.Sprintf("must be in partition %q of the shoot region", partition)))
+		}
+	}
 
 	return allErrs
 }
@@ -179,9 +711,27 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, ipFamilie
 func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisaws.InfrastructureConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	// only allowed to be set once
-	if oldConfig.ElasticFileSystem != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.ElasticFileSystem, oldConfig.ElasticFileSystem, field.NewPath("elasticFileSystem"))...)
+	// only allowed to be set once, the settings which can be changed in place are updated by the infrastructure controller
+	if oldEFS := oldConfig.ElasticFileSystem; oldEFS != nil {
+		efsPath := field.NewPath("elasticFileSystem")
+		newEFS := ptr.Deref(newConfig.ElasticFileSystem, apisaws.ElasticFileSystemConfig{})
+		if newEFS.Enabled != oldEFS.Enabled || !ptr.Equal(newEFS.ID, oldEFS.ID) {
+			allErrs = append(allErrs, field.Invalid(efsPath, newConfig.ElasticFileSystem, apivalidation.FieldImmutableErrorMsg))
+		}
+		// the effective values are compared, so that the defaults used by AWS can be set explicitly
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(
+			ptr.Deref(newEFS.PerformanceMode, apisaws.EFSPerformanceModeGeneralPurpose),
+			ptr.Deref(oldEFS.PerformanceMode, apisaws.EFSPerformanceModeGeneralPurpose),
+			efsPath.Child("performanceMode"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(
+			ptr.Deref(newEFS.KMSKeyID, efsDefaultKMSKeyID),
+			ptr.Deref(oldEFS.KMSKeyID, efsDefaultKMSKeyID),
+			efsPath.Child("kmsKeyID"))...)
+	}
+
+	// the FSx file system can be removed, but not changed, as changing most of its settings requires a replacement
+	if oldConfig.FSx != nil && newConfig.FSx != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.FSx, oldConfig.FSx, field.NewPath("fsx"))...)
 	}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.EnableDedicatedTenancyForVPC, oldConfig.EnableDedicatedTenancyForVPC, field.NewPath("enableDedicatedTenancyForVPC"))...)
@@ -192,6 +742,14 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisaws.Infrastruc
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.ID, oldVPC.ID, vpcPath.Child("id"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.CIDR, oldVPC.CIDR, vpcPath.Child("cidr"))...)
 
+	// moving the single NAT gateway to another zone disrupts the egress traffic, it is only possible by switching the mode
+	if isSingleNATGateway(oldConfig) && isSingleNATGateway(newConfig) {
+		oldZone := apisawshelper.NATGatewayZone(oldConfig, "")
+		if newZone := apisawshelper.NATGatewayZone(newConfig, ""); newZone != oldZone {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("networks.egress.natGatewayZone"), newZone, apivalidation.FieldImmutableErrorMsg))
+		}
+	}
+
 	var (
 		oldZones = oldConfig.Networks.Zones
 		newZones = newConfig.Networks.Zones
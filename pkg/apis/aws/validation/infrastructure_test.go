@@ -6,6 +6,7 @@ package validation_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -13,6 +14,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -170,6 +172,28 @@ var _ = Describe("InfrastructureConfig validation", func() {
 		})
 	})
 
+	Describe("#ValidateInfrastructureConfigPartition", func() {
+		It("should pass if the role ARNs are in the partition of the region", func() {
+			infra := &apisaws.InfrastructureConfig{ECR: &apisaws.ECRConfig{Registries: []apisaws.ECRRegistry{
+				{MatchImages: []string{"*.dkr.ecr.*.amazonaws.com.cn"}},
+				{MatchImages: []string{"*.dkr.ecr.*.amazonaws.com.cn"}, RoleARN: ptr.To("arn:aws-cn:iam::123456789012:role/ecr-pull")},
+			}}}
+
+			Expect(ValidateInfrastructureConfigPartition(infra, "aws-cn", field.NewPath("spec"))).To(BeEmpty())
+		})
+
+		It("should forbid role ARNs of other partitions", func() {
+			infra := &apisaws.InfrastructureConfig{ECR: &apisaws.ECRConfig{Registries: []apisaws.ECRRegistry{
+				{MatchImages: []string{"*.dkr.ecr.*.amazonaws.com"}, RoleARN: ptr.To("arn:aws:iam::123456789012:role/ecr-pull")},
+			}}}
+
+			Expect(ValidateInfrastructureConfigPartition(infra, "aws-us-gov", field.NewPath("spec"))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ecr.registries[0].roleARN"),
+			}))))
+		})
+	})
+
 	Describe("#ValidateInfrastructureConfig", func() {
 		Context("VPC", func() {
 			Context("ID", func() {
@@ -478,6 +502,352 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			})
 		})
 
+		Context("egress", func() {
+			It("should allow a single NAT gateway in a configured zone", func() {
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+				infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeSingleNATGateway, NATGatewayZone: ptr.To(awsZone2.Name)}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported modes", func() {
+				infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: "NATInstance"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.egress.mode"),
+				}))
+			})
+
+			It("should forbid a NAT gateway zone which is not configured", func() {
+				infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeSingleNATGateway, NATGatewayZone: ptr.To("foo")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.egress.natGatewayZone"),
+				}))
+			})
+
+			It("should forbid a NAT gateway zone in other modes", func() {
+				infrastructureConfig.Networks.VPC.GatewayEndpoints = []string{"s3"}
+				infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeNone, NATGatewayZone: ptr.To(infrastructureConfig.Networks.Zones[0].Name)}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.egress.natGatewayZone"),
+				}))
+			})
+
+			It("should require the s3 gateway endpoint without NAT gateway in a managed VPC", func() {
+				infrastructureConfig.Networks.VPC = apisaws.VPC{CIDR: ptr.To("10.0.0.0/8")}
+				infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeNone}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.vpc.gatewayEndpoints"),
+				}))
+
+				infrastructureConfig.Networks.VPC.GatewayEndpoints = []string{"s3"}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid elastic IPs for zones without NAT gateway", func() {
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+				infrastructureConfig.Networks.Zones[1].ElasticIPAllocationID = ptr.To("eipalloc-123456")
+				infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeSingleNATGateway}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[1].elasticIPAllocationID"),
+				}))
+			})
+		})
+
+		Context("secondaryElasticIPs", func() {
+			It("should allow a count or allocation ids", func() {
+				infrastructureConfig.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](2)}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+
+				infrastructureConfig.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{AllocationIDs: []string{"eipalloc-1", "eipalloc-2"}}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should require exactly one of count and allocation ids", func() {
+				infrastructureConfig.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[0].secondaryElasticIPs"),
+				}))
+
+				infrastructureConfig.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](1), AllocationIDs: []string{"eipalloc-1"}}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].secondaryElasticIPs"),
+				}))
+			})
+
+			It("should forbid counts out of range", func() {
+				infrastructureConfig.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](8)}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].secondaryElasticIPs.count"),
+				}))
+			})
+
+			It("should forbid invalid and duplicate allocation ids", func() {
+				infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = ptr.To("eipalloc-1")
+				infrastructureConfig.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{AllocationIDs: []string{"eipalloc-1", "foo"}}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[0].secondaryElasticIPs.allocationIDs[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].secondaryElasticIPs.allocationIDs[1]"),
+				}))
+			})
+
+			It("should forbid secondary elastic IPs for zones without NAT gateway", func() {
+				infrastructureConfig.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](1)}
+				infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeNone}
+				infrastructureConfig.Networks.VPC.GatewayEndpoints = []string{"s3"}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].secondaryElasticIPs"),
+				}))
+			})
+		})
+
+		Context("elasticIPPool", func() {
+			It("should allow a public IPv4 pool or an IPAM pool", func() {
+				infrastructureConfig.Networks.ElasticIPPool = &apisaws.ElasticIPPool{PublicIPv4PoolID: ptr.To("ipv4pool-ec2-0123456789abcdef0")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+
+				infrastructureConfig.Networks.ElasticIPPool = &apisaws.ElasticIPPool{IPAMPoolID: ptr.To("ipam-pool-0123456789abcdef0")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should require exactly one pool", func() {
+				infrastructureConfig.Networks.ElasticIPPool = &apisaws.ElasticIPPool{}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.elasticIPPool"),
+				}))
+
+				infrastructureConfig.Networks.ElasticIPPool = &apisaws.ElasticIPPool{
+					PublicIPv4PoolID: ptr.To("ipv4pool-ec2-0123456789abcdef0"),
+					IPAMPoolID:       ptr.To("ipam-pool-0123456789abcdef0"),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.elasticIPPool"),
+				}))
+			})
+
+			It("should forbid invalid pool ids", func() {
+				infrastructureConfig.Networks.ElasticIPPool = &apisaws.ElasticIPPool{PublicIPv4PoolID: ptr.To("ipam-pool-0123456789abcdef0")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.elasticIPPool.publicIPv4PoolID"),
+				}))
+
+				infrastructureConfig.Networks.ElasticIPPool = &apisaws.ElasticIPPool{IPAMPoolID: ptr.To("ipv4pool-ec2-0123456789abcdef0")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.elasticIPPool.ipamPoolID"),
+				}))
+			})
+		})
+
+		Context("networkACLs", func() {
+			It("should allow valid network ACLs", func() {
+				infrastructureConfig.Networks.NetworkACLs = &apisaws.NetworkACLs{
+					Workers: &apisaws.NetworkACL{
+						Ingress: []apisaws.NetworkACLRule{
+							{RuleNumber: 100, Action: apisaws.NetworkACLRuleActionAllow, Protocol: "all", CIDR: "10.250.0.0/16"},
+							{RuleNumber: 200, Action: apisaws.NetworkACLRuleActionAllow, Protocol: "tcp", CIDR: "0.0.0.0/0", FromPort: ptr.To[int32](1024), ToPort: ptr.To[int32](65535)},
+							{RuleNumber: 300, Action: apisaws.NetworkACLRuleActionDeny, Protocol: "icmpv6", CIDR: "::/0"},
+						},
+						Egress: []apisaws.NetworkACLRule{
+							{RuleNumber: 100, Action: apisaws.NetworkACLRuleActionAllow, Protocol: "all", CIDR: "0.0.0.0/0"},
+						},
+					},
+					Public: &apisaws.NetworkACL{
+						Ingress: []apisaws.NetworkACLRule{
+							{RuleNumber: 100, Action: apisaws.NetworkACLRuleActionAllow, Protocol: "50", CIDR: "0.0.0.0/0"},
+						},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid invalid rules", func() {
+				infrastructureConfig.Networks.NetworkACLs = &apisaws.NetworkACLs{
+					Internal: &apisaws.NetworkACL{
+						Ingress: []apisaws.NetworkACLRule{
+							{RuleNumber: 0, Action: "reject", Protocol: "sctp", CIDR: "10.250.0.0"},
+							{RuleNumber: 100, Action: apisaws.NetworkACLRuleActionAllow, Protocol: "udp", CIDR: "0.0.0.0/0", FromPort: ptr.To[int32](53)},
+							{RuleNumber: 100, Action: apisaws.NetworkACLRuleActionAllow, Protocol: "tcp", CIDR: "0.0.0.0/0", FromPort: ptr.To[int32](443), ToPort: ptr.To[int32](80)},
+						},
+						Egress: []apisaws.NetworkACLRule{
+							{RuleNumber: 32767, Action: apisaws.NetworkACLRuleActionDeny, Protocol: "icmp", CIDR: "0.0.0.0/0", FromPort: ptr.To[int32](0)},
+						},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.ingress[0].ruleNumber"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.networkACLs.internal.ingress[0].action"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.ingress[0].protocol"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.ingress[0].cidr"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.networkACLs.internal.ingress[1].toPort"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.networkACLs.internal.ingress[2].ruleNumber"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.ingress[2].toPort"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.egress[0].ruleNumber"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.networkACLs.internal.egress[0].fromPort"),
+				}))
+			})
+
+			It("should forbid too many rules", func() {
+				acl := &apisaws.NetworkACL{}
+				for i := range 21 {
+					acl.Egress = append(acl.Egress, apisaws.NetworkACLRule{RuleNumber: int32(i + 1), Action: apisaws.NetworkACLRuleActionAllow, Protocol: "all", CIDR: "0.0.0.0/0"})
+				}
+				infrastructureConfig.Networks.NetworkACLs = &apisaws.NetworkACLs{Workers: acl}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("networks.networkACLs.workers.egress"),
+				}))
+			})
+		})
+
+		Context("flowLogs", func() {
+			It("should allow an S3 bucket or a CloudWatch log group", func() {
+				infrastructureConfig.FlowLogs = &apisaws.FlowLogs{
+					S3BucketARN:            ptr.To("arn:aws:s3:::my-flow-logs/my-shoot"),
+					TrafficType:            ptr.To(apisaws.FlowLogTrafficTypeReject),
+					LogFormat:              ptr.To("${srcaddr} ${dstaddr} ${action}"),
+					MaxAggregationInterval: ptr.To[int32](60),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+
+				infrastructureConfig.FlowLogs = &apisaws.FlowLogs{CloudWatchLogGroup: ptr.To("/gardener/flow-logs")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should require exactly one destination", func() {
+				infrastructureConfig.FlowLogs = &apisaws.FlowLogs{}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("flowLogs"),
+				}))
+
+				infrastructureConfig.FlowLogs = &apisaws.FlowLogs{
+					S3BucketARN:        ptr.To("arn:aws:s3:::my-flow-logs"),
+					CloudWatchLogGroup: ptr.To("/gardener/flow-logs"),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("flowLogs"),
+				}))
+			})
+
+			It("should forbid invalid settings", func() {
+				infrastructureConfig.FlowLogs = &apisaws.FlowLogs{
+					S3BucketARN:            ptr.To("arn:aws:iam::123456789012:role/flow-logs"),
+					TrafficType:            ptr.To[apisaws.FlowLogTrafficType]("SOME"),
+					LogFormat:              ptr.To("srcaddr,dstaddr"),
+					MaxAggregationInterval: ptr.To[int32](300),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("flowLogs.s3BucketARN"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("flowLogs.trafficType"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("flowLogs.logFormat"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("flowLogs.maxAggregationInterval"),
+				}))
+
+				infrastructureConfig.FlowLogs = &apisaws.FlowLogs{CloudWatchLogGroup: ptr.To("flow logs")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("flowLogs.cloudWatchLogGroup"),
+				}))
+			})
+
+			It("should forbid flow logs for an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = apisaws.VPC{ID: ptr.To("vpc-123456")}
+				infrastructureConfig.FlowLogs = &apisaws.FlowLogs{CloudWatchLogGroup: ptr.To("/gardener/flow-logs")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("flowLogs"),
+				}))
+			})
+		})
+
+		Context("deletionPolicy", func() {
+			It("should allow retaining resources and requiring a confirmation", func() {
+				infrastructureConfig.DeletionPolicy = &apisaws.DeletionPolicy{
+					Retain: []apisaws.RetainedResource{
+						apisaws.RetainedResourceElasticFileSystem,
+						apisaws.RetainedResourceElasticIPs,
+						apisaws.RetainedResourceVPC,
+					},
+					RequireConfirmation: true,
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid unknown and duplicate resources", func() {
+				infrastructureConfig.DeletionPolicy = &apisaws.DeletionPolicy{
+					Retain: []apisaws.RetainedResource{apisaws.RetainedResourceElasticIPs, "Subnets", apisaws.RetainedResourceElasticIPs},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("deletionPolicy.retain[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("deletionPolicy.retain[2]"),
+				}))
+			})
+
+			It("should forbid retaining an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = apisaws.VPC{ID: ptr.To("vpc-123456")}
+				infrastructureConfig.DeletionPolicy = &apisaws.DeletionPolicy{
+					Retain: []apisaws.RetainedResource{apisaws.RetainedResourceVPC},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("deletionPolicy.retain[0]"),
+				}))
+			})
+		})
+
 		Context("ignoreTags", func() {
 			It("should forbid ignoring reserved tags", func() {
 				infrastructureConfig.IgnoreTags = &apisaws.IgnoreTags{
@@ -488,6 +858,287 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				Expect(errorList).NotTo(BeEmpty())
 			})
 		})
+
+		Context("ecr", func() {
+			It("should pass for valid registries", func() {
+				infrastructureConfig.ECR = &apisaws.ECRConfig{
+					CacheDuration: &metav1.Duration{Duration: 6 * time.Hour},
+					Registries: []apisaws.ECRRegistry{
+						{MatchImages: []string{"*.dkr.ecr.*.amazonaws.com:443/team-*"}},
+						{
+							MatchImages: []string{"123456789012.dkr.ecr.eu-west-1.amazonaws.com"},
+							RoleARN:     ptr.To("arn:aws:iam::123456789012:role/path/ecr-pull"),
+						},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid invalid registries", func() {
+				infrastructureConfig.ECR = &apisaws.ECRConfig{
+					CacheDuration: &metav1.Duration{Duration: -time.Minute},
+					Registries: []apisaws.ECRRegistry{
+						{},
+						{
+							MatchImages: []string{"registry example.com", ""},
+							RoleARN:     ptr.To("arn:aws:iam::123456789012:user/foo"),
+						},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("ecr.cacheDuration"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("ecr.registries[0].matchImages"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("ecr.registries[1].matchImages[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("ecr.registries[1].matchImages[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("ecr.registries[1].roleARN"),
+					})),
+				))
+			})
+		})
+
+		Context("elasticFileSystem", func() {
+			It("should pass for a valid config", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled:                      true,
+					PerformanceMode:              ptr.To(apisaws.EFSPerformanceModeGeneralPurpose),
+					ThroughputMode:               ptr.To(apisaws.EFSThroughputModeProvisioned),
+					ProvisionedThroughputInMibps: ptr.To[int32](128),
+					KMSKeyID:                     ptr.To("alias/efs"),
+					LifecyclePolicy: &apisaws.EFSLifecyclePolicy{
+						TransitionToIA:                  ptr.To("AFTER_30_DAYS"),
+						TransitionToPrimaryStorageClass: ptr.To("AFTER_1_ACCESS"),
+					},
+					AutomaticBackups: ptr.To(true),
+					AccessPoints: []apisaws.EFSAccessPoint{
+						{Name: "data", Path: "/data", PosixUser: &apisaws.EFSPosixUser{UID: 1000, GID: 1000, SecondaryGIDs: []int64{2000}}, Permissions: ptr.To("0750")},
+						{Name: "logs", Path: "/"},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid settings for an existing EFS", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled:          true,
+					ID:               ptr.To("fs-06fbb971c2e1c6cdd"),
+					KMSKeyID:         ptr.To("alias/efs"),
+					AutomaticBackups: ptr.To(true),
+					AccessPoints:     []apisaws.EFSAccessPoint{{Name: "data", Path: "/data"}},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("elasticFileSystem.kmsKeyID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("elasticFileSystem.automaticBackups"),
+					})),
+				))
+			})
+
+			It("should forbid invalid throughput settings", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled:         true,
+					PerformanceMode: ptr.To(apisaws.EFSPerformanceModeMaxIO),
+					ThroughputMode:  ptr.To(apisaws.EFSThroughputModeElastic),
+					LifecyclePolicy: &apisaws.EFSLifecyclePolicy{
+						TransitionToIA: ptr.To("AFTER_2_DAYS"),
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.throughputMode"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("elasticFileSystem.lifecyclePolicy.transitionToIA"),
+					})),
+				))
+			})
+
+			It("should require the provisioned throughput only for throughput mode provisioned", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled:        true,
+					ThroughputMode: ptr.To(apisaws.EFSThroughputModeProvisioned),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("elasticFileSystem.provisionedThroughputInMibps"),
+					})),
+				))
+
+				infrastructureConfig.ElasticFileSystem.ThroughputMode = nil
+				infrastructureConfig.ElasticFileSystem.ProvisionedThroughputInMibps = ptr.To[int32](64)
+				infrastructureConfig.ElasticFileSystem.LifecyclePolicy = &apisaws.EFSLifecyclePolicy{TransitionToArchive: ptr.To("AFTER_90_DAYS")}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("elasticFileSystem.provisionedThroughputInMibps"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("elasticFileSystem.lifecyclePolicy.transitionToArchive"),
+					})),
+				))
+			})
+
+			It("should forbid invalid access points", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled: true,
+					AccessPoints: []apisaws.EFSAccessPoint{
+						{Name: "data", Path: "/data/../etc", Permissions: ptr.To("999")},
+						{Name: "data", Path: "data", PosixUser: &apisaws.EFSPosixUser{UID: -1}},
+						{Name: "Data", Path: "/"},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.accessPoints[0].path"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.accessPoints[0].permissions"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("elasticFileSystem.accessPoints[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.accessPoints[1].path"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.accessPoints[1].posixUser.uid"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.accessPoints[2].name"),
+					})),
+				))
+			})
+		})
+
+		Context("fsx", func() {
+			It("should pass for a valid Lustre config", func() {
+				infrastructureConfig.FSx = &apisaws.FSxConfig{
+					Type:               apisaws.FSxFileSystemTypeLustre,
+					Zone:               ptr.To(zone),
+					StorageCapacityGiB: 2400,
+					Lustre: &apisaws.FSxLustreConfig{
+						DeploymentType:           ptr.To("PERSISTENT_2"),
+						PerUnitStorageThroughput: ptr.To[int32](250),
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should pass for a valid OpenZFS config", func() {
+				infrastructureConfig.FSx = &apisaws.FSxConfig{
+					Type:               apisaws.FSxFileSystemTypeOpenZFS,
+					StorageCapacityGiB: 64,
+					OpenZFS:            &apisaws.FSxOpenZFSConfig{ThroughputCapacity: 128},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid Lustre config", func() {
+				infrastructureConfig.FSx = &apisaws.FSxConfig{
+					Type:               apisaws.FSxFileSystemTypeLustre,
+					Zone:               ptr.To(zone2),
+					StorageCapacityGiB: 3600,
+					Lustre: &apisaws.FSxLustreConfig{
+						PerUnitStorageThroughput: ptr.To[int32](250),
+					},
+					OpenZFS: &apisaws.FSxOpenZFSConfig{ThroughputCapacity: 128},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("fsx.zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("fsx.storageCapacityGiB"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("fsx.lustre.perUnitStorageThroughput"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("fsx.openZFS"),
+					})),
+				))
+			})
+
+			It("should require the throughput of persistent Lustre deployment types", func() {
+				infrastructureConfig.FSx = &apisaws.FSxConfig{
+					Type:               apisaws.FSxFileSystemTypeLustre,
+					StorageCapacityGiB: 1200,
+					Lustre:             &apisaws.FSxLustreConfig{DeploymentType: ptr.To("PERSISTENT_1")},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("fsx.lustre.perUnitStorageThroughput"),
+					})),
+				))
+			})
+
+			It("should forbid an invalid OpenZFS config", func() {
+				infrastructureConfig.FSx = &apisaws.FSxConfig{
+					Type:               apisaws.FSxFileSystemTypeOpenZFS,
+					StorageCapacityGiB: 32,
+					OpenZFS:            &apisaws.FSxOpenZFSConfig{DeploymentType: ptr.To("MULTI_AZ_1"), ThroughputCapacity: 128},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, familyIPv4, &nodes, &pods, &services)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("fsx.storageCapacityGiB"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("fsx.openZFS.deploymentType"),
+					})),
+				))
+			})
+
+			It("should forbid an unsupported type and IPv6", func() {
+				infrastructureConfig.FSx = &apisaws.FSxConfig{
+					Type:               "ONTAP",
+					StorageCapacityGiB: 1024,
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, []core.IPFamily{core.IPFamilyIPv6}, &nodes, &pods, &services)).To(ContainElements(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("fsx"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("fsx.type"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateInfrastructureConfigUpdate", func() {
@@ -504,6 +1155,23 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		It("should forbid moving the single NAT gateway to another zone", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+			infrastructureConfig.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeSingleNATGateway}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Egress.NATGatewayZone = ptr.To(infrastructureConfig.Networks.Zones[0].Name)
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+
+			newInfrastructureConfig.Networks.Egress.NATGatewayZone = ptr.To(awsZone2.Name)
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.egress.natGatewayZone"),
+			}))))
+
+			newInfrastructureConfig.Networks.Egress = nil
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
 		It("should allow changing gateway endpoints inside vpc", func() {
 			newInfraConfig := infrastructureConfig.DeepCopy()
 			newInfraConfig.Networks.VPC.GatewayEndpoints = []string{"myep"}
@@ -708,6 +1376,27 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		Context("FSx config updates", func() {
+			It("should allow adding and removing the fsx config", func() {
+				newInfraConfig := infrastructureConfig.DeepCopy()
+				newInfraConfig.FSx = &apisaws.FSxConfig{Type: apisaws.FSxFileSystemTypeLustre, StorageCapacityGiB: 1200}
+
+				Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig)).To(BeEmpty())
+				Expect(ValidateInfrastructureConfigUpdate(newInfraConfig, infrastructureConfig)).To(BeEmpty())
+			})
+
+			It("should forbid changing the fsx config", func() {
+				infrastructureConfig.FSx = &apisaws.FSxConfig{Type: apisaws.FSxFileSystemTypeLustre, StorageCapacityGiB: 1200}
+				newInfraConfig := infrastructureConfig.DeepCopy()
+				newInfraConfig.FSx.StorageCapacityGiB = 2400
+
+				Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("fsx"),
+				}))))
+			})
+		})
+
 		Context("EFS config updates", func() {
 			It("should allow setting the efs config once", func() {
 				newInfraConfig := infrastructureConfig.DeepCopy()
@@ -736,6 +1425,64 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))))
 			})
 
+			It("should allow updating the settings which can be changed in place", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled:  true,
+					KMSKeyID: ptr.To("alias/efs"),
+				}
+				newInfraConfig := infrastructureConfig.DeepCopy()
+				newInfraConfig.ElasticFileSystem.ThroughputMode = ptr.To(apisaws.EFSThroughputModeElastic)
+				newInfraConfig.ElasticFileSystem.LifecyclePolicy = &apisaws.EFSLifecyclePolicy{TransitionToIA: ptr.To("AFTER_7_DAYS")}
+				newInfraConfig.ElasticFileSystem.AutomaticBackups = ptr.To(true)
+				newInfraConfig.ElasticFileSystem.AccessPoints = []apisaws.EFSAccessPoint{{Name: "data", Path: "/data"}}
+
+				Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig)).To(BeEmpty())
+			})
+
+			It("should forbid changing the performance mode and the KMS key", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled:  true,
+					KMSKeyID: ptr.To("alias/efs"),
+				}
+				newInfraConfig := infrastructureConfig.DeepCopy()
+				newInfraConfig.ElasticFileSystem.PerformanceMode = ptr.To(apisaws.EFSPerformanceModeMaxIO)
+				newInfraConfig.ElasticFileSystem.KMSKeyID = ptr.To("alias/other")
+
+				Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.performanceMode"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("elasticFileSystem.kmsKeyID"),
+					})),
+				))
+			})
+
+			It("should allow setting the defaults of the performance mode and the KMS key explicitly", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled: true,
+				}
+				newInfraConfig := infrastructureConfig.DeepCopy()
+				newInfraConfig.ElasticFileSystem.PerformanceMode = ptr.To(apisaws.EFSPerformanceModeGeneralPurpose)
+				newInfraConfig.ElasticFileSystem.KMSKeyID = ptr.To("alias/aws/elasticfilesystem")
+
+				Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig)).To(BeEmpty())
+				Expect(ValidateInfrastructureConfigUpdate(newInfraConfig, infrastructureConfig)).To(BeEmpty())
+			})
+
+			It("should forbid setting another performance mode or KMS key than the defaults", func() {
+				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
+					Enabled: true,
+				}
+				newInfraConfig := infrastructureConfig.DeepCopy()
+				newInfraConfig.ElasticFileSystem.PerformanceMode = ptr.To(apisaws.EFSPerformanceModeMaxIO)
+				newInfraConfig.ElasticFileSystem.KMSKeyID = ptr.To("alias/efs")
+
+				Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig)).To(HaveLen(2))
+			})
+
 			It("should forbid to remove existing efs config", func() {
 				infrastructureConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{
 					Enabled: true,
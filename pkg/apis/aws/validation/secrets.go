@@ -6,11 +6,13 @@ package validation
 
 import (
 	"fmt"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
 
@@ -27,7 +29,8 @@ func ValidateCloudProviderSecret(secret *corev1.Secret, fldPath *field.Path) fie
 
 	// Allow all possible keys
 	allErrs = append(allErrs, validateNoUnexpectedKeys(secret.Data, dataPath, secretRef,
-		aws.AccessKeyID, aws.SecretAccessKey, aws.Region,
+		aws.AccessKeyID, aws.SecretAccessKey, aws.Region, aws.AssumeRoleChain,
+		aws.Partition, aws.Endpoint, aws.UseFIPSEndpoints,
 		aws.DNSAccessKeyID, aws.DNSSecretAccessKey, aws.DNSRegion)...)
 
 	// Validate required credentials
@@ -43,6 +46,41 @@ func ValidateCloudProviderSecret(secret *corev1.Secret, fldPath *field.Path) fie
 		allErrs = append(allErrs, validateRegion(string(region), dataPath.Key(regionKey))...)
 	}
 
+	// Validate optional role chain
+	if data, ok := secret.Data[aws.AssumeRoleChain]; ok {
+		chain, err := helper.AssumeRoleChainFromBytes(data)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(dataPath.Key(aws.AssumeRoleChain), string(data), fmt.Sprintf("cannot be parsed as list of roles: %v", err)))
+		} else {
+			allErrs = append(allErrs, ValidateAssumeRoleChain(chain, false, dataPath.Key(aws.AssumeRoleChain))...)
+		}
+	}
+
+	allErrs = append(allErrs, validateAPISettings(secret.Data, dataPath)...)
+
+	return allErrs
+}
+
+// validateAPISettings validates the optional settings of the AWS API in the data of a cloud provider secret.
+func validateAPISettings(data map[string][]byte, dataPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if partition, ok := data[aws.Partition]; ok {
+		allErrs = append(allErrs, validatePartition(string(partition), dataPath.Key(aws.Partition))...)
+	}
+	endpoint, hasEndpoint := data[aws.Endpoint]
+	if hasEndpoint {
+		allErrs = append(allErrs, validateEndpoint(string(endpoint), dataPath.Key(aws.Endpoint))...)
+	}
+	if value, ok := data[aws.UseFIPSEndpoints]; ok {
+		useFIPSEndpoints, err := strconv.ParseBool(string(value))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(dataPath.Key(aws.UseFIPSEndpoints), string(value), "must be a boolean"))
+		} else if useFIPSEndpoints && hasEndpoint {
+			allErrs = append(allErrs, field.Forbidden(dataPath.Key(aws.UseFIPSEndpoints), fmt.Sprintf("cannot be enabled together with %q", aws.Endpoint)))
+		}
+	}
+
 	return allErrs
 }
 
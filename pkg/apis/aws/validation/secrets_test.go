@@ -709,5 +709,109 @@ var _ = Describe("Secret validation", func() {
 				}))))
 			})
 		})
+
+		Context("Role chain", func() {
+			BeforeEach(func() {
+				secret.Data = map[string][]byte{
+					aws.AccessKeyID:     []byte(validAccessKeyID),
+					aws.SecretAccessKey: []byte(validSecretAccessKey),
+				}
+			})
+
+			It("should pass with a valid role chain", func() {
+				secret.Data[aws.AssumeRoleChain] = []byte(`
+- roleARN: arn:aws:iam::111111111111:role/central
+  duration: 12h
+- roleARN: arn:aws:iam::222222222222:role/shoot
+  externalID: external-id
+  sessionName: gardener
+  duration: 1h
+  sessionPolicy: '{"Version":"2012-10-17","Statement":[]}'
+`)
+
+				errs := ValidateCloudProviderSecret(secret, fldPath)
+				Expect(errs).To(BeEmpty())
+			})
+
+			It("should fail when the role chain cannot be parsed", func() {
+				secret.Data[aws.AssumeRoleChain] = []byte(`[{"role":"arn:aws:iam::111111111111:role/central"}]`)
+
+				errs := ValidateCloudProviderSecret(secret, fldPath)
+				Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("secret.data[assumeRoleChain]"),
+				}))))
+			})
+
+			It("should fail when the roles of the chain are invalid", func() {
+				secret.Data[aws.AssumeRoleChain] = []byte(`
+- roleARN: central
+  externalID: "x"
+  duration: 13h
+- roleARN: arn:aws:iam::222222222222:role/shoot
+  sessionName: "invalid session"
+  duration: 2h
+  sessionPolicy: "{"
+`)
+
+				errs := ValidateCloudProviderSecret(secret, fldPath)
+				Expect(errs).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[assumeRoleChain][0].roleARN")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[assumeRoleChain][0].externalID")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[assumeRoleChain][0].duration"), "Detail": Equal("must be between 15m0s and 12h0m0s")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[assumeRoleChain][1].sessionName")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[assumeRoleChain][1].duration"), "Detail": Equal("must be between 15m0s and 1h0m0s")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[assumeRoleChain][1].sessionPolicy")})),
+				))
+			})
+		})
+
+		Context("API settings", func() {
+			BeforeEach(func() {
+				secret.Data = map[string][]byte{
+					aws.AccessKeyID:     []byte(validAccessKeyID),
+					aws.SecretAccessKey: []byte(validSecretAccessKey),
+				}
+			})
+
+			It("should pass with a partition and an endpoint", func() {
+				secret.Data[aws.Partition] = []byte("aws-us-gov")
+				secret.Data[aws.Endpoint] = []byte("https://aws.example.com:4566/")
+
+				errs := ValidateCloudProviderSecret(secret, fldPath)
+				Expect(errs).To(BeEmpty())
+			})
+
+			It("should pass with FIPS endpoints", func() {
+				secret.Data[aws.UseFIPSEndpoints] = []byte("true")
+
+				errs := ValidateCloudProviderSecret(secret, fldPath)
+				Expect(errs).To(BeEmpty())
+			})
+
+			It("should fail with invalid settings", func() {
+				secret.Data[aws.Partition] = []byte("azure")
+				secret.Data[aws.Endpoint] = []byte("localhost:4566")
+				secret.Data[aws.UseFIPSEndpoints] = []byte("yes please")
+
+				errs := ValidateCloudProviderSecret(secret, fldPath)
+				Expect(errs).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[partition]")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[endpoint]")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("secret.data[useFIPSEndpoints]")})),
+				))
+			})
+
+			It("should forbid FIPS endpoints together with an endpoint", func() {
+				secret.Data[aws.Endpoint] = []byte("https://aws.example.com")
+				secret.Data[aws.UseFIPSEndpoints] = []byte("true")
+
+				errs := ValidateCloudProviderSecret(secret, fldPath)
+				Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("secret.data[useFIPSEndpoints]"),
+				}))))
+			})
+		})
 	})
 })
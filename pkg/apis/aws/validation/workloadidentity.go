@@ -5,6 +5,10 @@
 package validation
 
 import (
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
@@ -17,6 +21,60 @@ func ValidateWorkloadIdentityConfig(config *apisaws.WorkloadIdentityConfig, fldP
 	if len(config.RoleARN) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("roleARN"), "roleARN is required"))
 	}
+	// the first role of the chain is assumed with the credentials of the role given by roleARN
+	allErrs = append(allErrs, ValidateAssumeRoleChain(config.AssumeRoleChain, true, fldPath.Child("assumeRoleChain"))...)
+
+	return allErrs
+}
+
+const (
+	// maxAssumeRoleChainLength is the maximum number of roles in a role chain.
+	maxAssumeRoleChainLength = 5
+	// maxSessionPolicyLength is the maximum length of an inline session policy.
+	maxSessionPolicyLength = 2048
+
+	minRoleSessionDuration = 15 * time.Minute
+	maxRoleSessionDuration = 12 * time.Hour
+	// maxChainedRoleSessionDuration is the maximum duration of sessions of roles assumed with the credentials of
+	// another role, see https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_terms-and-concepts.html#iam-term-role-chaining
+	maxChainedRoleSessionDuration = time.Hour
+)
+
+// ValidateAssumeRoleChain validates a chain of roles. If chained is true, the first role is assumed with the credentials
+// of another role.
+func ValidateAssumeRoleChain(chain []apisaws.AssumeRole, chained bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(chain) > maxAssumeRoleChainLength {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(chain), maxAssumeRoleChainLength))
+	}
+
+	for i, role := range chain {
+		rolePath := fldPath.Index(i)
+		allErrs = append(allErrs, validateIamRoleArn(role.RoleARN, rolePath.Child("roleARN"))...)
+		if role.ExternalID != nil {
+			allErrs = append(allErrs, validateExternalID(*role.ExternalID, rolePath.Child("externalID"))...)
+		}
+		if role.SessionName != nil {
+			allErrs = append(allErrs, validateRoleSessionName(*role.SessionName, rolePath.Child("sessionName"))...)
+		}
+		if role.Duration != nil {
+			maxDuration := maxRoleSessionDuration
+			if chained || i > 0 {
+				maxDuration = maxChainedRoleSessionDuration
+			}
+			if d := role.Duration.Duration; d < minRoleSessionDuration || d > maxDuration {
+				allErrs = append(allErrs, field.Invalid(rolePath.Child("duration"), d.String(), fmt.Sprintf("must be between %s and %s", minRoleSessionDuration, maxDuration)))
+			}
+		}
+		if role.SessionPolicy != nil {
+			if len(*role.SessionPolicy) > maxSessionPolicyLength {
+				allErrs = append(allErrs, field.TooLong(rolePath.Child("sessionPolicy"), "", maxSessionPolicyLength))
+			} else if !json.Valid([]byte(*role.SessionPolicy)) {
+				allErrs = append(allErrs, field.Invalid(rolePath.Child("sessionPolicy"), *role.SessionPolicy, "must be a valid JSON policy document"))
+			}
+		}
+	}
 
 	return allErrs
 }
@@ -5,11 +5,15 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
@@ -42,6 +46,34 @@ var _ = Describe("#ValidateWorkloadIdentityConfig", func() {
 		))
 	})
 
+	It("should validate the role chain", func() {
+		workloadIdentityConfig.AssumeRoleChain = []apisaws.AssumeRole{
+			{RoleARN: "arn:aws:iam::111111111111:role/central", Duration: &metav1.Duration{Duration: time.Hour}},
+			{RoleARN: "arn:aws:iam::222222222222:role/shoot", ExternalID: ptr.To("external-id"), Duration: &metav1.Duration{Duration: 2 * time.Hour}},
+		}
+		errorList := validation.ValidateWorkloadIdentityConfig(workloadIdentityConfig, field.NewPath("providerConfig"))
+		Expect(errorList).To(ConsistOfFields(
+			Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.assumeRoleChain[1].duration"),
+				"Detail": Equal("must be between 15m0s and 1h0m0s"),
+			},
+		))
+	})
+
+	It("should forbid too long role chains", func() {
+		for range 6 {
+			workloadIdentityConfig.AssumeRoleChain = append(workloadIdentityConfig.AssumeRoleChain, apisaws.AssumeRole{RoleARN: "arn:aws:iam::111111111111:role/central"})
+		}
+		errorList := validation.ValidateWorkloadIdentityConfig(workloadIdentityConfig, field.NewPath("providerConfig"))
+		Expect(errorList).To(ConsistOfFields(
+			Fields{
+				"Type":  Equal(field.ErrorTypeTooMany),
+				"Field": Equal("providerConfig.assumeRoleChain"),
+			},
+		))
+	})
+
 	It("should validate the config successfully during update", func() {
 		newConfig := workloadIdentityConfig.DeepCopy()
 		Expect(validation.ValidateWorkloadIdentityConfigUpdate(workloadIdentityConfig, newConfig, field.NewPath(""))).To(BeEmpty())
@@ -24,6 +24,12 @@ type ControllerConfiguration struct {
 	ETCD ETCD
 	// HealthCheckConfig is the config for the health check controller
 	HealthCheckConfig *apisconfigv1alpha1.HealthCheckConfig
+	// AccessKeyRotation is the configuration for reporting the age of static access keys of shoots.
+	AccessKeyRotation *AccessKeyRotation
+	// APIRateLimiting is the configuration of the client-side rate limiting of EC2, ELB and IAM API calls.
+	APIRateLimiting *APIRateLimiting
+	// Tracing is the configuration for exporting spans of AWS API calls.
+	Tracing *Tracing
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool
@@ -43,6 +49,36 @@ type ETCDStorage struct {
 	ClassName *string
 	// Capacity is the storage capacity used in etcd-main volume claims.
 	Capacity *resource.Quantity
+	// StorageClass are the parameters of the storage class ClassName which is deployed by the extension.
+	StorageClass *ETCDStorageClass
+	// Overrides are storage configurations for the etcd-main of shoots with specific purposes or labels. The first
+	// matching override is used, unset fields are taken from the global configuration.
+	Overrides []ETCDStorageOverride
+}
+
+// ETCDStorageOverride is an etcd storage configuration for a subset of shoots.
+type ETCDStorageOverride struct {
+	// Purposes are the shoot purposes the override applies to. If empty, shoots of all purposes are matched.
+	Purposes []string
+	// ShootSelector selects the shoots the override applies to by their labels. If nil, all shoots are matched.
+	ShootSelector *metav1.LabelSelector
+	// ClassName is the name of the storage class used in etcd-main volume claims.
+	ClassName *string
+	// Capacity is the storage capacity used in etcd-main volume claims.
+	Capacity *resource.Quantity
+	// StorageClass are the parameters of the storage class ClassName. If set, the storage class is deployed by the
+	// extension, otherwise it has to exist in the seed.
+	StorageClass *ETCDStorageClass
+}
+
+// ETCDStorageClass are the parameters of an EBS storage class for etcd volumes.
+type ETCDStorageClass struct {
+	// Type is the EBS volume type, defaults to gp3.
+	Type *string
+	// IOPS are the provisioned I/O operations per second of the volumes, only supported for gp3, io1 and io2 volumes.
+	IOPS *int32
+	// Throughput is the provisioned throughput of the volumes in MiB/s, only supported for gp3 volumes.
+	Throughput *int32
 }
 
 // ETCDBackup is an etcd backup configuration.
@@ -50,3 +86,32 @@ type ETCDBackup struct {
 	// Schedule is the etcd backup schedule.
 	Schedule *string
 }
+
+// AccessKeyRotation is the configuration for reporting the age of static access keys.
+type AccessKeyRotation struct {
+	// MaxAge is the age after which static access keys must be rotated.
+	MaxAge metav1.Duration
+	// WarningPeriod is the period before MaxAge in which the upcoming expiry of an access key is reported.
+	WarningPeriod *metav1.Duration
+}
+
+// Tracing is the configuration for exporting spans of AWS API calls to an OpenTelemetry collector.
+type Tracing struct {
+	// Endpoint is the address of the OTLP gRPC endpoint of the collector, e.g. "otel-collector.garden:4317".
+	Endpoint string
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool
+	// SamplingPercentage is the percentage of traces which are sampled.
+	SamplingPercentage *int32
+}
+
+// APIRateLimiting is the configuration of the client-side rate limiting of EC2, ELB and IAM API calls. The rate limit
+// is shared by all shoots using the same AWS account in the same region.
+type APIRateLimiting struct {
+	// QPS is the number of API calls per second which may be made for each AWS account and region.
+	QPS int32
+	// Burst is the number of API calls which may be made at once.
+	Burst *int32
+	// WaitTimeout is the maximum time an API call waits for the rate limiter before it fails.
+	WaitTimeout *metav1.Duration
+}
@@ -5,9 +5,37 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_AccessKeyRotation sets default values for AccessKeyRotation objects.
+func SetDefaults_AccessKeyRotation(obj *AccessKeyRotation) {
+	if obj.WarningPeriod == nil {
+		obj.WarningPeriod = &metav1.Duration{Duration: 14 * 24 * time.Hour}
+	}
+}
+
+// SetDefaults_APIRateLimiting sets default values for APIRateLimiting objects.
+func SetDefaults_APIRateLimiting(obj *APIRateLimiting) {
+	if obj.Burst == nil {
+		obj.Burst = ptr.To(obj.QPS)
+	}
+	if obj.WaitTimeout == nil {
+		obj.WaitTimeout = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
+// SetDefaults_Tracing sets default values for Tracing objects.
+func SetDefaults_Tracing(obj *Tracing) {
+	if obj.SamplingPercentage == nil {
+		obj.SamplingPercentage = ptr.To[int32](100)
+	}
+}
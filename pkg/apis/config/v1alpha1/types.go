@@ -27,6 +27,15 @@ type ControllerConfiguration struct {
 	// HealthCheckConfig is the config for the health check controller
 	// +optional
 	HealthCheckConfig *healthcheckconfigv1alpha1.HealthCheckConfig `json:"healthCheckConfig,omitempty"`
+	// AccessKeyRotation is the configuration for reporting the age of static access keys of shoots.
+	// +optional
+	AccessKeyRotation *AccessKeyRotation `json:"accessKeyRotation,omitempty"`
+	// APIRateLimiting is the configuration of the client-side rate limiting of EC2, ELB and IAM API calls.
+	// +optional
+	APIRateLimiting *APIRateLimiting `json:"apiRateLimiting,omitempty"`
+	// Tracing is the configuration for exporting spans of AWS API calls.
+	// +optional
+	Tracing *Tracing `json:"tracing,omitempty"`
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
@@ -48,6 +57,46 @@ type ETCDStorage struct {
 	// Capacity is the storage capacity used in etcd-main volume claims.
 	// +optional
 	Capacity *resource.Quantity `json:"capacity,omitempty"`
+	// StorageClass are the parameters of the storage class ClassName which is deployed by the extension.
+	// +optional
+	StorageClass *ETCDStorageClass `json:"storageClass,omitempty"`
+	// Overrides are storage configurations for the etcd-main of shoots with specific purposes or labels. The first
+	// matching override is used, unset fields are taken from the global configuration.
+	// +optional
+	Overrides []ETCDStorageOverride `json:"overrides,omitempty"`
+}
+
+// ETCDStorageOverride is an etcd storage configuration for a subset of shoots.
+type ETCDStorageOverride struct {
+	// Purposes are the shoot purposes the override applies to. If empty, shoots of all purposes are matched.
+	// +optional
+	Purposes []string `json:"purposes,omitempty"`
+	// ShootSelector selects the shoots the override applies to by their labels. If nil, all shoots are matched.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+	// ClassName is the name of the storage class used in etcd-main volume claims.
+	// +optional
+	ClassName *string `json:"className,omitempty"`
+	// Capacity is the storage capacity used in etcd-main volume claims.
+	// +optional
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+	// StorageClass are the parameters of the storage class ClassName. If set, the storage class is deployed by the
+	// extension, otherwise it has to exist in the seed.
+	// +optional
+	StorageClass *ETCDStorageClass `json:"storageClass,omitempty"`
+}
+
+// ETCDStorageClass are the parameters of an EBS storage class for etcd volumes.
+type ETCDStorageClass struct {
+	// Type is the EBS volume type, defaults to gp3.
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// IOPS are the provisioned I/O operations per second of the volumes, only supported for gp3, io1 and io2 volumes.
+	// +optional
+	IOPS *int32 `json:"iops,omitempty"`
+	// Throughput is the provisioned throughput of the volumes in MiB/s, only supported for gp3 volumes.
+	// +optional
+	Throughput *int32 `json:"throughput,omitempty"`
 }
 
 // ETCDBackup is an etcd backup configuration.
@@ -56,3 +105,41 @@ type ETCDBackup struct {
 	// +optional
 	Schedule *string `json:"schedule,omitempty"`
 }
+
+// AccessKeyRotation is the configuration for reporting the age of static access keys.
+type AccessKeyRotation struct {
+	// MaxAge is the age after which static access keys must be rotated.
+	MaxAge metav1.Duration `json:"maxAge"`
+	// WarningPeriod is the period before MaxAge in which the upcoming expiry of an access key is reported.
+	// Defaults to 14 days.
+	// +optional
+	WarningPeriod *metav1.Duration `json:"warningPeriod,omitempty"`
+}
+
+// Tracing is the configuration for exporting spans of AWS API calls to an OpenTelemetry collector.
+type Tracing struct {
+	// Endpoint is the address of the OTLP gRPC endpoint of the collector, e.g. "otel-collector.garden:4317".
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the collector.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// SamplingPercentage is the percentage of traces which are sampled.
+	// Defaults to 100.
+	// +optional
+	SamplingPercentage *int32 `json:"samplingPercentage,omitempty"`
+}
+
+// APIRateLimiting is the configuration of the client-side rate limiting of EC2, ELB and IAM API calls. The rate limit
+// is shared by all shoots using the same AWS account in the same region.
+type APIRateLimiting struct {
+	// QPS is the number of API calls per second which may be made for each AWS account and region.
+	QPS int32 `json:"qps"`
+	// Burst is the number of API calls which may be made at once.
+	// Defaults to QPS.
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+	// WaitTimeout is the maximum time an API call waits for the rate limiter before it fails.
+	// Defaults to 1m.
+	// +optional
+	WaitTimeout *metav1.Duration `json:"waitTimeout,omitempty"`
+}
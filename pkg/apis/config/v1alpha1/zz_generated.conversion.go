@@ -15,6 +15,7 @@ import (
 	config "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -27,6 +28,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIRateLimiting)(nil), (*config.APIRateLimiting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIRateLimiting_To_config_APIRateLimiting(a.(*APIRateLimiting), b.(*config.APIRateLimiting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.APIRateLimiting)(nil), (*APIRateLimiting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_APIRateLimiting_To_v1alpha1_APIRateLimiting(a.(*config.APIRateLimiting), b.(*APIRateLimiting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessKeyRotation)(nil), (*config.AccessKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AccessKeyRotation_To_config_AccessKeyRotation(a.(*AccessKeyRotation), b.(*config.AccessKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AccessKeyRotation)(nil), (*AccessKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AccessKeyRotation_To_v1alpha1_AccessKeyRotation(a.(*config.AccessKeyRotation), b.(*AccessKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -67,15 +88,94 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDStorageClass)(nil), (*config.ETCDStorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDStorageClass_To_config_ETCDStorageClass(a.(*ETCDStorageClass), b.(*config.ETCDStorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ETCDStorageClass)(nil), (*ETCDStorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ETCDStorageClass_To_v1alpha1_ETCDStorageClass(a.(*config.ETCDStorageClass), b.(*ETCDStorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDStorageOverride)(nil), (*config.ETCDStorageOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDStorageOverride_To_config_ETCDStorageOverride(a.(*ETCDStorageOverride), b.(*config.ETCDStorageOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ETCDStorageOverride)(nil), (*ETCDStorageOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ETCDStorageOverride_To_v1alpha1_ETCDStorageOverride(a.(*config.ETCDStorageOverride), b.(*ETCDStorageOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Tracing)(nil), (*config.Tracing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Tracing_To_config_Tracing(a.(*Tracing), b.(*config.Tracing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Tracing)(nil), (*Tracing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Tracing_To_v1alpha1_Tracing(a.(*config.Tracing), b.(*Tracing), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_APIRateLimiting_To_config_APIRateLimiting(in *APIRateLimiting, out *config.APIRateLimiting, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = (*int32)(unsafe.Pointer(in.Burst))
+	out.WaitTimeout = (*v1.Duration)(unsafe.Pointer(in.WaitTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_APIRateLimiting_To_config_APIRateLimiting is an autogenerated conversion function.
+func Convert_v1alpha1_APIRateLimiting_To_config_APIRateLimiting(in *APIRateLimiting, out *config.APIRateLimiting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIRateLimiting_To_config_APIRateLimiting(in, out, s)
+}
+
+func autoConvert_config_APIRateLimiting_To_v1alpha1_APIRateLimiting(in *config.APIRateLimiting, out *APIRateLimiting, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = (*int32)(unsafe.Pointer(in.Burst))
+	out.WaitTimeout = (*v1.Duration)(unsafe.Pointer(in.WaitTimeout))
+	return nil
+}
+
+// Convert_config_APIRateLimiting_To_v1alpha1_APIRateLimiting is an autogenerated conversion function.
+func Convert_config_APIRateLimiting_To_v1alpha1_APIRateLimiting(in *config.APIRateLimiting, out *APIRateLimiting, s conversion.Scope) error {
+	return autoConvert_config_APIRateLimiting_To_v1alpha1_APIRateLimiting(in, out, s)
+}
+
+func autoConvert_v1alpha1_AccessKeyRotation_To_config_AccessKeyRotation(in *AccessKeyRotation, out *config.AccessKeyRotation, s conversion.Scope) error {
+	out.MaxAge = in.MaxAge
+	out.WarningPeriod = (*v1.Duration)(unsafe.Pointer(in.WarningPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_AccessKeyRotation_To_config_AccessKeyRotation is an autogenerated conversion function.
+func Convert_v1alpha1_AccessKeyRotation_To_config_AccessKeyRotation(in *AccessKeyRotation, out *config.AccessKeyRotation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AccessKeyRotation_To_config_AccessKeyRotation(in, out, s)
+}
+
+func autoConvert_config_AccessKeyRotation_To_v1alpha1_AccessKeyRotation(in *config.AccessKeyRotation, out *AccessKeyRotation, s conversion.Scope) error {
+	out.MaxAge = in.MaxAge
+	out.WarningPeriod = (*v1.Duration)(unsafe.Pointer(in.WarningPeriod))
 	return nil
 }
 
+// Convert_config_AccessKeyRotation_To_v1alpha1_AccessKeyRotation is an autogenerated conversion function.
+func Convert_config_AccessKeyRotation_To_v1alpha1_AccessKeyRotation(in *config.AccessKeyRotation, out *AccessKeyRotation, s conversion.Scope) error {
+	return autoConvert_config_AccessKeyRotation_To_v1alpha1_AccessKeyRotation(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
+	out.AccessKeyRotation = (*config.AccessKeyRotation)(unsafe.Pointer(in.AccessKeyRotation))
+	out.APIRateLimiting = (*config.APIRateLimiting)(unsafe.Pointer(in.APIRateLimiting))
+	out.Tracing = (*config.Tracing)(unsafe.Pointer(in.Tracing))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -91,6 +191,9 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 		return err
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
+	out.AccessKeyRotation = (*AccessKeyRotation)(unsafe.Pointer(in.AccessKeyRotation))
+	out.APIRateLimiting = (*APIRateLimiting)(unsafe.Pointer(in.APIRateLimiting))
+	out.Tracing = (*Tracing)(unsafe.Pointer(in.Tracing))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -153,6 +256,8 @@ func Convert_config_ETCDBackup_To_v1alpha1_ETCDBackup(in *config.ETCDBackup, out
 func autoConvert_v1alpha1_ETCDStorage_To_config_ETCDStorage(in *ETCDStorage, out *config.ETCDStorage, s conversion.Scope) error {
 	out.ClassName = (*string)(unsafe.Pointer(in.ClassName))
 	out.Capacity = (*resource.Quantity)(unsafe.Pointer(in.Capacity))
+	out.StorageClass = (*config.ETCDStorageClass)(unsafe.Pointer(in.StorageClass))
+	out.Overrides = *(*[]config.ETCDStorageOverride)(unsafe.Pointer(&in.Overrides))
 	return nil
 }
 
@@ -164,6 +269,8 @@ func Convert_v1alpha1_ETCDStorage_To_config_ETCDStorage(in *ETCDStorage, out *co
 func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	out.ClassName = (*string)(unsafe.Pointer(in.ClassName))
 	out.Capacity = (*resource.Quantity)(unsafe.Pointer(in.Capacity))
+	out.StorageClass = (*ETCDStorageClass)(unsafe.Pointer(in.StorageClass))
+	out.Overrides = *(*[]ETCDStorageOverride)(unsafe.Pointer(&in.Overrides))
 	return nil
 }
 
@@ -171,3 +278,79 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_ETCDStorageClass_To_config_ETCDStorageClass(in *ETCDStorageClass, out *config.ETCDStorageClass, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IOPS = (*int32)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int32)(unsafe.Pointer(in.Throughput))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDStorageClass_To_config_ETCDStorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDStorageClass_To_config_ETCDStorageClass(in *ETCDStorageClass, out *config.ETCDStorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDStorageClass_To_config_ETCDStorageClass(in, out, s)
+}
+
+func autoConvert_config_ETCDStorageClass_To_v1alpha1_ETCDStorageClass(in *config.ETCDStorageClass, out *ETCDStorageClass, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IOPS = (*int32)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int32)(unsafe.Pointer(in.Throughput))
+	return nil
+}
+
+// Convert_config_ETCDStorageClass_To_v1alpha1_ETCDStorageClass is an autogenerated conversion function.
+func Convert_config_ETCDStorageClass_To_v1alpha1_ETCDStorageClass(in *config.ETCDStorageClass, out *ETCDStorageClass, s conversion.Scope) error {
+	return autoConvert_config_ETCDStorageClass_To_v1alpha1_ETCDStorageClass(in, out, s)
+}
+
+func autoConvert_v1alpha1_ETCDStorageOverride_To_config_ETCDStorageOverride(in *ETCDStorageOverride, out *config.ETCDStorageOverride, s conversion.Scope) error {
+	out.Purposes = *(*[]string)(unsafe.Pointer(&in.Purposes))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ClassName = (*string)(unsafe.Pointer(in.ClassName))
+	out.Capacity = (*resource.Quantity)(unsafe.Pointer(in.Capacity))
+	out.StorageClass = (*config.ETCDStorageClass)(unsafe.Pointer(in.StorageClass))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDStorageOverride_To_config_ETCDStorageOverride is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDStorageOverride_To_config_ETCDStorageOverride(in *ETCDStorageOverride, out *config.ETCDStorageOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDStorageOverride_To_config_ETCDStorageOverride(in, out, s)
+}
+
+func autoConvert_config_ETCDStorageOverride_To_v1alpha1_ETCDStorageOverride(in *config.ETCDStorageOverride, out *ETCDStorageOverride, s conversion.Scope) error {
+	out.Purposes = *(*[]string)(unsafe.Pointer(&in.Purposes))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ClassName = (*string)(unsafe.Pointer(in.ClassName))
+	out.Capacity = (*resource.Quantity)(unsafe.Pointer(in.Capacity))
+	out.StorageClass = (*ETCDStorageClass)(unsafe.Pointer(in.StorageClass))
+	return nil
+}
+
+// Convert_config_ETCDStorageOverride_To_v1alpha1_ETCDStorageOverride is an autogenerated conversion function.
+func Convert_config_ETCDStorageOverride_To_v1alpha1_ETCDStorageOverride(in *config.ETCDStorageOverride, out *ETCDStorageOverride, s conversion.Scope) error {
+	return autoConvert_config_ETCDStorageOverride_To_v1alpha1_ETCDStorageOverride(in, out, s)
+}
+
+func autoConvert_v1alpha1_Tracing_To_config_Tracing(in *Tracing, out *config.Tracing, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Insecure = in.Insecure
+	out.SamplingPercentage = (*int32)(unsafe.Pointer(in.SamplingPercentage))
+	return nil
+}
+
+// Convert_v1alpha1_Tracing_To_config_Tracing is an autogenerated conversion function.
+func Convert_v1alpha1_Tracing_To_config_Tracing(in *Tracing, out *config.Tracing, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Tracing_To_config_Tracing(in, out, s)
+}
+
+func autoConvert_config_Tracing_To_v1alpha1_Tracing(in *config.Tracing, out *Tracing, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Insecure = in.Insecure
+	out.SamplingPercentage = (*int32)(unsafe.Pointer(in.SamplingPercentage))
+	return nil
+}
+
+// Convert_config_Tracing_To_v1alpha1_Tracing is an autogenerated conversion function.
+func Convert_config_Tracing_To_v1alpha1_Tracing(in *config.Tracing, out *Tracing, s conversion.Scope) error {
+	return autoConvert_config_Tracing_To_v1alpha1_Tracing(in, out, s)
+}
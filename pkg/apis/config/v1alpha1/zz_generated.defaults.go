@@ -17,5 +17,18 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&ControllerConfiguration{}, func(obj interface{}) { SetObjectDefaults_ControllerConfiguration(obj.(*ControllerConfiguration)) })
 	return nil
 }
+
+func SetObjectDefaults_ControllerConfiguration(in *ControllerConfiguration) {
+	if in.AccessKeyRotation != nil {
+		SetDefaults_AccessKeyRotation(in.AccessKeyRotation)
+	}
+	if in.APIRateLimiting != nil {
+		SetDefaults_APIRateLimiting(in.APIRateLimiting)
+	}
+	if in.Tracing != nil {
+		SetDefaults_Tracing(in.Tracing)
+	}
+}
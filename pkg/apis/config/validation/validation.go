@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+)
+
+var (
+	validVolumeTypes = sets.New("gp2", "gp3", "io1", "io2", "sc1", "st1", "standard")
+	validPurposes    = sets.New(
+		string(v1beta1.ShootPurposeEvaluation),
+		string(v1beta1.ShootPurposeTesting),
+		string(v1beta1.ShootPurposeDevelopment),
+		string(v1beta1.ShootPurposeInfrastructure),
+		string(v1beta1.ShootPurposeProduction),
+	)
+)
+
+// ValidateControllerConfiguration validates the controller configuration.
+func ValidateControllerConfiguration(config *config.ControllerConfiguration) field.ErrorList {
+	return ValidateETCDStorage(&config.ETCD.Storage, field.NewPath("etcd", "storage"))
+}
+
+// ValidateETCDStorage validates the etcd storage configuration.
+func ValidateETCDStorage(storage *config.ETCDStorage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if storage.Capacity != nil && storage.Capacity.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("capacity"), storage.Capacity.String(), "must be positive"))
+	}
+	if storage.StorageClass != nil {
+		allErrs = append(allErrs, validateETCDStorageClass(storage.StorageClass, fldPath.Child("storageClass"))...)
+	}
+
+	for i, override := range storage.Overrides {
+		idxPath := fldPath.Child("overrides").Index(i)
+
+		for j, purpose := range override.Purposes {
+			if !validPurposes.Has(purpose) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("purposes").Index(j), purpose, sets.List(validPurposes)))
+			}
+		}
+		if override.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(override.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("shootSelector"))...)
+		}
+		if override.Capacity != nil && override.Capacity.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("capacity"), override.Capacity.String(), "must be positive"))
+		}
+		if override.StorageClass != nil {
+			if override.ClassName == nil || *override.ClassName == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("className"), "must be set if the storage class is deployed by the extension"))
+			}
+			allErrs = append(allErrs, validateETCDStorageClass(override.StorageClass, idxPath.Child("storageClass"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateETCDStorageClass(class *config.ETCDStorageClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	volumeType := "gp3"
+	if class.Type != nil {
+		volumeType = *class.Type
+		if !validVolumeTypes.Has(volumeType) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), volumeType, sets.List(validVolumeTypes)))
+		}
+	}
+
+	if class.IOPS != nil {
+		if *class.IOPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("iops"), *class.IOPS, "must be positive"))
+		}
+		if volumeType != "gp3" && volumeType != "io1" && volumeType != "io2" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("iops"), "is only supported for gp3, io1 and io2 volumes"))
+		}
+	} else if volumeType == "io1" || volumeType == "io2" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("iops"), "must be set for io1 and io2 volumes"))
+	}
+
+	if class.Throughput != nil {
+		if *class.Throughput <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("throughput"), *class.Throughput, "must be positive"))
+		}
+		if volumeType != "gp3" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("throughput"), "is only supported for gp3 volumes"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateETCDStorage", func() {
+		var (
+			fldPath = field.NewPath("storage")
+			storage *config.ETCDStorage
+		)
+
+		BeforeEach(func() {
+			storage = &config.ETCDStorage{
+				ClassName:    ptr.To("gardener.cloud-fast"),
+				Capacity:     ptr.To(resource.MustParse("25Gi")),
+				StorageClass: &config.ETCDStorageClass{Type: ptr.To("gp3"), IOPS: ptr.To[int32](3000), Throughput: ptr.To[int32](125)},
+				Overrides: []config.ETCDStorageOverride{{
+					Purposes:      []string{"production"},
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"etcd": "io2"}},
+					ClassName:     ptr.To("gardener.cloud-io2"),
+					Capacity:      ptr.To(resource.MustParse("50Gi")),
+					StorageClass:  &config.ETCDStorageClass{Type: ptr.To("io2"), IOPS: ptr.To[int32](6000)},
+				}},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateETCDStorage(storage, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid overrides", func() {
+			storage.Overrides[0].Purposes = []string{"unknown"}
+			storage.Overrides[0].ShootSelector.MatchLabels["etcd"] = "not valid"
+			storage.Overrides[0].Capacity = ptr.To(resource.MustParse("0"))
+			storage.Overrides[0].ClassName = nil
+
+			Expect(ValidateETCDStorage(storage, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("storage.overrides[0].purposes[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("storage.overrides[0].shootSelector.matchLabels")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("storage.overrides[0].capacity")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("storage.overrides[0].className")})),
+			))
+		})
+
+		It("should forbid an unknown volume type", func() {
+			storage.StorageClass = &config.ETCDStorageClass{Type: ptr.To("gp4")}
+
+			Expect(ValidateETCDStorage(storage, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("storage.storageClass.type")})),
+			))
+		})
+
+		It("should require IOPS for io2 volumes", func() {
+			storage.Overrides[0].StorageClass.IOPS = nil
+
+			Expect(ValidateETCDStorage(storage, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("storage.overrides[0].storageClass.iops")})),
+			))
+		})
+
+		It("should forbid IOPS and throughput for volume types not supporting them", func() {
+			storage.StorageClass = &config.ETCDStorageClass{Type: ptr.To("st1"), IOPS: ptr.To[int32](3000), Throughput: ptr.To[int32](125)}
+			storage.Overrides[0].StorageClass.Throughput = ptr.To[int32](0)
+
+			Expect(ValidateETCDStorage(storage, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("storage.storageClass.iops")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("storage.storageClass.throughput")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("storage.overrides[0].storageClass.throughput")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("storage.overrides[0].storageClass.throughput")})),
+			))
+		})
+	})
+})
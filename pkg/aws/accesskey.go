@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// globalServiceRegion is the region used to call global services like IAM with credentials from secrets without
+// region. Any region of the partition works for them.
+const globalServiceRegion = "us-east-1"
+
+// AccessKeyAge is the result of comparing the age of a static access key with the configured maximum age.
+type AccessKeyAge struct {
+	// Metadata is the metadata of the access key.
+	Metadata *awsclient.AccessKeyMetadata
+	// Age is the age of the access key.
+	Age time.Duration
+	// Remaining is the time until the access key reaches the maximum age. It is negative for expired access keys.
+	Remaining time.Duration
+}
+
+// Expired returns true if the access key is older than the maximum age.
+func (a *AccessKeyAge) Expired() bool {
+	return a.Remaining <= 0
+}
+
+// ExpiresWithin returns true if the access key reaches the maximum age within the given period.
+func (a *AccessKeyAge) ExpiresWithin(period time.Duration) bool {
+	return a.Remaining <= period
+}
+
+// String returns a description of the age and last use of the access key.
+func (a *AccessKeyAge) String() string {
+	lastUsed := "never used"
+	if a.Metadata.LastUsedDate != nil {
+		lastUsed = "last used " + a.Metadata.LastUsedDate.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("access key %s of user %q was created %d days ago (%s)", a.Metadata.AccessKeyID, a.Metadata.UserName, int(a.Age.Hours()/24), lastUsed)
+}
+
+// GetAccessKeyAge reads the metadata of the static access key of the given auth config and compares its age with the
+// given maximum age. It returns nil if the auth config does not use a static access key, if the access key does not
+// exist or if the credentials are not permitted to read the metadata of their own access key.
+func GetAccessKeyAge(ctx context.Context, factory awsclient.Factory, authConfig awsclient.AuthConfig, maxAge time.Duration, now time.Time) (*AccessKeyAge, error) {
+	if authConfig.AccessKey == nil {
+		return nil, nil
+	}
+
+	authConfig.Region = cmp.Or(authConfig.Region, globalServiceRegion)
+	awsClient, err := factory.NewClient(authConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS client: %w", err)
+	}
+
+	metadata, err := awsClient.GetAccessKeyMetadata(ctx, authConfig.AccessKey.ID)
+	if err != nil {
+		if awsclient.GetAWSAPIErrorCode(err) == "AccessDenied" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read metadata of access key %s: %w", authConfig.AccessKey.ID, err)
+	}
+	if metadata == nil {
+		return nil, nil
+	}
+
+	age := metadata.Age(now)
+	return &AccessKeyAge{
+		Metadata:  metadata,
+		Age:       age,
+		Remaining: maxAge - age,
+	}, nil
+}
@@ -5,16 +5,21 @@
 package client
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
 	"net/url"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	v2config "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
@@ -25,11 +30,16 @@ import (
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/middleware"
@@ -56,6 +66,18 @@ type AuthConfig struct {
 	// WorkloadIdentity contains workload identity configuration.
 	// This field is mutually exclusive with AccessKey.
 	WorkloadIdentity *WorkloadIdentity
+
+	// AssumeRoleChain is a list of roles which are assumed one after another, starting with the credentials given by
+	// AccessKey or WorkloadIdentity.
+	AssumeRoleChain []AssumeRole
+
+	// Partition is the ARN partition of the region, e.g. "aws-cn". It is derived from Region if empty.
+	Partition string
+	// Endpoint is the URL of the endpoint used for all AWS services instead of the default endpoints of the region.
+	// This field is mutually exclusive with UseFIPSEndpoints.
+	Endpoint string
+	// UseFIPSEndpoints selects the FIPS 140 validated endpoints of the AWS services.
+	UseFIPSEndpoints bool
 }
 
 // AccessKey represents static credentials for authentication to AWS.
@@ -75,6 +97,20 @@ type WorkloadIdentity struct {
 	RoleARN string
 }
 
+// AssumeRole is a role which is assumed with the credentials of the previous hop of a role chain.
+type AssumeRole struct {
+	// RoleARN is the ARN of the role that will be assumed.
+	RoleARN string
+	// ExternalID is the external ID required by the trust policy of the role.
+	ExternalID *string
+	// SessionName is the name of the role session. A random name is used if empty.
+	SessionName string
+	// Duration is the duration of the role session. The default of the SDK is used if zero.
+	Duration time.Duration
+	// Policy is an inline session policy which further restricts the permissions of the role session.
+	Policy *string
+}
+
 // Client is a struct containing several clients for the different AWS services it needs to interact with.
 // * EC2 is the standard client for the EC2 service.
 // * STS is the standard client for the STS service.
@@ -82,7 +118,10 @@ type WorkloadIdentity struct {
 // * S3 is the standard client for the S3 service.
 // * ELB is the standard client for the ELB service.
 // * ELBv2 is the standard client for the ELBv2 service.
+// * EFS is the standard client for the EFS service.
+// * FSx is the standard client for the FSx service.
 // * Route53 is the standard client for the Route53 service.
+// * ServiceQuotas is the standard client for the Service Quotas service.
 type Client struct {
 	EC2                           ec2.Client
 	STS                           sts.Client
@@ -91,7 +130,10 @@ type Client struct {
 	ELB                           elb.Client
 	ELBv2                         elbv2.Client
 	EFS                           efs.Client
+	FSx                           fsx.Client
 	Route53                       route53.Client
+	ServiceQuotas                 servicequotas.Client
+	Partition                     string
 	Route53RateLimiter            *rate.Limiter
 	Route53RateLimiterWaitTimeout time.Duration
 	Logger                        logr.Logger
@@ -114,21 +156,48 @@ func NewClient(authConfig AuthConfig) (*Client, error) {
 		credentialsProvider = credentials.NewStaticCredentialsProvider(authConfig.AccessKey.ID, authConfig.AccessKey.Secret, "")
 	} else {
 		credentialsProvider = stscreds.NewWebIdentityRoleProvider(
-			sts.NewFromConfig(aws.Config{Region: authConfig.Region}),
+			newSTSClient(authConfig, nil),
 			authConfig.WorkloadIdentity.RoleARN,
 			authConfig.WorkloadIdentity.TokenRetriever,
 		)
 	}
-	cfg, err := v2config.LoadDefaultConfig(
-		context.TODO(),
+	for _, role := range authConfig.AssumeRoleChain {
+		credentialsProvider = stscreds.NewAssumeRoleProvider(
+			newSTSClient(authConfig, aws.NewCredentialsCache(credentialsProvider)),
+			role.RoleARN,
+			func(o *stscreds.AssumeRoleOptions) {
+				o.ExternalID = role.ExternalID
+				o.Policy = role.Policy
+				if role.SessionName != "" {
+					o.RoleSessionName = role.SessionName
+				}
+				if role.Duration > 0 {
+					o.Duration = role.Duration
+				}
+			},
+		)
+	}
+	opts := []func(*v2config.LoadOptions) error{
 		v2config.WithRegion(authConfig.Region),
 		v2config.WithCredentialsProvider(aws.NewCredentialsCache(credentialsProvider)),
-	)
+	}
+	if authConfig.UseFIPSEndpoints {
+		opts = append(opts, v2config.WithUseFIPSEndpoint(aws.FIPSEndpointStateEnabled))
+	}
+	cfg, err := v2config.LoadDefaultConfig(context.TODO(), opts...)
 	if err != nil {
 		return nil, err
 	}
+	if authConfig.Endpoint != "" {
+		cfg.BaseEndpoint = aws.String(authConfig.Endpoint)
+	}
 
-	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+	cfg.APIOptions = append(cfg.APIOptions, coalesceDescribeCallsMiddleware(strings.Join([]string{identityKey(authConfig), authConfig.Region, authConfig.Endpoint}, "/")))
+	if opts := DefaultAPIRateLimiterOptions; opts.Limit > 0 {
+		rateLimiter := getAPIRateLimiter(accountKey(context.TODO(), sts.NewFromConfig(cfg), authConfig)+"/"+authConfig.Region, opts)
+		cfg.APIOptions = append(cfg.APIOptions, apiRateLimiterMiddleware(rateLimiter, opts.WaitTimeout))
+	}
+	cfg.APIOptions = append(cfg.APIOptions, addInstrumentationMiddleware, func(stack *middleware.Stack) error {
 		return stack.Build.Add(
 			middleware.BuildMiddlewareFunc(
 				"addUserAgent",
@@ -156,15 +225,23 @@ func NewClient(authConfig AuthConfig) (*Client, error) {
 		)
 	})
 
+	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		// custom endpoints usually do not support virtual-hosted-style bucket addressing
+		o.UsePathStyle = authConfig.Endpoint != ""
+	})
+
 	return &Client{
 		EC2:                           *ec2.NewFromConfig(cfg),
 		ELB:                           *elb.NewFromConfig(cfg),
 		ELBv2:                         *elbv2.NewFromConfig(cfg),
 		IAM:                           *iam.NewFromConfig(cfg),
 		STS:                           *sts.NewFromConfig(cfg),
-		S3:                            *s3.NewFromConfig(cfg),
+		S3:                            *s3Client,
 		EFS:                           *efs.NewFromConfig(cfg),
+		FSx:                           *fsx.NewFromConfig(cfg),
 		Route53:                       *route53.NewFromConfig(cfg),
+		ServiceQuotas:                 *servicequotas.NewFromConfig(cfg),
+		Partition:                     cmp.Or(authConfig.Partition, PartitionForRegion(authConfig.Region)),
 		Route53RateLimiter:            rate.NewLimiter(rate.Inf, 0),
 		Route53RateLimiterWaitTimeout: 1 * time.Second,
 		Logger:                        log.Log.WithName("aws-client"),
@@ -172,6 +249,28 @@ func NewClient(authConfig AuthConfig) (*Client, error) {
 	}, nil
 }
 
+// newSTSClient creates an STS client for the credential providers of the given auth config.
+func newSTSClient(authConfig AuthConfig, credentialsProvider aws.CredentialsProvider) *sts.Client {
+	cfg := aws.Config{
+		Region:      authConfig.Region,
+		Credentials: credentialsProvider,
+		APIOptions:  []func(*middleware.Stack) error{addInstrumentationMiddleware},
+	}
+	if authConfig.Endpoint != "" {
+		cfg.BaseEndpoint = aws.String(authConfig.Endpoint)
+	}
+	return sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if authConfig.UseFIPSEndpoints {
+			o.EndpointOptions.UseFIPSEndpoint = aws.FIPSEndpointStateEnabled
+		}
+	})
+}
+
+// GetPartition returns the ARN partition of the region the Client is interacting with.
+func (c *Client) GetPartition() string {
+	return c.Partition
+}
+
 // GetAccountID returns the ID of the AWS account the Client is interacting with.
 func (c *Client) GetAccountID(ctx context.Context) (string, error) {
 	getCallerIdentityOutput, err := c.STS.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
@@ -271,6 +370,62 @@ func (c *Client) GetNATGatewayAddressAllocations(ctx context.Context, shootNames
 	return result, nil
 }
 
+// GetPublicIPv4PoolFreeAddressCount returns the number of addresses of the public IPv4 pool with the given ID which are
+// not allocated yet. It returns nil if the pool is not found.
+func (c *Client) GetPublicIPv4PoolFreeAddressCount(ctx context.Context, poolID string) (*int, error) {
+	output, err := c.EC2.DescribePublicIpv4Pools(ctx, &ec2.DescribePublicIpv4PoolsInput{PoolIds: []string{poolID}})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	if len(output.PublicIpv4Pools) == 0 {
+		return nil, nil
+	}
+	return ptr.To(int(aws.ToInt32(output.PublicIpv4Pools[0].TotalAvailableAddressCount))), nil
+}
+
+// GetIPAMPoolFreeAddressCount returns the number of IPv4 addresses of the provisioned CIDRs of the IPAM pool with the
+// given ID which are not allocated yet. It returns nil if the pool is not found.
+func (c *Client) GetIPAMPoolFreeAddressCount(ctx context.Context, poolID string) (*int, error) {
+	count := 0
+	cidrs := ec2.NewGetIpamPoolCidrsPaginator(&c.EC2, &ec2.GetIpamPoolCidrsInput{IpamPoolId: aws.String(poolID)})
+	for cidrs.HasMorePages() {
+		page, err := cidrs.NextPage(ctx)
+		if err != nil {
+			if IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		for _, cidr := range page.IpamPoolCidrs {
+			if cidr.State == ec2types.IpamPoolCidrStateProvisioned {
+				count += ipv4AddressCount(aws.ToString(cidr.Cidr))
+			}
+		}
+	}
+
+	allocations := ec2.NewGetIpamPoolAllocationsPaginator(&c.EC2, &ec2.GetIpamPoolAllocationsInput{IpamPoolId: aws.String(poolID)})
+	for allocations.HasMorePages() {
+		page, err := allocations.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, allocation := range page.IpamPoolAllocations {
+			count -= ipv4AddressCount(aws.ToString(allocation.Cidr))
+		}
+	}
+	return ptr.To(max(count, 0)), nil
+}
+
+// ipv4AddressCount returns the number of addresses of the given IPv4 CIDR, or 0 if it is no IPv4 CIDR.
+func ipv4AddressCount(cidr string) int {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil || ipNet.IP.To4() == nil {
+		return 0
+	}
+	ones, bits := ipNet.Mask.Size()
+	return 1 << (bits - ones)
+}
+
 // GetVPCAttribute returns the value of the specified VPC attribute.
 func (c *Client) GetVPCAttribute(ctx context.Context, vpcID string, attribute ec2types.VpcAttributeName) (bool, error) {
 	vpcAttribute, err := c.EC2.DescribeVpcAttribute(
@@ -415,17 +570,7 @@ func (c *Client) CreateBucket(ctx context.Context, bucket, region string, object
 	}
 
 	// Handle bucket policy IAM ARN for different partitions (AWS region groups)
-	// Different available partitions in AWS are defined at
-	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference-arns.html
-	// https://github.com/aws/aws-sdk-go-v2/blob/main/internal/endpoints/awsrulesfn/partitions.json
-	arnPartition := "aws"
-	if strings.HasPrefix(region, "cn-") {
-		arnPartition = "aws-cn" // China regions
-	} else if strings.HasPrefix(region, "us-gov-") {
-		arnPartition = "aws-us-gov" // AWS GovCloud (US) regions
-	} else if strings.HasPrefix(region, "eusc-") { // e.g. "eusc-de-east-1"
-		arnPartition = "aws-eusc" // AWS EUSC region
-	}
+	arnPartition := cmp.Or(c.Partition, PartitionForRegion(region))
 
 	// Set bucket policy to deny non-HTTPS requests
 	bucketPolicy := map[string]interface{}{
@@ -703,6 +848,163 @@ func (c *Client) DeleteELBV2(ctx context.Context, arn string) error {
 	return nil
 }
 
+// FindKubernetesELBByDNSName returns the name of the ELB loadbalancer with the given <dnsName> tagged with <clusterName>.
+// If no such loadbalancer exists, an empty string is returned.
+func (c *Client) FindKubernetesELBByDNSName(ctx context.Context, dnsName, clusterName string) (string, error) {
+	name := loadBalancerNameFromDNSName(dnsName)
+	if name == "" {
+		return "", nil
+	}
+
+	output, err := c.ELB.DescribeLoadBalancers(ctx, &elb.DescribeLoadBalancersInput{LoadBalancerNames: []string{name}})
+	if err != nil {
+		return "", ignoreNotFound(err)
+	}
+	if !slices.ContainsFunc(output.LoadBalancerDescriptions, func(description elbtypes.LoadBalancerDescription) bool {
+		return description.DNSName != nil && strings.EqualFold(*description.DNSName, dnsName)
+	}) {
+		return "", nil
+	}
+
+	tags, err := c.ELB.DescribeTags(ctx, &elb.DescribeTagsInput{LoadBalancerNames: []string{name}})
+	if err != nil {
+		return "", ignoreNotFound(err)
+	}
+
+	for _, description := range tags.TagDescriptions {
+		for _, tag := range description.Tags {
+			if tag.Key != nil && *tag.Key == fmt.Sprintf("kubernetes.io/cluster/%s", clusterName) &&
+				tag.Value != nil && *tag.Value == "owned" {
+				return name, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// FindKubernetesELBV2ByDNSName returns the Amazon Resource Name (ARN) of the ELBv2 loadbalancer with the given <dnsName>
+// tagged with <clusterName>. If no such loadbalancer exists, an empty string is returned.
+func (c *Client) FindKubernetesELBV2ByDNSName(ctx context.Context, dnsName, clusterName string) (string, error) {
+	name := loadBalancerNameFromDNSName(dnsName)
+	if name == "" {
+		return "", nil
+	}
+
+	output, err := c.ELBv2.DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{Names: []string{name}})
+	if err != nil {
+		return "", ignoreNotFound(err)
+	}
+	var loadBalancerARN *string
+	for _, lb := range output.LoadBalancers {
+		if lb.DNSName != nil && strings.EqualFold(*lb.DNSName, dnsName) {
+			loadBalancerARN = lb.LoadBalancerArn
+			break
+		}
+	}
+	if loadBalancerARN == nil {
+		return "", nil
+	}
+
+	tags, err := c.ELBv2.DescribeTags(ctx, &elbv2.DescribeTagsInput{ResourceArns: []string{*loadBalancerARN}})
+	if err != nil {
+		return "", ignoreNotFound(err)
+	}
+
+	for _, description := range tags.TagDescriptions {
+		for _, tag := range description.Tags {
+			if tag.Key != nil && *tag.Key == fmt.Sprintf("kubernetes.io/cluster/%s", clusterName) &&
+				tag.Value != nil && *tag.Value == "owned" {
+				return *loadBalancerARN, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// loadBalancerNameFromDNSName returns the name of the load balancer with the given DNS name, so that it can be looked up
+// directly instead of listing all load balancers of the region. AWS derives the first label of the DNS name from the
+// name of the load balancer and a generated suffix, e.g. `<name>-<suffix>` or `internal-<name>-<suffix>`.
+func loadBalancerNameFromDNSName(dnsName string) string {
+	label, _, _ := strings.Cut(dnsName, ".")
+	label = strings.TrimPrefix(label, "internal-")
+	if i := strings.LastIndex(label, "-"); i > 0 {
+		return label[:i]
+	}
+	return ""
+}
+
+// DrainELB deregisters the instances of the ELB loadbalancer with the given <name>, so that it does not accept new
+// connections, and returns true once the connection draining of all instances has finished.
+func (c *Client) DrainELB(ctx context.Context, name string) (bool, error) {
+	output, err := c.ELB.DescribeInstanceHealth(ctx, &elb.DescribeInstanceHealthInput{LoadBalancerName: aws.String(name)})
+	if err != nil {
+		return false, ignoreNotFound(err)
+	}
+
+	var registered []elbtypes.Instance
+	for _, state := range output.InstanceStates {
+		// instances which are still draining are reported until the connection draining timeout has expired
+		if state.Description != nil && strings.Contains(*state.Description, "deregistration currently in progress") {
+			return false, nil
+		}
+		registered = append(registered, elbtypes.Instance{InstanceId: state.InstanceId})
+	}
+	if len(registered) == 0 {
+		return true, nil
+	}
+
+	_, err = c.ELB.DeregisterInstancesFromLoadBalancer(ctx, &elb.DeregisterInstancesFromLoadBalancerInput{
+		LoadBalancerName: aws.String(name),
+		Instances:        registered,
+	})
+	return false, ignoreNotFound(err)
+}
+
+// DrainELBV2 deregisters the targets of the target groups of the ELBv2 loadbalancer with the given <arn>, so that it
+// does not accept new connections, and returns true once no target is draining anymore.
+func (c *Client) DrainELBV2(ctx context.Context, arn string) (bool, error) {
+	drained := true
+	paginator := elbv2.NewDescribeTargetGroupsPaginator(&c.ELBv2, &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(arn)})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return false, ignoreNotFound(err)
+		}
+		for _, targetGroup := range output.TargetGroups {
+			health, err := c.ELBv2.DescribeTargetHealth(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: targetGroup.TargetGroupArn})
+			if err != nil {
+				if IsNotFoundError(err) {
+					continue
+				}
+				return false, err
+			}
+
+			var registered []elbv2types.TargetDescription
+			for _, description := range health.TargetHealthDescriptions {
+				if description.Target == nil {
+					continue
+				}
+				drained = false
+				if description.TargetHealth == nil || description.TargetHealth.State != elbv2types.TargetHealthStateEnumDraining {
+					registered = append(registered, *description.Target)
+				}
+			}
+			if len(registered) == 0 {
+				continue
+			}
+			if _, err := c.ELBv2.DeregisterTargets(ctx, &elbv2.DeregisterTargetsInput{
+				TargetGroupArn: targetGroup.TargetGroupArn,
+				Targets:        registered,
+			}); ignoreNotFound(err) != nil {
+				return false, err
+			}
+		}
+	}
+	return drained, nil
+}
+
 // ListKubernetesSecurityGroups returns the list of security groups in the given <vpcID> tagged with <clusterName>.
 func (c *Client) ListKubernetesSecurityGroups(ctx context.Context, vpcID, clusterName string) ([]string, error) {
 	groups, err := c.EC2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
@@ -972,6 +1274,103 @@ func (c *Client) DeleteVpc(ctx context.Context, id string) error {
 	return ignoreNotFound(err)
 }
 
+// CreateFlowLog creates a flow log for a VPC.
+func (c *Client) CreateFlowLog(ctx context.Context, flowLog *FlowLog) (*FlowLog, error) {
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:              []string{flowLog.ResourceId},
+		ResourceType:             ec2types.FlowLogsResourceTypeVpc,
+		TrafficType:              ec2types.TrafficType(flowLog.TrafficType),
+		LogDestinationType:       ec2types.LogDestinationType(flowLog.LogDestinationType),
+		LogDestination:           flowLog.LogDestination,
+		LogGroupName:             flowLog.LogGroupName,
+		DeliverLogsPermissionArn: flowLog.DeliverLogsPermissionArn,
+		LogFormat:                flowLog.LogFormat,
+		MaxAggregationInterval:   flowLog.MaxAggregationInterval,
+		TagSpecifications:        flowLog.ToTagSpecifications(ec2types.ResourceTypeVpcFlowLog),
+	}
+	output, err := c.EC2.CreateFlowLogs(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil {
+			return nil, fmt.Errorf("creating flow log for %s failed: %s (%s)", aws.ToString(item.ResourceId), aws.ToString(item.Error.Message), aws.ToString(item.Error.Code))
+		}
+	}
+	if len(output.FlowLogIds) == 0 {
+		return nil, fmt.Errorf("creating flow log for %s returned no flow log id", flowLog.ResourceId)
+	}
+	created := *flowLog
+	created.FlowLogId = output.FlowLogIds[0]
+	return &created, nil
+}
+
+// GetFlowLog gets a flow log resource by identifier.
+// Returns nil, if the resource is not found.
+func (c *Client) GetFlowLog(ctx context.Context, id string) (*FlowLog, error) {
+	input := &ec2.DescribeFlowLogsInput{FlowLogIds: []string{id}}
+	output, err := c.describeFlowLogs(ctx, input)
+	return single(output, err)
+}
+
+// FindFlowLogsByTags finds flow log resources matching the given tag map.
+func (c *Client) FindFlowLogsByTags(ctx context.Context, tags Tags) ([]*FlowLog, error) {
+	input := &ec2.DescribeFlowLogsInput{Filter: tags.ToFilters()}
+	return c.describeFlowLogs(ctx, input)
+}
+
+// FindFlowLogsByResourceID finds the flow logs of the resource with the given identifier, e.g. a VPC.
+func (c *Client) FindFlowLogsByResourceID(ctx context.Context, resourceID string) ([]*FlowLog, error) {
+	input := &ec2.DescribeFlowLogsInput{Filter: []ec2types.Filter{
+		{
+			Name:   aws.String("resource-id"),
+			Values: []string{resourceID},
+		},
+	}}
+	return c.describeFlowLogs(ctx, input)
+}
+
+func (c *Client) describeFlowLogs(ctx context.Context, input *ec2.DescribeFlowLogsInput) ([]*FlowLog, error) {
+	var flowLogs []*FlowLog
+	paginator := ec2.NewDescribeFlowLogsPaginator(&c.EC2, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		for _, item := range output.FlowLogs {
+			flowLogs = append(flowLogs, &FlowLog{
+				Tags:                     FromTags(item.Tags),
+				FlowLogId:                aws.ToString(item.FlowLogId),
+				ResourceId:               aws.ToString(item.ResourceId),
+				TrafficType:              string(item.TrafficType),
+				LogDestinationType:       string(item.LogDestinationType),
+				LogDestination:           item.LogDestination,
+				LogGroupName:             item.LogGroupName,
+				DeliverLogsPermissionArn: item.DeliverLogsPermissionArn,
+				LogFormat:                item.LogFormat,
+				MaxAggregationInterval:   item.MaxAggregationInterval,
+			})
+		}
+	}
+	return flowLogs, nil
+}
+
+// DeleteFlowLog deletes a flow log resource by identifier.
+// Returns nil, if the resource is not found.
+func (c *Client) DeleteFlowLog(ctx context.Context, id string) error {
+	output, err := c.EC2.DeleteFlowLogs(ctx, &ec2.DeleteFlowLogsInput{FlowLogIds: []string{id}})
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil && !strings.HasSuffix(aws.ToString(item.Error.Code), ".NotFound") {
+			return fmt.Errorf("deleting flow log %s failed: %s (%s)", id, aws.ToString(item.Error.Message), aws.ToString(item.Error.Code))
+		}
+	}
+	return nil
+}
+
 // GetVpc gets a VPC resource by identifier.
 // Returns nil, if the resource is not found.
 func (c *Client) GetVpc(ctx context.Context, id string) (*VPC, error) {
@@ -1177,6 +1576,12 @@ func (c *Client) FindSecurityGroupsByTags(ctx context.Context, tags Tags) ([]*Se
 	return c.describeSecurityGroups(ctx, input)
 }
 
+// FindSecurityGroups finds security groups by the given filters.
+func (c *Client) FindSecurityGroups(ctx context.Context, filters []ec2types.Filter) ([]*SecurityGroup, error) {
+	input := &ec2.DescribeSecurityGroupsInput{Filters: filters}
+	return c.describeSecurityGroups(ctx, input)
+}
+
 func (c *Client) describeSecurityGroups(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*SecurityGroup, error) {
 	output, err := c.EC2.DescribeSecurityGroups(ctx, input)
 	if err != nil {
@@ -1190,6 +1595,7 @@ func (c *Client) describeSecurityGroups(ctx context.Context, input *ec2.Describe
 			GroupName:   aws.ToString(item.GroupName),
 			VpcId:       item.VpcId,
 			Description: item.Description,
+			OwnerId:     aws.ToString(item.OwnerId),
 		}
 		for _, ipPerm := range item.IpPermissions {
 			rule, err := fromIpPermission(aws.ToString(item.GroupId), ipPerm, SecurityGroupRuleTypeIngress)
@@ -1229,6 +1635,181 @@ func (c *Client) FindDefaultSecurityGroupByVpcId(ctx context.Context, vpcId stri
 	return nil, nil
 }
 
+// CreateNetworkAcl creates an EC2 network ACL resource.
+func (c *Client) CreateNetworkAcl(ctx context.Context, acl *NetworkAcl) (*NetworkAcl, error) {
+	input := &ec2.CreateNetworkAclInput{
+		VpcId:             acl.VpcId,
+		TagSpecifications: acl.ToTagSpecifications(ec2types.ResourceTypeNetworkAcl),
+	}
+	output, err := c.EC2.CreateNetworkAcl(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromNetworkAcl(output.NetworkAcl), nil
+}
+
+// GetNetworkAcl gets a network ACL resource by identifier.
+// Returns nil, if the resource is not found.
+func (c *Client) GetNetworkAcl(ctx context.Context, id string) (*NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{NetworkAclIds: []string{id}}
+	output, err := c.describeNetworkAcls(ctx, input)
+	return single(output, err)
+}
+
+// FindNetworkAclsByTags finds network ACL resources matching the given tag map.
+func (c *Client) FindNetworkAclsByTags(ctx context.Context, tags Tags) ([]*NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{Filters: tags.ToFilters()}
+	return c.describeNetworkAcls(ctx, input)
+}
+
+// FindDefaultNetworkAclByVpcId finds the default network ACL of the given VPC.
+func (c *Client) FindDefaultNetworkAclByVpcId(ctx context.Context, vpcId string) (*NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String(FilterVpcID), Values: []string{vpcId}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	}
+	output, err := c.describeNetworkAcls(ctx, input)
+	return single(output, err)
+}
+
+// FindNetworkAclBySubnetId finds the network ACL the given subnet is associated with.
+func (c *Client) FindNetworkAclBySubnetId(ctx context.Context, subnetId string) (*NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("association.subnet-id"), Values: []string{subnetId}},
+		},
+	}
+	output, err := c.describeNetworkAcls(ctx, input)
+	return single(output, err)
+}
+
+func (c *Client) describeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput) ([]*NetworkAcl, error) {
+	output, err := c.EC2.DescribeNetworkAcls(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var acls []*NetworkAcl
+	for _, item := range output.NetworkAcls {
+		acls = append(acls, fromNetworkAcl(&item))
+	}
+	return acls, nil
+}
+
+func fromNetworkAcl(item *ec2types.NetworkAcl) *NetworkAcl {
+	acl := &NetworkAcl{
+		Tags:         FromTags(item.Tags),
+		NetworkAclId: aws.ToString(item.NetworkAclId),
+		VpcId:        item.VpcId,
+		IsDefault:    aws.ToBool(item.IsDefault),
+	}
+	for _, entry := range item.Entries {
+		e := &NetworkAclEntry{
+			RuleNumber:    aws.ToInt32(entry.RuleNumber),
+			Egress:        aws.ToBool(entry.Egress),
+			Protocol:      aws.ToString(entry.Protocol),
+			RuleAction:    string(entry.RuleAction),
+			CidrBlock:     entry.CidrBlock,
+			Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		}
+		if entry.PortRange != nil {
+			e.FromPort = entry.PortRange.From
+			e.ToPort = entry.PortRange.To
+		}
+		acl.Entries = append(acl.Entries, e)
+	}
+	for _, assoc := range item.Associations {
+		acl.Associations = append(acl.Associations, &NetworkAclAssociation{
+			NetworkAclAssociationId: aws.ToString(assoc.NetworkAclAssociationId),
+			SubnetId:                aws.ToString(assoc.SubnetId),
+		})
+	}
+	return acl
+}
+
+// CreateNetworkAclEntry creates an entry of a network ACL.
+func (c *Client) CreateNetworkAclEntry(ctx context.Context, id string, entry *NetworkAclEntry) error {
+	input := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		RuleNumber:    aws.Int32(entry.RuleNumber),
+		Egress:        aws.Bool(entry.Egress),
+		Protocol:      aws.String(entry.Protocol),
+		RuleAction:    ec2types.RuleAction(entry.RuleAction),
+		CidrBlock:     entry.CidrBlock,
+		Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		PortRange:     networkAclEntryPortRange(entry),
+		IcmpTypeCode:  networkAclEntryIcmpTypeCode(entry),
+	}
+	_, err := c.EC2.CreateNetworkAclEntry(ctx, input)
+	return err
+}
+
+// ReplaceNetworkAclEntry replaces the entry of a network ACL with the same direction and rule number.
+func (c *Client) ReplaceNetworkAclEntry(ctx context.Context, id string, entry *NetworkAclEntry) error {
+	input := &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		RuleNumber:    aws.Int32(entry.RuleNumber),
+		Egress:        aws.Bool(entry.Egress),
+		Protocol:      aws.String(entry.Protocol),
+		RuleAction:    ec2types.RuleAction(entry.RuleAction),
+		CidrBlock:     entry.CidrBlock,
+		Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		PortRange:     networkAclEntryPortRange(entry),
+		IcmpTypeCode:  networkAclEntryIcmpTypeCode(entry),
+	}
+	_, err := c.EC2.ReplaceNetworkAclEntry(ctx, input)
+	return err
+}
+
+func networkAclEntryPortRange(entry *NetworkAclEntry) *ec2types.PortRange {
+	if !entry.HasPortRange() {
+		return nil
+	}
+	return &ec2types.PortRange{From: entry.FromPort, To: entry.ToPort}
+}
+
+// networkAclEntryIcmpTypeCode returns all ICMP types and codes for ICMP entries, as they are required by AWS.
+func networkAclEntryIcmpTypeCode(entry *NetworkAclEntry) *ec2types.IcmpTypeCode {
+	if !entry.IsICMP() {
+		return nil
+	}
+	return &ec2types.IcmpTypeCode{Type: aws.Int32(-1), Code: aws.Int32(-1)}
+}
+
+// DeleteNetworkAclEntry deletes the entry of a network ACL with the same direction and rule number.
+// Returns nil, if the entry is not found.
+func (c *Client) DeleteNetworkAclEntry(ctx context.Context, id string, entry *NetworkAclEntry) error {
+	input := &ec2.DeleteNetworkAclEntryInput{
+		NetworkAclId: aws.String(id),
+		RuleNumber:   aws.Int32(entry.RuleNumber),
+		Egress:       aws.Bool(entry.Egress),
+	}
+	_, err := c.EC2.DeleteNetworkAclEntry(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// ReplaceNetworkAclAssociation associates the subnet of the given association with another network ACL and returns
+// the identifier of the new association.
+func (c *Client) ReplaceNetworkAclAssociation(ctx context.Context, associationId, networkAclId string) (string, error) {
+	input := &ec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: aws.String(associationId),
+		NetworkAclId:  aws.String(networkAclId),
+	}
+	output, err := c.EC2.ReplaceNetworkAclAssociation(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(output.NewAssociationId), nil
+}
+
+// DeleteNetworkAcl deletes a network ACL resource by identifier.
+// Returns nil, if the resource is not found.
+func (c *Client) DeleteNetworkAcl(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteNetworkAcl(ctx, &ec2.DeleteNetworkAclInput{NetworkAclId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
 // DeleteSecurityGroup deletes a security group resource by identifier.
 // Returns nil, if the resource is not found.
 func (c *Client) DeleteSecurityGroup(ctx context.Context, id string) error {
@@ -1706,6 +2287,39 @@ func (c *Client) FindSubnets(ctx context.Context, filters []ec2types.Filter) ([]
 	return c.describeSubnets(ctx, input)
 }
 
+// FindNetworkInterfaces finds network interfaces by the given filters.
+func (c *Client) FindNetworkInterfaces(ctx context.Context, filters []ec2types.Filter) ([]*NetworkInterface, error) {
+	var interfaces []*NetworkInterface
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(&c.EC2, &ec2.DescribeNetworkInterfacesInput{Filters: filters})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		for _, item := range page.NetworkInterfaces {
+			networkInterface := &NetworkInterface{
+				Tags:               FromTags(item.TagSet),
+				NetworkInterfaceId: aws.ToString(item.NetworkInterfaceId),
+				SubnetId:           aws.ToString(item.SubnetId),
+				VpcId:              aws.ToString(item.VpcId),
+				InterfaceType:      string(item.InterfaceType),
+				Description:        aws.ToString(item.Description),
+				Status:             string(item.Status),
+				OwnerId:            aws.ToString(item.OwnerId),
+				RequesterId:        item.RequesterId,
+			}
+			for _, group := range item.Groups {
+				networkInterface.SecurityGroupIds = append(networkInterface.SecurityGroupIds, aws.ToString(group.GroupId))
+			}
+			if item.Attachment != nil {
+				networkInterface.InstanceId = item.Attachment.InstanceId
+			}
+			interfaces = append(interfaces, networkInterface)
+		}
+	}
+	return interfaces, nil
+}
+
 func (c *Client) describeSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*Subnet, error) {
 	output, err := c.EC2.DescribeSubnets(ctx, input)
 	if err != nil {
@@ -1868,6 +2482,8 @@ func (c *Client) CreateElasticIP(ctx context.Context, eip *ElasticIP) (*ElasticI
 	}
 	input := &ec2.AllocateAddressInput{
 		Domain:            domainOpt,
+		PublicIpv4Pool:    eip.PublicIPv4Pool,
+		IpamPoolId:        eip.IPAMPoolID,
 		TagSpecifications: eip.ToTagSpecifications(ec2types.ResourceTypeElasticIp),
 	}
 	output, err := c.EC2.AllocateAddress(ctx, input)
@@ -1875,10 +2491,12 @@ func (c *Client) CreateElasticIP(ctx context.Context, eip *ElasticIP) (*ElasticI
 		return nil, err
 	}
 	return &ElasticIP{
-		Tags:         eip.Clone(),
-		Vpc:          eip.Vpc,
-		AllocationId: aws.ToString(output.AllocationId),
-		PublicIp:     aws.ToString(output.PublicIp),
+		Tags:           eip.Clone(),
+		Vpc:            eip.Vpc,
+		AllocationId:   aws.ToString(output.AllocationId),
+		PublicIp:       aws.ToString(output.PublicIp),
+		PublicIPv4Pool: output.PublicIpv4Pool,
+		IPAMPoolID:     eip.IPAMPoolID,
 	}, nil
 }
 
@@ -2017,20 +2635,109 @@ func (c *Client) DeleteNATGateway(ctx context.Context, id string) error {
 	return ignoreNotFound(err)
 }
 
-// ImportKeyPair creates a EC2 key pair.
-func (c *Client) ImportKeyPair(ctx context.Context, keyName string, publicKey []byte, tags Tags) (*KeyPairInfo, error) {
-	input := &ec2.ImportKeyPairInput{
-		KeyName:           aws.String(keyName),
-		PublicKeyMaterial: publicKey,
-		TagSpecifications: tags.ToTagSpecifications(ec2types.ResourceTypeKeyPair),
+// AssociateNATGatewayAddresses associates the elastic IPs with the given allocation IDs as secondary addresses with a
+// NAT gateway and waits until the association succeeded. The NAT gateway assigns a secondary private IP to each of them.
+func (c *Client) AssociateNATGatewayAddresses(ctx context.Context, id string, allocationIDs []string) error {
+	input := &ec2.AssociateNatGatewayAddressInput{
+		NatGatewayId:  aws.String(id),
+		AllocationIds: allocationIDs,
 	}
-	output, err := c.EC2.ImportKeyPair(ctx, input)
-	if err != nil {
-		return nil, err
+	if _, err := c.EC2.AssociateNatGatewayAddress(ctx, input); err != nil {
+		return err
 	}
-	return &KeyPairInfo{
-		Tags:           FromTags(output.Tags),
-		KeyName:        aws.ToString(output.KeyName),
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		gw, err := c.GetNATGateway(ctx, id)
+		if err != nil || gw == nil {
+			return false, err
+		}
+		for _, allocationID := range allocationIDs {
+			address := findNATGatewayAddress(gw.SecondaryAddresses, func(a *NATGatewayAddress) bool { return a.AllocationId == allocationID })
+			if address == nil {
+				return false, nil
+			}
+			if address.Status == string(ec2types.NatGatewayAddressStatusFailed) {
+				return false, fmt.Errorf("association of elastic IP %s with NAT gateway %s failed", allocationID, id)
+			}
+			if address.Status != string(ec2types.NatGatewayAddressStatusSucceeded) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// DisassociateNATGatewayAddresses disassociates the given secondary addresses from a NAT gateway and unassigns their
+// private IPs. It waits until the addresses are removed, so that the elastic IPs can be released afterwards.
+func (c *Client) DisassociateNATGatewayAddresses(ctx context.Context, id string, addresses []*NATGatewayAddress) error {
+	var associationIDs, privateIPs []string
+	for _, address := range addresses {
+		if address.AssociationId != "" {
+			associationIDs = append(associationIDs, address.AssociationId)
+		}
+		privateIPs = append(privateIPs, address.PrivateIP)
+	}
+
+	if len(associationIDs) > 0 {
+		input := &ec2.DisassociateNatGatewayAddressInput{
+			NatGatewayId:   aws.String(id),
+			AssociationIds: associationIDs,
+		}
+		if _, err := c.EC2.DisassociateNatGatewayAddress(ctx, input); err != nil {
+			return ignoreNotFound(err)
+		}
+		if err := c.waitForNATGatewayAddresses(ctx, id, func(a *NATGatewayAddress) bool {
+			return slices.Contains(associationIDs, a.AssociationId)
+		}); err != nil {
+			return err
+		}
+	}
+
+	input := &ec2.UnassignPrivateNatGatewayAddressInput{
+		NatGatewayId:       aws.String(id),
+		PrivateIpAddresses: privateIPs,
+	}
+	if _, err := c.EC2.UnassignPrivateNatGatewayAddress(ctx, input); err != nil {
+		return ignoreNotFound(err)
+	}
+	return c.waitForNATGatewayAddresses(ctx, id, func(a *NATGatewayAddress) bool {
+		return slices.Contains(privateIPs, a.PrivateIP)
+	})
+}
+
+// waitForNATGatewayAddresses waits until the NAT gateway has no secondary address matching the given function anymore.
+func (c *Client) waitForNATGatewayAddresses(ctx context.Context, id string, matches func(*NATGatewayAddress) bool) error {
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		gw, err := c.GetNATGateway(ctx, id)
+		if err != nil || gw == nil {
+			return gw == nil, err
+		}
+		return findNATGatewayAddress(gw.SecondaryAddresses, matches) == nil, nil
+	})
+}
+
+func findNATGatewayAddress(addresses []*NATGatewayAddress, matches func(*NATGatewayAddress) bool) *NATGatewayAddress {
+	for _, address := range addresses {
+		if matches(address) {
+			return address
+		}
+	}
+	return nil
+}
+
+// ImportKeyPair creates a EC2 key pair.
+func (c *Client) ImportKeyPair(ctx context.Context, keyName string, publicKey []byte, tags Tags) (*KeyPairInfo, error) {
+	input := &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(keyName),
+		PublicKeyMaterial: publicKey,
+		TagSpecifications: tags.ToTagSpecifications(ec2types.ResourceTypeKeyPair),
+	}
+	output, err := c.EC2.ImportKeyPair(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPairInfo{
+		Tags:           FromTags(output.Tags),
+		KeyName:        aws.ToString(output.KeyName),
 		KeyFingerprint: aws.ToString(output.KeyFingerprint),
 	}, nil
 }
@@ -2270,6 +2977,280 @@ func (c *Client) DeleteIAMRolePolicy(ctx context.Context, policyName, roleName s
 	return ignoreNotFound(err)
 }
 
+// GetAccessKeyMetadata returns the creation date, status and last use of the IAM access key with the given ID.
+// Returns nil if the access key is not found.
+func (c *Client) GetAccessKeyMetadata(ctx context.Context, accessKeyID string) (*AccessKeyMetadata, error) {
+	lastUsed, err := c.IAM.GetAccessKeyLastUsed(ctx, &iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(accessKeyID),
+	})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+
+	metadata := &AccessKeyMetadata{
+		AccessKeyID: accessKeyID,
+		UserName:    aws.ToString(lastUsed.UserName),
+	}
+	if lastUsed.AccessKeyLastUsed != nil {
+		metadata.LastUsedDate = lastUsed.AccessKeyLastUsed.LastUsedDate
+	}
+
+	input := &iam.ListAccessKeysInput{}
+	// access keys of the root user are listed by omitting the user name
+	if metadata.UserName != "" && metadata.UserName != "root" {
+		input.UserName = aws.String(metadata.UserName)
+	}
+	paginator := iam.NewListAccessKeysPaginator(&c.IAM, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		for _, key := range page.AccessKeyMetadata {
+			if aws.ToString(key.AccessKeyId) == accessKeyID {
+				metadata.Status = string(key.Status)
+				metadata.CreateDate = aws.ToTime(key.CreateDate)
+				return metadata, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// GetCallerPrincipalARN returns the ARN of the IAM principal behind the credentials of the client. For assumed role
+// sessions, the ARN of the role is returned instead of the ARN of the session, as only the former can be used for
+// policy simulations.
+func (c *Client) GetCallerPrincipalARN(ctx context.Context) (string, error) {
+	output, err := c.STS.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+
+	callerARN, err := arn.Parse(aws.ToString(output.Arn))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse caller ARN: %w", err)
+	}
+	// the resource of an assumed role session has the format "assumed-role/<role name>/<session name>"
+	parts := strings.Split(callerARN.Resource, "/")
+	if callerARN.Service != "sts" || len(parts) != 3 || parts[0] != "assumed-role" {
+		return callerARN.String(), nil
+	}
+
+	// the session ARN does not contain the path of the role, so it has to be read from the role itself
+	role, err := c.IAM.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(parts[1])})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(role.Role.Arn), nil
+}
+
+// SimulatePrincipalPolicy simulates the policies of the principal with the given ARN for the given actions in the given
+// context and returns the actions which are denied, either implicitly or explicitly. The actions are simulated per
+// service against all resources of the service in the account of the principal and the region of the context.
+func (c *Client) SimulatePrincipalPolicy(ctx context.Context, principalARN string, actions []string, simulationContext PolicySimulationContext) ([]string, error) {
+	principal, err := arn.Parse(principalARN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse principal ARN: %w", err)
+	}
+
+	var contextEntries []iamtypes.ContextEntry
+	addEntry := func(key string, keyType iamtypes.ContextKeyTypeEnum, values ...string) {
+		contextEntries = append(contextEntries, iamtypes.ContextEntry{
+			ContextKeyName:   aws.String(key),
+			ContextKeyType:   keyType,
+			ContextKeyValues: values,
+		})
+	}
+	if simulationContext.Region != "" {
+		addEntry("aws:RequestedRegion", iamtypes.ContextKeyTypeEnumString, simulationContext.Region)
+		addEntry("ec2:Region", iamtypes.ContextKeyTypeEnumString, simulationContext.Region)
+	}
+	if len(simulationContext.Tags) > 0 {
+		keys := slices.Sorted(maps.Keys(simulationContext.Tags))
+		for _, key := range keys {
+			value := simulationContext.Tags[key]
+			addEntry("aws:RequestTag/"+key, iamtypes.ContextKeyTypeEnumString, value)
+			addEntry("aws:ResourceTag/"+key, iamtypes.ContextKeyTypeEnumString, value)
+			addEntry("ec2:ResourceTag/"+key, iamtypes.ContextKeyTypeEnumString, value)
+		}
+		addEntry("aws:TagKeys", iamtypes.ContextKeyTypeEnumStringList, keys...)
+	}
+
+	actionsByService := map[string][]string{}
+	for _, action := range actions {
+		service, _, _ := strings.Cut(action, ":")
+		actionsByService[service] = append(actionsByService[service], action)
+	}
+
+	var denied []string
+	for _, service := range slices.Sorted(maps.Keys(actionsByService)) {
+		resourceARN := arn.ARN{Partition: principal.Partition, Service: service, AccountID: principal.AccountID, Resource: "*"}
+		if service != "iam" {
+			resourceARN.Region = simulationContext.Region
+		}
+		paginator := iam.NewSimulatePrincipalPolicyPaginator(&c.IAM, &iam.SimulatePrincipalPolicyInput{
+			PolicySourceArn: aws.String(principalARN),
+			ActionNames:     actionsByService[service],
+			ResourceArns:    []string{resourceARN.String()},
+			ContextEntries:  contextEntries,
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, result := range page.EvaluationResults {
+				if result.EvalDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+					denied = append(denied, aws.ToString(result.EvalActionName))
+				}
+			}
+		}
+	}
+	return denied, nil
+}
+
+// GetServiceQuota returns the value of the service quota with the given codes applied to the account. If the quota has
+// not been changed for the account, the default value of AWS is returned. It returns nil if the quota does not exist.
+func (c *Client) GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (*float64, error) {
+	output, err := c.ServiceQuotas.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil {
+		return output.Quota.Value, nil
+	}
+	var noSuchResource *servicequotastypes.NoSuchResourceException
+	if !errors.As(err, &noSuchResource) {
+		return nil, err
+	}
+
+	defaultOutput, err := c.ServiceQuotas.GetAWSDefaultServiceQuota(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		if errors.As(err, &noSuchResource) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return defaultOutput.Quota.Value, nil
+}
+
+// CountVpcs returns the number of VPCs in the region.
+func (c *Client) CountVpcs(ctx context.Context) (int, error) {
+	count := 0
+	paginator := ec2.NewDescribeVpcsPaginator(&c.EC2, &ec2.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+		count += len(page.Vpcs)
+	}
+	return count, nil
+}
+
+// CountElasticIPs returns the number of elastic IP addresses allocated in the region.
+func (c *Client) CountElasticIPs(ctx context.Context) (int, error) {
+	output, err := c.EC2.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{{Name: aws.String("domain"), Values: []string{string(ec2types.DomainTypeVpc)}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(output.Addresses), nil
+}
+
+// CountNATGatewaysByZone returns the number of pending and available NAT gateways per availability zone.
+func (c *Client) CountNATGatewaysByZone(ctx context.Context) (map[string]int, error) {
+	var subnetIDs []string
+	paginator := ec2.NewDescribeNatGatewaysPaginator(&c.EC2, &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{{Name: aws.String("state"), Values: []string{string(ec2types.NatGatewayStatePending), string(ec2types.NatGatewayStateAvailable)}}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, natGateway := range page.NatGateways {
+			subnetIDs = append(subnetIDs, aws.ToString(natGateway.SubnetId))
+		}
+	}
+
+	counts := map[string]int{}
+	if len(subnetIDs) == 0 {
+		return counts, nil
+	}
+	zones := map[string]string{}
+	subnetPaginator := ec2.NewDescribeSubnetsPaginator(&c.EC2, &ec2.DescribeSubnetsInput{SubnetIds: sets.List(sets.New(subnetIDs...))})
+	for subnetPaginator.HasMorePages() {
+		page, err := subnetPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range page.Subnets {
+			zones[aws.ToString(subnet.SubnetId)] = aws.ToString(subnet.AvailabilityZone)
+		}
+	}
+	for _, subnetID := range subnetIDs {
+		counts[zones[subnetID]]++
+	}
+	return counts, nil
+}
+
+// GetInstanceTypeVCPUs returns the default number of vCPUs of the given instance types. Unknown instance types are
+// omitted.
+func (c *Client) GetInstanceTypeVCPUs(ctx context.Context, instanceTypes []string) (map[string]int, error) {
+	vcpus := map[string]int{}
+	for chunk := range slices.Chunk(instanceTypes, 100) {
+		input := &ec2.DescribeInstanceTypesInput{}
+		for _, instanceType := range chunk {
+			input.InstanceTypes = append(input.InstanceTypes, ec2types.InstanceType(instanceType))
+		}
+		paginator := ec2.NewDescribeInstanceTypesPaginator(&c.EC2, input)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, info := range page.InstanceTypes {
+				if info.VCpuInfo != nil {
+					vcpus[string(info.InstanceType)] = int(aws.ToInt32(info.VCpuInfo.DefaultVCpus))
+				}
+			}
+		}
+	}
+	return vcpus, nil
+}
+
+// GetRunningOnDemandVCPUs returns the number of vCPUs of the pending and running on-demand instances with the given
+// tags per instance type. All on-demand instances of the region are considered if no tags are given.
+func (c *Client) GetRunningOnDemandVCPUs(ctx context.Context, tags Tags) (map[string]int, error) {
+	filters := append(tags.ToFilters(), ec2types.Filter{
+		Name:   aws.String("instance-state-name"),
+		Values: []string{string(ec2types.InstanceStateNamePending), string(ec2types.InstanceStateNameRunning)},
+	})
+
+	vcpus := map[string]int{}
+	paginator := ec2.NewDescribeInstancesPaginator(&c.EC2, &ec2.DescribeInstancesInput{Filters: filters})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot || instance.CpuOptions == nil {
+					continue
+				}
+				vcpus[string(instance.InstanceType)] += int(aws.ToInt32(instance.CpuOptions.CoreCount) * aws.ToInt32(instance.CpuOptions.ThreadsPerCore))
+			}
+		}
+	}
+	return vcpus, nil
+}
+
 // GetFileSystem retrieve information about an efs file system by its ID
 // Returns nil if the file system is not found
 func (c *Client) GetFileSystem(ctx context.Context, fileSystemID string) (*efstypes.FileSystemDescription, error) {
@@ -2347,6 +3328,88 @@ func (c *Client) DeleteFileSystem(ctx context.Context, input *efs.DeleteFileSyst
 	return ignoreNotFound(err)
 }
 
+// UpdateFileSystem updates the throughput mode and the provisioned throughput of an efs file system
+func (c *Client) UpdateFileSystem(ctx context.Context, input *efs.UpdateFileSystemInput) error {
+	_, err := c.EFS.UpdateFileSystem(ctx, input)
+	return err
+}
+
+// DescribeLifecycleConfiguration describes the lifecycle policies of an efs file system
+func (c *Client) DescribeLifecycleConfiguration(ctx context.Context, input *efs.DescribeLifecycleConfigurationInput) ([]efstypes.LifecyclePolicy, error) {
+	output, err := c.EFS.DescribeLifecycleConfiguration(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return output.LifecyclePolicies, nil
+}
+
+// PutLifecycleConfiguration replaces the lifecycle policies of an efs file system
+func (c *Client) PutLifecycleConfiguration(ctx context.Context, input *efs.PutLifecycleConfigurationInput) error {
+	_, err := c.EFS.PutLifecycleConfiguration(ctx, input)
+	return err
+}
+
+// DescribeBackupPolicy describes the backup policy of an efs file system
+// Returns nil if the file system has no backup policy
+func (c *Client) DescribeBackupPolicy(ctx context.Context, input *efs.DescribeBackupPolicyInput) (*efstypes.BackupPolicy, error) {
+	output, err := c.EFS.DescribeBackupPolicy(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	return output.BackupPolicy, nil
+}
+
+// PutBackupPolicy updates the backup policy of an efs file system
+func (c *Client) PutBackupPolicy(ctx context.Context, input *efs.PutBackupPolicyInput) error {
+	_, err := c.EFS.PutBackupPolicy(ctx, input)
+	return err
+}
+
+// DescribeAccessPoints describes the access points of an efs file system or a single access point
+func (c *Client) DescribeAccessPoints(ctx context.Context, input *efs.DescribeAccessPointsInput) ([]efstypes.AccessPointDescription, error) {
+	var accessPoints []efstypes.AccessPointDescription
+	paginator := efs.NewDescribeAccessPointsPaginator(&c.EFS, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		accessPoints = append(accessPoints, page.AccessPoints...)
+	}
+	return accessPoints, nil
+}
+
+// CreateAccessPoint creates an efs access point and waits until it is available
+func (c *Client) CreateAccessPoint(ctx context.Context, input *efs.CreateAccessPointInput) (*efstypes.AccessPointDescription, error) {
+	output, err := c.EFS.CreateAccessPoint(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if output.AccessPointId == nil {
+		return nil, fmt.Errorf("efs access point creation failed, no AccessPointId returned")
+	}
+
+	var accessPoint *efstypes.AccessPointDescription
+	err = c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		accessPoints, err := c.DescribeAccessPoints(ctx, &efs.DescribeAccessPointsInput{AccessPointId: output.AccessPointId})
+		if err != nil {
+			return true, err
+		}
+		if len(accessPoints) == 0 {
+			return false, nil
+		}
+		accessPoint = &accessPoints[0]
+		return accessPoint.LifeCycleState == efstypes.LifeCycleStateAvailable, nil
+	})
+	return accessPoint, err
+}
+
+// DeleteAccessPoint deletes an efs access point
+func (c *Client) DeleteAccessPoint(ctx context.Context, input *efs.DeleteAccessPointInput) error {
+	_, err := c.EFS.DeleteAccessPoint(ctx, input)
+	return ignoreNotFound(err)
+}
+
 // DescribeMountTargetsEfs describes an efs mount target
 func (c *Client) DescribeMountTargetsEfs(ctx context.Context, input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error) {
 	output, err := c.EFS.DescribeMountTargets(ctx, input)
@@ -2372,6 +3435,92 @@ func (c *Client) DeleteMountTargetEfs(ctx context.Context, input *efs.DeleteMoun
 	return ignoreNotFound(err)
 }
 
+// TagResourceEfs adds or overwrites tags of an efs resource
+func (c *Client) TagResourceEfs(ctx context.Context, input *efs.TagResourceInput) error {
+	_, err := c.EFS.TagResource(ctx, input)
+	return err
+}
+
+// UntagResourceEfs removes tags from an efs resource
+func (c *Client) UntagResourceEfs(ctx context.Context, input *efs.UntagResourceInput) error {
+	_, err := c.EFS.UntagResource(ctx, input)
+	return err
+}
+
+// GetFSxFileSystem retrieves information about an FSx file system by its ID
+// Returns nil if the file system is not found
+func (c *Client) GetFSxFileSystem(ctx context.Context, fileSystemID string) (*fsxtypes.FileSystem, error) {
+	output, err := c.FSx.DescribeFileSystems(ctx, &fsx.DescribeFileSystemsInput{
+		FileSystemIds: []string{fileSystemID},
+	})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	if len(output.FileSystems) != 1 {
+		return nil, fmt.Errorf("expected 1 file system, got %d", len(output.FileSystems))
+	}
+	return &output.FileSystems[0], nil
+}
+
+// FindFSxFileSystemsByTags finds FSx file systems by tags
+func (c *Client) FindFSxFileSystemsByTags(ctx context.Context, tags Tags) ([]*fsxtypes.FileSystem, error) {
+	var result []*fsxtypes.FileSystem
+	paginator := fsx.NewDescribeFileSystemsPaginator(&c.FSx, &fsx.DescribeFileSystemsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fs := range page.FileSystems {
+			if fs.Lifecycle != fsxtypes.FileSystemLifecycleDeleting && tags.ContainFSxTags(fs.Tags) {
+				result = append(result, &fs)
+			}
+		}
+	}
+	return result, nil
+}
+
+// CreateFSxFileSystem creates an FSx file system
+// It does not wait until the file system is available, as this takes several minutes.
+func (c *Client) CreateFSxFileSystem(ctx context.Context, input *fsx.CreateFileSystemInput) (*fsxtypes.FileSystem, error) {
+	output, err := c.FSx.CreateFileSystem(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if output.FileSystem == nil || output.FileSystem.FileSystemId == nil {
+		return nil, fmt.Errorf("fsx file system creation failed, no FileSystemId returned")
+	}
+	return output.FileSystem, nil
+}
+
+// DeleteFSxFileSystem deletes an FSx file system without a final backup and waits until it is gone
+func (c *Client) DeleteFSxFileSystem(ctx context.Context, fileSystemID string) error {
+	fs, err := c.GetFSxFileSystem(ctx, fileSystemID)
+	if err != nil || fs == nil {
+		return err
+	}
+	if fs.Lifecycle != fsxtypes.FileSystemLifecycleDeleting {
+		input := &fsx.DeleteFileSystemInput{FileSystemId: &fileSystemID}
+		switch fs.FileSystemType {
+		case fsxtypes.FileSystemTypeLustre:
+			input.LustreConfiguration = &fsxtypes.DeleteFileSystemLustreConfiguration{SkipFinalBackup: aws.Bool(true)}
+		case fsxtypes.FileSystemTypeOpenzfs:
+			input.OpenZFSConfiguration = &fsxtypes.DeleteFileSystemOpenZFSConfiguration{SkipFinalBackup: aws.Bool(true)}
+		}
+		if _, err := c.FSx.DeleteFileSystem(ctx, input); ignoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		fs, err := c.GetFSxFileSystem(ctx, fileSystemID)
+		if err != nil {
+			return true, err
+		}
+		return fs == nil, nil
+	})
+}
+
 // CreateEC2Tags creates the tags for the given EC2 resource identifiers
 func (c *Client) CreateEC2Tags(ctx context.Context, resources []string, tags Tags) error {
 	input := &ec2.CreateTagsInput{
@@ -2432,6 +3581,31 @@ func IsNotFoundError(err error) bool {
 		return true
 	}
 
+	var efsAccessPointNotFound *efstypes.AccessPointNotFound
+	if errors.As(err, &efsAccessPointNotFound) {
+		return true
+	}
+
+	var efsPolicyNotFound *efstypes.PolicyNotFound
+	if errors.As(err, &efsPolicyNotFound) {
+		return true
+	}
+
+	var fsxNotFound *fsxtypes.FileSystemNotFound
+	if errors.As(err, &fsxNotFound) {
+		return true
+	}
+
+	var lbNotFound *elbv2types.LoadBalancerNotFoundException
+	if errors.As(err, &lbNotFound) {
+		return true
+	}
+
+	var tgNotFound *elbv2types.TargetGroupNotFoundException
+	if errors.As(err, &tgNotFound) {
+		return true
+	}
+
 	var apiError smithy.APIError
 	if errors.As(err, &apiError) {
 		if code := apiError.ErrorCode(); code == "NatGatewayNotFound" || strings.HasSuffix(code, ".NotFound") {
@@ -2586,11 +3760,12 @@ func fromSubnet(item *ec2types.Subnet) *Subnet {
 
 func fromAddress(item *ec2types.Address) *ElasticIP {
 	return &ElasticIP{
-		Tags:          FromTags(item.Tags),
-		Vpc:           item.Domain == ec2types.DomainTypeVpc,
-		AllocationId:  aws.ToString(item.AllocationId),
-		PublicIp:      aws.ToString(item.PublicIp),
-		AssociationID: item.AssociationId,
+		Tags:           FromTags(item.Tags),
+		Vpc:            item.Domain == ec2types.DomainTypeVpc,
+		AllocationId:   aws.ToString(item.AllocationId),
+		PublicIp:       aws.ToString(item.PublicIp),
+		AssociationID:  item.AssociationId,
+		PublicIPv4Pool: item.PublicIpv4Pool,
 	}
 }
 
@@ -2598,20 +3773,34 @@ func fromNatGateway(item *ec2types.NatGateway) *NATGateway {
 	if item.State == ec2types.NatGatewayStateDeleted {
 		return nil
 	}
-	var allocationId, publicIP string
-	for _, address := range item.NatGatewayAddresses {
-		allocationId = aws.ToString(address.AllocationId)
-		publicIP = aws.ToString(address.PublicIp)
-		break
+	var (
+		allocationId, publicIP string
+		secondaryAddresses     []*NATGatewayAddress
+	)
+	for i, address := range item.NatGatewayAddresses {
+		// the primary address is reported first by older API versions, which do not set IsPrimary
+		if aws.ToBool(address.IsPrimary) || (i == 0 && address.IsPrimary == nil) {
+			allocationId = aws.ToString(address.AllocationId)
+			publicIP = aws.ToString(address.PublicIp)
+			continue
+		}
+		secondaryAddresses = append(secondaryAddresses, &NATGatewayAddress{
+			AllocationId:  aws.ToString(address.AllocationId),
+			AssociationId: aws.ToString(address.AssociationId),
+			PublicIP:      aws.ToString(address.PublicIp),
+			PrivateIP:     aws.ToString(address.PrivateIp),
+			Status:        string(address.Status),
+		})
 	}
 	return &NATGateway{
-		Tags:            FromTags(item.Tags),
-		NATGatewayId:    aws.ToString(item.NatGatewayId),
-		EIPAllocationId: allocationId,
-		PublicIP:        publicIP,
-		SubnetId:        aws.ToString(item.SubnetId),
-		State:           string(item.State),
-		VpcId:           item.VpcId,
+		Tags:               FromTags(item.Tags),
+		NATGatewayId:       aws.ToString(item.NatGatewayId),
+		EIPAllocationId:    allocationId,
+		PublicIP:           publicIP,
+		SubnetId:           aws.ToString(item.SubnetId),
+		State:              string(item.State),
+		VpcId:              item.VpcId,
+		SecondaryAddresses: secondaryAddresses,
 	}
 }
 
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"slices"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+func cloneConfigurations(in map[string][]string) map[string][]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string][]string, len(in))
+	for k, v := range in {
+		out[k] = slices.Clone(v)
+	}
+	return out
+}
+
+func cloneDhcpOptions(in *awsclient.DhcpOptions) *awsclient.DhcpOptions {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.DhcpConfigurations = cloneConfigurations(in.DhcpConfigurations)
+	return &out
+}
+
+func cloneVPC(in *awsclient.VPC) *awsclient.VPC {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.Ipv6IpamPoolId = clonePtr(in.Ipv6IpamPoolId)
+	out.Ipv6NetmaskLength = clonePtr(in.Ipv6NetmaskLength)
+	out.DhcpOptionsId = clonePtr(in.DhcpOptionsId)
+	out.State = clonePtr(in.State)
+	return &out
+}
+
+func cloneSecurityGroup(in *awsclient.SecurityGroup) *awsclient.SecurityGroup {
+	out := in.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	out.Description = clonePtr(in.Description)
+	for i, rule := range in.Rules {
+		out.Rules[i] = rule.Clone()
+	}
+	return out
+}
+
+func cloneInternetGateway(in *awsclient.InternetGateway) *awsclient.InternetGateway {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	return &out
+}
+
+func cloneEgressOnlyInternetGateway(in *awsclient.EgressOnlyInternetGateway) *awsclient.EgressOnlyInternetGateway {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	return &out
+}
+
+func cloneFlowLog(in *awsclient.FlowLog) *awsclient.FlowLog {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.LogDestination = clonePtr(in.LogDestination)
+	out.LogGroupName = clonePtr(in.LogGroupName)
+	out.DeliverLogsPermissionArn = clonePtr(in.DeliverLogsPermissionArn)
+	out.LogFormat = clonePtr(in.LogFormat)
+	out.MaxAggregationInterval = clonePtr(in.MaxAggregationInterval)
+	return &out
+}
+
+func cloneNetworkAcl(in *awsclient.NetworkAcl) *awsclient.NetworkAcl {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	out.Entries = make([]*awsclient.NetworkAclEntry, len(in.Entries))
+	for i, entry := range in.Entries {
+		out.Entries[i] = cloneNetworkAclEntry(entry)
+	}
+	out.Associations = make([]*awsclient.NetworkAclAssociation, len(in.Associations))
+	for i, assoc := range in.Associations {
+		a := *assoc
+		out.Associations[i] = &a
+	}
+	return &out
+}
+
+func cloneNetworkAclEntry(in *awsclient.NetworkAclEntry) *awsclient.NetworkAclEntry {
+	out := *in
+	out.CidrBlock = clonePtr(in.CidrBlock)
+	out.Ipv6CidrBlock = clonePtr(in.Ipv6CidrBlock)
+	out.FromPort = clonePtr(in.FromPort)
+	out.ToPort = clonePtr(in.ToPort)
+	return &out
+}
+
+func cloneVpcEndpoint(in *awsclient.VpcEndpoint) *awsclient.VpcEndpoint {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	return &out
+}
+
+func cloneRoute(in *awsclient.Route) *awsclient.Route {
+	return &awsclient.Route{
+		DestinationCidrBlock:        clonePtr(in.DestinationCidrBlock),
+		DestinationIpv6CidrBlock:    clonePtr(in.DestinationIpv6CidrBlock),
+		GatewayId:                   clonePtr(in.GatewayId),
+		NatGatewayId:                clonePtr(in.NatGatewayId),
+		EgressOnlyInternetGatewayId: clonePtr(in.EgressOnlyInternetGatewayId),
+		DestinationPrefixListId:     clonePtr(in.DestinationPrefixListId),
+	}
+}
+
+func cloneRouteTable(in *awsclient.RouteTable) *awsclient.RouteTable {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	out.Routes = make([]*awsclient.Route, 0, len(in.Routes))
+	for _, route := range in.Routes {
+		out.Routes = append(out.Routes, cloneRoute(route))
+	}
+	out.Associations = make([]*awsclient.RouteTableAssociation, 0, len(in.Associations))
+	for _, assoc := range in.Associations {
+		cp := *assoc
+		cp.GatewayId = clonePtr(assoc.GatewayId)
+		cp.SubnetId = clonePtr(assoc.SubnetId)
+		out.Associations = append(out.Associations, &cp)
+	}
+	return &out
+}
+
+func cloneSubnet(in *awsclient.Subnet) *awsclient.Subnet {
+	out := in.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	out.AssignIpv6AddressOnCreation = clonePtr(in.AssignIpv6AddressOnCreation)
+	out.CustomerOwnedIpv4Pool = clonePtr(in.CustomerOwnedIpv4Pool)
+	out.EnableDns64 = clonePtr(in.EnableDns64)
+	out.EnableResourceNameDnsAAAARecordOnLaunch = clonePtr(in.EnableResourceNameDnsAAAARecordOnLaunch)
+	out.EnableResourceNameDnsARecordOnLaunch = clonePtr(in.EnableResourceNameDnsARecordOnLaunch)
+	out.Ipv6CidrBlocks = slices.Clone(in.Ipv6CidrBlocks)
+	out.Ipv6Native = clonePtr(in.Ipv6Native)
+	out.MapPublicIpOnLaunch = clonePtr(in.MapPublicIpOnLaunch)
+	out.MapCustomerOwnedIpOnLaunch = clonePtr(in.MapCustomerOwnedIpOnLaunch)
+	out.OutpostArn = clonePtr(in.OutpostArn)
+	out.PrivateDnsHostnameTypeOnLaunch = clonePtr(in.PrivateDnsHostnameTypeOnLaunch)
+	return out
+}
+
+func cloneNetworkInterface(in *awsclient.NetworkInterface) *awsclient.NetworkInterface {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.SecurityGroupIds = slices.Clone(in.SecurityGroupIds)
+	out.RequesterId = clonePtr(in.RequesterId)
+	out.InstanceId = clonePtr(in.InstanceId)
+	return &out
+}
+
+func cloneElasticIP(in *awsclient.ElasticIP) *awsclient.ElasticIP {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.AssociationID = clonePtr(in.AssociationID)
+	out.PublicIPv4Pool = clonePtr(in.PublicIPv4Pool)
+	out.IPAMPoolID = clonePtr(in.IPAMPoolID)
+	return &out
+}
+
+func cloneNATGateway(in *awsclient.NATGateway) *awsclient.NATGateway {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = clonePtr(in.VpcId)
+	out.SecondaryAddresses = nil
+	for _, address := range in.SecondaryAddresses {
+		out.SecondaryAddresses = append(out.SecondaryAddresses, clonePtr(address))
+	}
+	return &out
+}
+
+func cloneKeyPair(in *awsclient.KeyPairInfo) *awsclient.KeyPairInfo {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	return &out
+}
@@ -0,0 +1,2163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"crypto/md5" // #nosec G501 -- AWS uses MD5 fingerprints for imported key pairs
+	"fmt"
+	"maps"
+	"net"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// GetVPCInternetGateway returns the ID of the internet gateway attached to the given VPC.
+func (c *Client) GetVPCInternetGateway(_ context.Context, vpcID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range sortedKeys(c.internetGateways) {
+		if ptr.Deref(c.internetGateways[id].VpcId, "") == vpcID && c.visible(id) {
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+// GetVPCAttribute returns the value of the given VPC attribute.
+func (c *Client) GetVPCAttribute(_ context.Context, vpcID string, attribute ec2types.VpcAttributeName) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpc, ok := c.vpcs[vpcID]
+	if !ok {
+		return false, nil
+	}
+	switch attribute {
+	case ec2types.VpcAttributeNameEnableDnsSupport:
+		return vpc.EnableDnsSupport, nil
+	case ec2types.VpcAttributeNameEnableDnsHostnames:
+		return vpc.EnableDnsHostnames, nil
+	}
+	return false, apiError("InvalidParameterValue", "Value (%s) for parameter attribute is invalid", attribute)
+}
+
+// GetDHCPOptions returns the DHCP options associated with the given VPC.
+func (c *Client) GetDHCPOptions(_ context.Context, vpcID string) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpc, ok := c.vpcs[vpcID]
+	if !ok {
+		return nil, fmt.Errorf("could not find VPC %s", vpcID)
+	}
+	result := map[string]string{}
+	if options, ok := c.dhcpOptions[ptr.Deref(vpc.DhcpOptionsId, "")]; ok {
+		if values := options.DhcpConfigurations["domain-name"]; len(values) > 0 {
+			result["domain-name"] = values[0]
+		}
+	}
+	return result, nil
+}
+
+// GetElasticIPsAssociationIDForAllocationIDs returns the association IDs of the given elastic IPs.
+func (c *Client) GetElasticIPsAssociationIDForAllocationIDs(_ context.Context, allocationIDs []string) (map[string]*string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := map[string]*string{}
+	for _, id := range allocationIDs {
+		eip, ok := c.elasticIPs[id]
+		if !ok {
+			// AWS fails the complete request, which is ignored by the real client
+			return nil, nil
+		}
+		result[id] = clonePtr(eip.AssociationID)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// GetNATGatewayAddressAllocations returns the allocation IDs of the elastic IPs used by the NAT gateways of the shoot.
+func (c *Client) GetNATGatewayAddressAllocations(_ context.Context, shootNamespace string) (sets.Set[string], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := sets.New[string]()
+	tags := awsclient.Tags{fmt.Sprintf("kubernetes.io/cluster/%s", shootNamespace): "1"}
+	for _, nat := range c.natGateways {
+		if matchTags(tags, nat.Tags) {
+			result.Insert(nat.EIPAllocationId)
+			for _, address := range nat.SecondaryAddresses {
+				result.Insert(address.AllocationId)
+			}
+		}
+	}
+	return result, nil
+}
+
+// SetAddressPool sets the number of free addresses of a public IPv4 pool or IPAM pool. Pools which are not set do not
+// exist.
+func (c *Client) SetAddressPool(poolID string, free int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.addressPools[poolID] = free
+}
+
+// GetPublicIPv4PoolFreeAddressCount returns the number of free addresses set with SetAddressPool or nil.
+func (c *Client) GetPublicIPv4PoolFreeAddressCount(_ context.Context, poolID string) (*int, error) {
+	return c.getAddressPoolFreeCount(poolID), nil
+}
+
+// GetIPAMPoolFreeAddressCount returns the number of free addresses set with SetAddressPool or nil.
+func (c *Client) GetIPAMPoolFreeAddressCount(_ context.Context, poolID string) (*int, error) {
+	return c.getAddressPoolFreeCount(poolID), nil
+}
+
+func (c *Client) getAddressPoolFreeCount(poolID string) *int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if free, ok := c.addressPools[poolID]; ok {
+		return ptr.To(free)
+	}
+	return nil
+}
+
+// CreateVpcDhcpOptions creates DHCP options.
+func (c *Client) CreateVpcDhcpOptions(_ context.Context, options *awsclient.DhcpOptions) (*awsclient.DhcpOptions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	created := &awsclient.DhcpOptions{
+		Tags:               options.Tags.Clone(),
+		DhcpOptionsId:      c.newID("dopt"),
+		DhcpConfigurations: cloneConfigurations(options.DhcpConfigurations),
+	}
+	c.dhcpOptions[created.DhcpOptionsId] = created
+	c.created(created.DhcpOptionsId)
+	return cloneDhcpOptions(created), nil
+}
+
+// GetVpcDhcpOptions returns the DHCP options with the given ID or nil if not found.
+func (c *Client) GetVpcDhcpOptions(_ context.Context, id string) (*awsclient.DhcpOptions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if options, ok := c.dhcpOptions[id]; ok && c.visible(id) {
+		return cloneDhcpOptions(options), nil
+	}
+	return nil, nil
+}
+
+// FindVpcDhcpOptionsByTags returns all DHCP options with the given tags.
+func (c *Client) FindVpcDhcpOptionsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.DhcpOptions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.DhcpOptions
+	for _, id := range sortedKeys(c.dhcpOptions) {
+		if options := c.dhcpOptions[id]; matchTags(tags, options.Tags) && c.visible(id) {
+			result = append(result, cloneDhcpOptions(options))
+		}
+	}
+	return result, nil
+}
+
+// DeleteVpcDhcpOptions deletes the DHCP options. It fails if they are still associated with a VPC.
+func (c *Client) DeleteVpcDhcpOptions(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.dhcpOptions[id]; !ok {
+		return nil
+	}
+	for _, vpc := range c.vpcs {
+		if ptr.Deref(vpc.DhcpOptionsId, "") == id {
+			return dependencyViolation("The dhcpOptions '%s' has dependencies and cannot be deleted.", id)
+		}
+	}
+	delete(c.dhcpOptions, id)
+	return nil
+}
+
+// CreateVpc creates a VPC together with its default security group, main route table and default network ACL.
+func (c *Client) CreateVpc(_ context.Context, desired *awsclient.VPC) (*awsclient.VPC, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, _, err := net.ParseCIDR(desired.CidrBlock); err != nil {
+		return nil, apiError("InvalidParameterValue", "Value (%s) for parameter cidrBlock is invalid.", desired.CidrBlock)
+	}
+	vpc := &awsclient.VPC{
+		Tags:             desired.Tags.Clone(),
+		VpcId:            c.newID("vpc"),
+		CidrBlock:        desired.CidrBlock,
+		EnableDnsSupport: true,
+		DhcpOptionsId:    ptr.To("default"),
+		InstanceTenancy:  desired.InstanceTenancy,
+		State:            ptr.To(string(ec2types.VpcStateAvailable)),
+	}
+	if vpc.InstanceTenancy == "" {
+		vpc.InstanceTenancy = ec2types.TenancyDefault
+	}
+	if desired.AssignGeneratedIPv6CidrBlock || desired.Ipv6IpamPoolId != nil {
+		vpc.IPv6CidrBlock = c.newIPv6CidrBlock()
+	}
+	c.vpcs[vpc.VpcId] = vpc
+
+	sg := &awsclient.SecurityGroup{
+		Tags:        awsclient.Tags{},
+		GroupId:     c.newID("sg"),
+		GroupName:   "default",
+		VpcId:       ptr.To(vpc.VpcId),
+		Description: ptr.To("default VPC security group"),
+		Rules: []*awsclient.SecurityGroupRule{
+			{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "-1", Self: true},
+			defaultEgressRule(),
+		},
+		OwnerId: c.accountID,
+	}
+	c.securityGroups[sg.GroupId] = sg
+
+	rt := &awsclient.RouteTable{
+		Tags:         awsclient.Tags{},
+		RouteTableId: c.newID("rtb"),
+		VpcId:        ptr.To(vpc.VpcId),
+		Routes:       localRoutes(vpc),
+		Associations: []*awsclient.RouteTableAssociation{
+			{RouteTableAssociationId: c.newID("rtbassoc"), Main: true},
+		},
+	}
+	c.routeTables[rt.RouteTableId] = rt
+
+	acl := &awsclient.NetworkAcl{
+		Tags:         awsclient.Tags{},
+		NetworkAclId: c.newID("acl"),
+		VpcId:        ptr.To(vpc.VpcId),
+		IsDefault:    true,
+		Entries: []*awsclient.NetworkAclEntry{
+			{RuleNumber: 100, Protocol: "-1", RuleAction: "allow", CidrBlock: ptr.To("0.0.0.0/0")},
+			{RuleNumber: 100, Egress: true, Protocol: "-1", RuleAction: "allow", CidrBlock: ptr.To("0.0.0.0/0")},
+		},
+	}
+	acl.Entries = append(acl.Entries, defaultNetworkAclEntries()...)
+	c.networkAcls[acl.NetworkAclId] = acl
+
+	c.created(vpc.VpcId)
+	return cloneVPC(vpc), nil
+}
+
+// GetIPv6Cidr returns the IPv6 CIDR block of the VPC.
+func (c *Client) GetIPv6Cidr(_ context.Context, vpcID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpc, ok := c.vpcs[vpcID]
+	if !ok {
+		return "", fmt.Errorf("error describing VPC: %v", notFoundError(vpcID))
+	}
+	if vpc.IPv6CidrBlock == "" {
+		return "", &awsclient.RetryableIPv6CIDRError{}
+	}
+	return vpc.IPv6CidrBlock, nil
+}
+
+// WaitForIPv6Cidr returns the IPv6 CIDR block of the VPC. The fake assigns IPv6 CIDR blocks immediately, so there
+// is nothing to wait for.
+func (c *Client) WaitForIPv6Cidr(ctx context.Context, vpcID string) (string, error) {
+	cidr, err := c.GetIPv6Cidr(ctx, vpcID)
+	if awsclient.IsRetryableIPv6CIDRError(err) {
+		return "", fmt.Errorf("no IPv6 CIDR Block was assigned to VPC")
+	}
+	return cidr, err
+}
+
+// AddVpcDhcpOptionAssociation associates the DHCP options with the VPC. If dhcpOptionsId is nil, the default DHCP
+// options are associated.
+func (c *Client) AddVpcDhcpOptionAssociation(vpcId string, dhcpOptionsId *string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := ptr.Deref(dhcpOptionsId, "default")
+	vpc, ok := c.vpcs[vpcId]
+	if !ok {
+		return notFoundError(vpcId)
+	}
+	if _, ok := c.dhcpOptions[id]; !ok && id != "default" {
+		return notFoundError(id)
+	}
+	vpc.DhcpOptionsId = ptr.To(id)
+	return nil
+}
+
+// UpdateVpcAttribute sets the given VPC attribute.
+func (c *Client) UpdateVpcAttribute(_ context.Context, vpcId, attributeName string, value bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpc, ok := c.vpcs[vpcId]
+	if !ok {
+		return notFoundError(vpcId)
+	}
+	switch attributeName {
+	case string(ec2types.VpcAttributeNameEnableDnsSupport):
+		vpc.EnableDnsSupport = value
+	case string(ec2types.VpcAttributeNameEnableDnsHostnames):
+		vpc.EnableDnsHostnames = value
+	default:
+		return fmt.Errorf("unknown attribute name: %s", attributeName)
+	}
+	return nil
+}
+
+// UpdateAmazonProvidedIPv6CidrBlock assigns an Amazon provided IPv6 CIDR block to the VPC if requested and not
+// assigned yet.
+func (c *Client) UpdateAmazonProvidedIPv6CidrBlock(_ context.Context, desired *awsclient.VPC, current *awsclient.VPC) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if current.VpcId == "" || desired.AssignGeneratedIPv6CidrBlock == current.AssignGeneratedIPv6CidrBlock {
+		return false, nil
+	}
+	vpc, ok := c.vpcs[current.VpcId]
+	if !ok {
+		return false, notFoundError(current.VpcId)
+	}
+	if vpc.IPv6CidrBlock != "" || !desired.AssignGeneratedIPv6CidrBlock {
+		return false, nil
+	}
+	vpc.IPv6CidrBlock = c.newIPv6CidrBlock()
+	for _, rt := range c.routeTables {
+		if ptr.Deref(rt.VpcId, "") == vpc.VpcId {
+			rt.Routes = append(rt.Routes, &awsclient.Route{DestinationIpv6CidrBlock: ptr.To(vpc.IPv6CidrBlock), GatewayId: ptr.To("local")})
+		}
+	}
+	return true, nil
+}
+
+// DeleteVpc deletes the VPC. It fails with a dependency violation if the VPC still contains resources.
+func (c *Client) DeleteVpc(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.vpcs[id]; !ok {
+		return nil
+	}
+	if deps := c.vpcDependencies(id); len(deps) > 0 {
+		return dependencyViolation("The vpc '%s' has dependencies and cannot be deleted: %s", id, strings.Join(deps, ", "))
+	}
+	for sgID, sg := range c.securityGroups {
+		if ptr.Deref(sg.VpcId, "") == id {
+			delete(c.securityGroups, sgID)
+		}
+	}
+	for rtID, rt := range c.routeTables {
+		if ptr.Deref(rt.VpcId, "") == id {
+			delete(c.routeTables, rtID)
+		}
+	}
+	for aclID, acl := range c.networkAcls {
+		if ptr.Deref(acl.VpcId, "") == id {
+			delete(c.networkAcls, aclID)
+		}
+	}
+	delete(c.vpcs, id)
+	return nil
+}
+
+func (c *Client) vpcDependencies(vpcID string) []string {
+	var deps []string
+	for id, subnet := range c.subnets {
+		if ptr.Deref(subnet.VpcId, "") == vpcID {
+			deps = append(deps, id)
+		}
+	}
+	for id, igw := range c.internetGateways {
+		if ptr.Deref(igw.VpcId, "") == vpcID {
+			deps = append(deps, id)
+		}
+	}
+	for id, eigw := range c.egressOnlyIGWs {
+		if ptr.Deref(eigw.VpcId, "") == vpcID {
+			deps = append(deps, id)
+		}
+	}
+	for id, sg := range c.securityGroups {
+		if ptr.Deref(sg.VpcId, "") == vpcID && sg.GroupName != "default" {
+			deps = append(deps, id)
+		}
+	}
+	for id, rt := range c.routeTables {
+		if ptr.Deref(rt.VpcId, "") == vpcID && !isMainRouteTable(rt) {
+			deps = append(deps, id)
+		}
+	}
+	for id, endpoint := range c.vpcEndpoints {
+		if ptr.Deref(endpoint.VpcId, "") == vpcID {
+			deps = append(deps, id)
+		}
+	}
+	for id, acl := range c.networkAcls {
+		if ptr.Deref(acl.VpcId, "") == vpcID && !acl.IsDefault {
+			deps = append(deps, id)
+		}
+	}
+	for name, lb := range c.loadBalancers {
+		if lb.VpcID == vpcID {
+			deps = append(deps, name)
+		}
+	}
+	slices.Sort(deps)
+	return deps
+}
+
+// GetVpc returns the VPC with the given ID or nil if not found.
+func (c *Client) GetVpc(_ context.Context, id string) (*awsclient.VPC, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if vpc, ok := c.vpcs[id]; ok && c.visible(id) {
+		return cloneVPC(vpc), nil
+	}
+	return nil, nil
+}
+
+// FindVpcsByTags returns all VPCs with the given tags.
+func (c *Client) FindVpcsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.VPC, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.VPC
+	for _, id := range sortedKeys(c.vpcs) {
+		if vpc := c.vpcs[id]; matchTags(tags, vpc.Tags) && c.visible(id) {
+			result = append(result, cloneVPC(vpc))
+		}
+	}
+	return result, nil
+}
+
+// CreateSecurityGroup creates a security group. Like AWS, the rules of the given group are ignored and the new
+// group only allows all egress traffic.
+func (c *Client) CreateSecurityGroup(_ context.Context, sg *awsclient.SecurityGroup) (*awsclient.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpcID := ptr.Deref(sg.VpcId, "")
+	if _, ok := c.vpcs[vpcID]; !ok {
+		return nil, notFoundError(vpcID)
+	}
+	for _, item := range c.securityGroups {
+		if ptr.Deref(item.VpcId, "") == vpcID && item.GroupName == sg.GroupName {
+			return nil, apiError("InvalidGroup.Duplicate", "The security group '%s' already exists for VPC '%s'", sg.GroupName, vpcID)
+		}
+	}
+	created := &awsclient.SecurityGroup{
+		Tags:        sg.Tags.Clone(),
+		GroupId:     c.newID("sg"),
+		GroupName:   sg.GroupName,
+		VpcId:       ptr.To(vpcID),
+		Description: clonePtr(sg.Description),
+		Rules:       []*awsclient.SecurityGroupRule{defaultEgressRule()},
+		OwnerId:     c.accountID,
+	}
+	c.securityGroups[created.GroupId] = created
+	c.created(created.GroupId)
+	return cloneSecurityGroup(created), nil
+}
+
+// GetSecurityGroup returns the security group with the given ID or nil if not found.
+func (c *Client) GetSecurityGroup(_ context.Context, id string) (*awsclient.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if sg, ok := c.securityGroups[id]; ok && c.visible(id) {
+		return cloneSecurityGroup(sg), nil
+	}
+	return nil, nil
+}
+
+// FindSecurityGroupsByTags returns all security groups with the given tags.
+func (c *Client) FindSecurityGroupsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.SecurityGroup
+	for _, id := range sortedKeys(c.securityGroups) {
+		if sg := c.securityGroups[id]; matchTags(tags, sg.Tags) && c.visible(id) {
+			result = append(result, cloneSecurityGroup(sg))
+		}
+	}
+	return result, nil
+}
+
+// FindSecurityGroups returns the security groups matching the filters.
+func (c *Client) FindSecurityGroups(_ context.Context, filters []ec2types.Filter) ([]*awsclient.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.SecurityGroup
+	for _, id := range sortedKeys(c.securityGroups) {
+		sg := c.securityGroups[id]
+		var ingressGroups, egressGroups []string
+		for _, rule := range sg.Rules {
+			if rule.Foreign == nil {
+				continue
+			}
+			if rule.Type == awsclient.SecurityGroupRuleTypeEgress {
+				egressGroups = append(egressGroups, *rule.Foreign)
+			} else {
+				ingressGroups = append(ingressGroups, *rule.Foreign)
+			}
+		}
+		ok, err := matchFilters(filters, sg.Tags, attributes{
+			"vpc-id":                        {ptr.Deref(sg.VpcId, "")},
+			"group-id":                      {id},
+			"group-name":                    {sg.GroupName},
+			"ip-permission.group-id":        ingressGroups,
+			"egress.ip-permission.group-id": egressGroups,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok && c.visible(id) {
+			result = append(result, cloneSecurityGroup(sg))
+		}
+	}
+	return result, nil
+}
+
+// FindDefaultSecurityGroupByVpcId returns the default security group of the VPC or nil if not found.
+func (c *Client) FindDefaultSecurityGroupByVpcId(_ context.Context, vpcId string) (*awsclient.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range sortedKeys(c.securityGroups) {
+		if sg := c.securityGroups[id]; ptr.Deref(sg.VpcId, "") == vpcId && sg.GroupName == "default" && c.visible(id) {
+			return cloneSecurityGroup(sg), nil
+		}
+	}
+	return nil, nil
+}
+
+// AuthorizeSecurityGroupRules adds the rules to the security group.
+func (c *Client) AuthorizeSecurityGroupRules(_ context.Context, id string, rules []*awsclient.SecurityGroupRule) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sg, ok := c.securityGroups[id]
+	if !ok {
+		return notFoundError(id)
+	}
+	for _, rule := range rules {
+		if slices.IndexFunc(sg.Rules, equivalentRule(rule)) >= 0 {
+			return apiError("InvalidPermission.Duplicate", "the specified rule already exists in security group %s", id)
+		}
+		sg.Rules = append(sg.Rules, rule.Clone())
+	}
+	return nil
+}
+
+// RevokeSecurityGroupRules removes the rules from the security group.
+func (c *Client) RevokeSecurityGroupRules(_ context.Context, id string, rules []*awsclient.SecurityGroupRule) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sg, ok := c.securityGroups[id]
+	if !ok {
+		return notFoundError(id)
+	}
+	for _, rule := range rules {
+		idx := slices.IndexFunc(sg.Rules, equivalentRule(rule))
+		if idx < 0 {
+			return apiError("InvalidPermission.NotFound", "the specified rule does not exist in security group %s", id)
+		}
+		sg.Rules = slices.Delete(sg.Rules, idx, idx+1)
+	}
+	return nil
+}
+
+// DeleteSecurityGroup deletes the security group. Default security groups and groups still used by EFS mount
+// targets cannot be deleted.
+func (c *Client) DeleteSecurityGroup(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sg, ok := c.securityGroups[id]
+	if !ok {
+		return nil
+	}
+	if sg.GroupName == "default" {
+		return apiError("CannotDelete", "the specified group: \"%s\" name: \"default\" cannot be deleted by a user", id)
+	}
+	for _, mt := range c.mountTargets {
+		if slices.Contains(mt.securityGroups, id) {
+			return dependencyViolation("resource %s has a dependent object", id)
+		}
+	}
+	if c.fsxFileSystemUses(id) {
+		return dependencyViolation("resource %s has a dependent object", id)
+	}
+	for _, eni := range c.interfaces {
+		if slices.Contains(eni.SecurityGroupIds, id) {
+			return dependencyViolation("resource %s has a dependent object", id)
+		}
+	}
+	for otherID, other := range c.securityGroups {
+		if otherID != id && slices.ContainsFunc(other.Rules, func(rule *awsclient.SecurityGroupRule) bool {
+			return ptr.Deref(rule.Foreign, "") == id
+		}) {
+			return dependencyViolation("resource %s has a dependent object", id)
+		}
+	}
+	delete(c.securityGroups, id)
+	return nil
+}
+
+// CreateInternetGateway creates an internet gateway. It is not attached to a VPC.
+func (c *Client) CreateInternetGateway(_ context.Context, gateway *awsclient.InternetGateway) (*awsclient.InternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	created := &awsclient.InternetGateway{
+		Tags:              gateway.Tags.Clone(),
+		InternetGatewayId: c.newID("igw"),
+	}
+	c.internetGateways[created.InternetGatewayId] = created
+	c.created(created.InternetGatewayId)
+	return cloneInternetGateway(created), nil
+}
+
+// GetInternetGateway returns the internet gateway with the given ID or nil if not found.
+func (c *Client) GetInternetGateway(_ context.Context, id string) (*awsclient.InternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if igw, ok := c.internetGateways[id]; ok && c.visible(id) {
+		return cloneInternetGateway(igw), nil
+	}
+	return nil, nil
+}
+
+// FindInternetGatewaysByTags returns all internet gateways with the given tags.
+func (c *Client) FindInternetGatewaysByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.InternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.InternetGateway
+	for _, id := range sortedKeys(c.internetGateways) {
+		if igw := c.internetGateways[id]; matchTags(tags, igw.Tags) && c.visible(id) {
+			result = append(result, cloneInternetGateway(igw))
+		}
+	}
+	return result, nil
+}
+
+// FindInternetGatewayByVPC returns the internet gateway attached to the VPC or nil if not found.
+func (c *Client) FindInternetGatewayByVPC(_ context.Context, vpcId string) (*awsclient.InternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range sortedKeys(c.internetGateways) {
+		if igw := c.internetGateways[id]; ptr.Deref(igw.VpcId, "") == vpcId && c.visible(id) {
+			return cloneInternetGateway(igw), nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteInternetGateway deletes the internet gateway. It must be detached before.
+func (c *Client) DeleteInternetGateway(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	igw, ok := c.internetGateways[id]
+	if !ok {
+		return nil
+	}
+	if igw.VpcId != nil {
+		return dependencyViolation("The internetGateway '%s' has dependencies and cannot be deleted.", id)
+	}
+	delete(c.internetGateways, id)
+	return nil
+}
+
+// AttachInternetGateway attaches the internet gateway to the VPC. Attaching it again to the same VPC is a no-op.
+func (c *Client) AttachInternetGateway(_ context.Context, vpcId, internetGatewayId string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	igw, ok := c.internetGateways[internetGatewayId]
+	if !ok {
+		return notFoundError(internetGatewayId)
+	}
+	if _, ok := c.vpcs[vpcId]; !ok {
+		return notFoundError(vpcId)
+	}
+	switch ptr.Deref(igw.VpcId, "") {
+	case vpcId:
+		return nil
+	case "":
+		igw.VpcId = ptr.To(vpcId)
+		return nil
+	}
+	return apiError("Resource.AlreadyAssociated", "resource %s is already attached to network %s", internetGatewayId, *igw.VpcId)
+}
+
+// DetachInternetGateway detaches the internet gateway from the VPC.
+func (c *Client) DetachInternetGateway(_ context.Context, vpcId, internetGatewayId string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	igw, ok := c.internetGateways[internetGatewayId]
+	if !ok {
+		return notFoundError(internetGatewayId)
+	}
+	if ptr.Deref(igw.VpcId, "") != vpcId {
+		return apiError("Gateway.NotAttached", "resource %s is not attached to network %s", internetGatewayId, vpcId)
+	}
+	for _, nat := range c.natGateways {
+		if ptr.Deref(nat.VpcId, "") == vpcId {
+			return dependencyViolation("Network %s has some mapped public address(es). Please unmap those public address(es) before detaching the gateway.", vpcId)
+		}
+	}
+	igw.VpcId = nil
+	return nil
+}
+
+// CreateVpcEndpoint creates a VPC endpoint.
+func (c *Client) CreateVpcEndpoint(_ context.Context, endpoint *awsclient.VpcEndpoint) (*awsclient.VpcEndpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpcID := ptr.Deref(endpoint.VpcId, "")
+	if _, ok := c.vpcs[vpcID]; !ok {
+		return nil, notFoundError(vpcID)
+	}
+	created := &awsclient.VpcEndpoint{
+		Tags:          endpoint.Tags.Clone(),
+		VpcEndpointId: c.newID("vpce"),
+		VpcId:         ptr.To(vpcID),
+		ServiceName:   endpoint.ServiceName,
+		IpAddressType: endpoint.IpAddressType,
+	}
+	if created.IpAddressType == "" {
+		created.IpAddressType = string(ec2types.IpAddressTypeIpv4)
+	}
+	c.vpcEndpoints[created.VpcEndpointId] = created
+	c.created(created.VpcEndpointId)
+	return cloneVpcEndpoint(created), nil
+}
+
+// GetVpcEndpoints returns the VPC endpoints with the given IDs. Non-existing IDs are ignored.
+func (c *Client) GetVpcEndpoints(_ context.Context, ids []string) ([]*awsclient.VpcEndpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.VpcEndpoint
+	for _, id := range ids {
+		if endpoint, ok := c.vpcEndpoints[id]; ok && c.visible(id) {
+			result = append(result, cloneVpcEndpoint(endpoint))
+		}
+	}
+	return result, nil
+}
+
+// FindVpcEndpoints returns all VPC endpoints matching the filters.
+func (c *Client) FindVpcEndpoints(_ context.Context, filters []ec2types.Filter) ([]*awsclient.VpcEndpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.VpcEndpoint
+	for _, id := range sortedKeys(c.vpcEndpoints) {
+		endpoint := c.vpcEndpoints[id]
+		ok, err := matchFilters(filters, endpoint.Tags, attributes{
+			"vpc-id":             {ptr.Deref(endpoint.VpcId, "")},
+			"vpc-endpoint-id":    {id},
+			"service-name":       {endpoint.ServiceName},
+			"vpc-endpoint-state": {"available"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok && c.visible(id) {
+			result = append(result, cloneVpcEndpoint(endpoint))
+		}
+	}
+	return result, nil
+}
+
+// DeleteVpcEndpoint deletes the VPC endpoint and its routes.
+func (c *Client) DeleteVpcEndpoint(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.vpcEndpoints[id]; !ok {
+		return nil
+	}
+	for _, rt := range c.routeTables {
+		rt.Routes = slices.DeleteFunc(rt.Routes, func(route *awsclient.Route) bool {
+			return ptr.Deref(route.GatewayId, "") == id
+		})
+	}
+	delete(c.vpcEndpoints, id)
+	return nil
+}
+
+// UpdateVpcEndpointIpAddressType sets the IP address type of the VPC endpoint.
+func (c *Client) UpdateVpcEndpointIpAddressType(_ context.Context, id string, ipAddressType string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if endpoint, ok := c.vpcEndpoints[id]; ok {
+		endpoint.IpAddressType = ipAddressType
+	}
+	return nil
+}
+
+// CreateVpcEndpointRouteTableAssociation adds a route for the prefix list of the VPC endpoint to the route table.
+func (c *Client) CreateVpcEndpointRouteTableAssociation(_ context.Context, routeTableId, vpcEndpointId string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rt, ok := c.routeTables[routeTableId]
+	if !ok {
+		return notFoundError(routeTableId)
+	}
+	endpoint, ok := c.vpcEndpoints[vpcEndpointId]
+	if !ok {
+		return notFoundError(vpcEndpointId)
+	}
+	for _, route := range rt.Routes {
+		if ptr.Deref(route.GatewayId, "") == vpcEndpointId {
+			return nil
+		}
+	}
+	rt.Routes = append(rt.Routes, &awsclient.Route{
+		DestinationPrefixListId: ptr.To(prefixListID(endpoint.ServiceName)),
+		GatewayId:               ptr.To(vpcEndpointId),
+	})
+	return nil
+}
+
+// DeleteVpcEndpointRouteTableAssociation removes the route of the VPC endpoint from the route table.
+func (c *Client) DeleteVpcEndpointRouteTableAssociation(_ context.Context, routeTableId, vpcEndpointId string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rt, ok := c.routeTables[routeTableId]
+	if !ok {
+		return notFoundError(routeTableId)
+	}
+	rt.Routes = slices.DeleteFunc(rt.Routes, func(route *awsclient.Route) bool {
+		return ptr.Deref(route.GatewayId, "") == vpcEndpointId
+	})
+	return nil
+}
+
+// CreateRouteTable creates a route table. Like AWS, the routes of the given table are ignored and only the local
+// routes are created.
+func (c *Client) CreateRouteTable(_ context.Context, routeTable *awsclient.RouteTable) (*awsclient.RouteTable, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpcID := ptr.Deref(routeTable.VpcId, "")
+	vpc, ok := c.vpcs[vpcID]
+	if !ok {
+		return nil, notFoundError(vpcID)
+	}
+	created := &awsclient.RouteTable{
+		Tags:         routeTable.Tags.Clone(),
+		RouteTableId: c.newID("rtb"),
+		VpcId:        ptr.To(vpcID),
+		Routes:       localRoutes(vpc),
+	}
+	c.routeTables[created.RouteTableId] = created
+	c.created(created.RouteTableId)
+	return cloneRouteTable(created), nil
+}
+
+// GetRouteTable returns the route table with the given ID or nil if not found.
+func (c *Client) GetRouteTable(_ context.Context, id string) (*awsclient.RouteTable, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if rt, ok := c.routeTables[id]; ok && c.visible(id) {
+		return cloneRouteTable(rt), nil
+	}
+	return nil, nil
+}
+
+// FindRouteTablesByTags returns all route tables with the given tags.
+func (c *Client) FindRouteTablesByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.RouteTable, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.RouteTable
+	for _, id := range sortedKeys(c.routeTables) {
+		if rt := c.routeTables[id]; matchTags(tags, rt.Tags) && c.visible(id) {
+			result = append(result, cloneRouteTable(rt))
+		}
+	}
+	return result, nil
+}
+
+// DeleteRouteTable deletes the route table. Main route tables and route tables with subnet associations cannot be
+// deleted.
+func (c *Client) DeleteRouteTable(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rt, ok := c.routeTables[id]
+	if !ok {
+		return nil
+	}
+	if len(rt.Associations) > 0 {
+		return dependencyViolation("The routeTable '%s' has dependencies and cannot be deleted.", id)
+	}
+	delete(c.routeTables, id)
+	return nil
+}
+
+// CreateRoute adds the route to the route table.
+func (c *Client) CreateRoute(_ context.Context, routeTableId string, route *awsclient.Route) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rt, ok := c.routeTables[routeTableId]
+	if !ok {
+		return notFoundError(routeTableId)
+	}
+	destination, err := route.DestinationId()
+	if err != nil {
+		return apiError("MissingParameter", "The request must contain a destination")
+	}
+	if slices.ContainsFunc(rt.Routes, sameDestination(destination)) {
+		return apiError("RouteAlreadyExists", "The route identified by %s already exists.", destination)
+	}
+	if err := c.validateRouteTarget(rt, route); err != nil {
+		return err
+	}
+	rt.Routes = append(rt.Routes, cloneRoute(route))
+	return nil
+}
+
+func (c *Client) validateRouteTarget(rt *awsclient.RouteTable, route *awsclient.Route) error {
+	vpcID := ptr.Deref(rt.VpcId, "")
+	switch {
+	case route.NatGatewayId != nil:
+		nat, ok := c.natGateways[*route.NatGatewayId]
+		if !ok {
+			return notFoundError(*route.NatGatewayId)
+		}
+		if ptr.Deref(nat.VpcId, "") != vpcID {
+			return apiError("InvalidParameterValue", "route table %s and network gateway %s belong to different networks", rt.RouteTableId, nat.NATGatewayId)
+		}
+	case route.EgressOnlyInternetGatewayId != nil:
+		eigw, ok := c.egressOnlyIGWs[*route.EgressOnlyInternetGatewayId]
+		if !ok {
+			return notFoundError(*route.EgressOnlyInternetGatewayId)
+		}
+		if ptr.Deref(eigw.VpcId, "") != vpcID {
+			return apiError("InvalidParameterValue", "route table %s and network gateway %s belong to different networks", rt.RouteTableId, eigw.EgressOnlyInternetGatewayId)
+		}
+	case route.GatewayId != nil:
+		id := *route.GatewayId
+		if igw, ok := c.internetGateways[id]; ok {
+			if ptr.Deref(igw.VpcId, "") != vpcID {
+				return apiError("InvalidParameterValue", "route table %s and network gateway %s belong to different networks", rt.RouteTableId, id)
+			}
+			return nil
+		}
+		if _, ok := c.vpcEndpoints[id]; ok {
+			return nil
+		}
+		return notFoundError(id)
+	default:
+		return apiError("MissingParameter", "The request must contain a route target")
+	}
+	return nil
+}
+
+// DeleteRoute removes the route with the same destination from the route table.
+func (c *Client) DeleteRoute(_ context.Context, routeTableId string, route *awsclient.Route) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rt, ok := c.routeTables[routeTableId]
+	if !ok {
+		return notFoundError(routeTableId)
+	}
+	destination, err := route.DestinationId()
+	if err != nil {
+		return apiError("MissingParameter", "The request must contain a destination")
+	}
+	idx := slices.IndexFunc(rt.Routes, sameDestination(destination))
+	if idx < 0 {
+		return apiError("InvalidRoute.NotFound", "no route with destination %s in route table %s", destination, routeTableId)
+	}
+	if ptr.Deref(rt.Routes[idx].GatewayId, "") == "local" {
+		return apiError("InvalidParameterValue", "cannot remove local route %s in route table %s", destination, routeTableId)
+	}
+	rt.Routes = slices.Delete(rt.Routes, idx, idx+1)
+	return nil
+}
+
+// CreateSubnet creates a subnet and associates it with the default network ACL of the VPC. The CIDR blocks must be
+// part of the VPC CIDR blocks and must not overlap with other subnets of the VPC.
+func (c *Client) CreateSubnet(_ context.Context, subnet *awsclient.Subnet, _ time.Duration) (*awsclient.Subnet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpcID := ptr.Deref(subnet.VpcId, "")
+	vpc, ok := c.vpcs[vpcID]
+	if !ok {
+		return nil, notFoundError(vpcID)
+	}
+	created := &awsclient.Subnet{
+		Tags:             subnet.Tags.Clone(),
+		SubnetId:         c.newID("subnet"),
+		VpcId:            ptr.To(vpcID),
+		AvailabilityZone: subnet.AvailabilityZone,
+		Ipv6Native:       trueOrNil(subnet.Ipv6Native),
+	}
+	if subnet.CidrBlock != "" && !ptr.Deref(subnet.Ipv6Native, false) {
+		if err := c.validateSubnetCidr(vpcID, vpc.CidrBlock, subnet.CidrBlock, func(s *awsclient.Subnet) string { return s.CidrBlock }); err != nil {
+			return nil, err
+		}
+		created.CidrBlock = subnet.CidrBlock
+	}
+	if len(subnet.Ipv6CidrBlocks) > 0 && subnet.Ipv6CidrBlocks[0] != "" {
+		if err := c.validateSubnetCidr(vpcID, vpc.IPv6CidrBlock, subnet.Ipv6CidrBlocks[0], func(s *awsclient.Subnet) string {
+			if len(s.Ipv6CidrBlocks) == 0 {
+				return ""
+			}
+			return s.Ipv6CidrBlocks[0]
+		}); err != nil {
+			return nil, err
+		}
+		created.Ipv6CidrBlocks = []string{subnet.Ipv6CidrBlocks[0]}
+	}
+	if created.CidrBlock == "" && len(created.Ipv6CidrBlocks) == 0 {
+		return nil, apiError("MissingParameter", "Either 'cidrBlock' or 'ipv6CidrBlock' should be provided.")
+	}
+	if created.CidrBlock != "" {
+		created.PrivateDnsHostnameTypeOnLaunch = ptr.To(string(ec2types.HostnameTypeIpName))
+	} else {
+		created.PrivateDnsHostnameTypeOnLaunch = ptr.To(string(ec2types.HostnameTypeResourceName))
+	}
+	c.subnets[created.SubnetId] = created
+	for _, acl := range c.networkAcls {
+		if ptr.Deref(acl.VpcId, "") == vpcID && acl.IsDefault {
+			acl.Associations = append(acl.Associations, &awsclient.NetworkAclAssociation{
+				NetworkAclAssociationId: c.newID("aclassoc"),
+				SubnetId:                created.SubnetId,
+			})
+		}
+	}
+	c.created(created.SubnetId)
+	return cloneSubnet(created), nil
+}
+
+func (c *Client) validateSubnetCidr(vpcID, vpcCidr, cidr string, subnetCidr func(*awsclient.Subnet) string) error {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return apiError("InvalidParameterValue", "Value (%s) for parameter cidrBlock is invalid.", cidr)
+	}
+	_, vpcNetwork, err := net.ParseCIDR(vpcCidr)
+	if err != nil || !containsNetwork(vpcNetwork, network) {
+		return apiError("InvalidSubnet.Range", "The CIDR '%s' is invalid.", cidr)
+	}
+	for _, other := range c.subnets {
+		if ptr.Deref(other.VpcId, "") != vpcID {
+			continue
+		}
+		if _, otherNetwork, err := net.ParseCIDR(subnetCidr(other)); err == nil &&
+			(otherNetwork.Contains(network.IP) || network.Contains(otherNetwork.IP)) {
+			return apiError("InvalidSubnet.Conflict", "The CIDR '%s' conflicts with another subnet", cidr)
+		}
+	}
+	return nil
+}
+
+// GetSubnets returns the subnets with the given IDs. Non-existing IDs are ignored.
+func (c *Client) GetSubnets(_ context.Context, ids []string) ([]*awsclient.Subnet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.Subnet
+	for _, id := range ids {
+		if subnet, ok := c.subnets[id]; ok && c.visible(id) {
+			result = append(result, cloneSubnet(subnet))
+		}
+	}
+	return result, nil
+}
+
+// FindSubnets returns all subnets matching the filters.
+func (c *Client) FindSubnets(_ context.Context, filters []ec2types.Filter) ([]*awsclient.Subnet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.Subnet
+	for _, id := range sortedKeys(c.subnets) {
+		subnet := c.subnets[id]
+		ok, err := matchFilters(filters, subnet.Tags, attributes{
+			"vpc-id":            {ptr.Deref(subnet.VpcId, "")},
+			"subnet-id":         {id},
+			"availability-zone": {subnet.AvailabilityZone},
+			"cidr-block":        {subnet.CidrBlock},
+			"state":             {string(ec2types.SubnetStateAvailable)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok && c.visible(id) {
+			result = append(result, cloneSubnet(subnet))
+		}
+	}
+	return result, nil
+}
+
+// AddNetworkInterface adds a network interface to the fake, e.g. one created by another service or account in a subnet
+// of the cluster. The network interface ID and the VPC ID are set if missing. Subnets and security groups cannot be
+// deleted while they are used by network interfaces.
+func (c *Client) AddNetworkInterface(eni awsclient.NetworkInterface) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if eni.NetworkInterfaceId == "" {
+		eni.NetworkInterfaceId = c.newID("eni")
+	}
+	if subnet, ok := c.subnets[eni.SubnetId]; ok && eni.VpcId == "" {
+		eni.VpcId = ptr.Deref(subnet.VpcId, "")
+	}
+	if eni.OwnerId == "" {
+		eni.OwnerId = c.accountID
+	}
+	c.interfaces[eni.NetworkInterfaceId] = cloneNetworkInterface(&eni)
+	return eni.NetworkInterfaceId
+}
+
+// RemoveNetworkInterface removes a network interface added by AddNetworkInterface.
+func (c *Client) RemoveNetworkInterface(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.interfaces, id)
+}
+
+// FindNetworkInterfaces returns the network interfaces matching the filters.
+func (c *Client) FindNetworkInterfaces(_ context.Context, filters []ec2types.Filter) ([]*awsclient.NetworkInterface, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.NetworkInterface
+	for _, id := range sortedKeys(c.interfaces) {
+		eni := c.interfaces[id]
+		ok, err := matchFilters(filters, eni.Tags, attributes{
+			"network-interface-id": {id},
+			"subnet-id":            {eni.SubnetId},
+			"vpc-id":               {eni.VpcId},
+			"group-id":             eni.SecurityGroupIds,
+			"interface-type":       {eni.InterfaceType},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, cloneNetworkInterface(eni))
+		}
+	}
+	return result, nil
+}
+
+// UpdateSubnetAttributes updates the attributes of the subnet like the real client does.
+func (c *Client) UpdateSubnetAttributes(_ context.Context, desired, current *awsclient.Subnet) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subnet, ok := c.subnets[current.SubnetId]
+	if !ok {
+		return false, notFoundError(current.SubnetId)
+	}
+	modified := false
+	updateBool := func(currentValue, desiredValue *bool, field **bool) {
+		if ptr.Deref(currentValue, false) != ptr.Deref(desiredValue, false) {
+			*field = trueOrNil(desiredValue)
+			modified = true
+		}
+	}
+	updateBool(current.EnableDns64, desired.EnableDns64, &subnet.EnableDns64)
+	updateBool(current.EnableResourceNameDnsAAAARecordOnLaunch, desired.EnableResourceNameDnsAAAARecordOnLaunch, &subnet.EnableResourceNameDnsAAAARecordOnLaunch)
+	updateBool(current.EnableResourceNameDnsARecordOnLaunch, desired.EnableResourceNameDnsARecordOnLaunch, &subnet.EnableResourceNameDnsARecordOnLaunch)
+	updateBool(current.MapCustomerOwnedIpOnLaunch, desired.MapCustomerOwnedIpOnLaunch, &subnet.MapCustomerOwnedIpOnLaunch)
+	updateBool(current.MapPublicIpOnLaunch, desired.MapPublicIpOnLaunch, &subnet.MapPublicIpOnLaunch)
+
+	if desired.Ipv6CidrBlocks != nil && (current.Ipv6CidrBlocks == nil || current.Ipv6CidrBlocks[0] != desired.Ipv6CidrBlocks[0]) {
+		if len(subnet.Ipv6CidrBlocks) == 0 && desired.Ipv6CidrBlocks[0] != "" {
+			vpcID := ptr.Deref(subnet.VpcId, "")
+			if err := c.validateSubnetCidr(vpcID, c.vpcs[vpcID].IPv6CidrBlock, desired.Ipv6CidrBlocks[0], func(s *awsclient.Subnet) string {
+				if len(s.Ipv6CidrBlocks) == 0 {
+					return ""
+				}
+				return s.Ipv6CidrBlocks[0]
+			}); err != nil {
+				return false, fmt.Errorf("IPv6 CIDR block association failed: %w", err)
+			}
+			subnet.Ipv6CidrBlocks = []string{desired.Ipv6CidrBlocks[0]}
+			modified = true
+		}
+	}
+
+	if !reflect.DeepEqual(current.CustomerOwnedIpv4Pool, desired.CustomerOwnedIpv4Pool) {
+		subnet.CustomerOwnedIpv4Pool = clonePtr(desired.CustomerOwnedIpv4Pool)
+		modified = true
+	}
+	privateDnsHostnameTypeOnLaunch := desired.PrivateDnsHostnameTypeOnLaunch
+	if privateDnsHostnameTypeOnLaunch == nil {
+		if desired.CidrBlock != "" && !ptr.Deref(desired.Ipv6Native, false) {
+			privateDnsHostnameTypeOnLaunch = ptr.To(string(ec2types.HostnameTypeIpName))
+		} else {
+			privateDnsHostnameTypeOnLaunch = ptr.To(string(ec2types.HostnameTypeResourceName))
+		}
+	}
+	if !reflect.DeepEqual(current.PrivateDnsHostnameTypeOnLaunch, privateDnsHostnameTypeOnLaunch) {
+		subnet.PrivateDnsHostnameTypeOnLaunch = ptr.To(*privateDnsHostnameTypeOnLaunch)
+		modified = true
+	}
+	return modified, nil
+}
+
+// DeleteSubnet deletes the subnet. It fails while NAT gateways or EFS mount targets are placed in the subnet.
+// Route table and network ACL associations of the subnet are removed.
+func (c *Client) DeleteSubnet(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.subnets[id]; !ok {
+		return nil
+	}
+	for _, nat := range c.natGateways {
+		if nat.SubnetId == id {
+			return dependencyViolation("The subnet '%s' has dependencies and cannot be deleted.", id)
+		}
+	}
+	for _, mt := range c.mountTargets {
+		if ptr.Deref(mt.SubnetId, "") == id {
+			return dependencyViolation("The subnet '%s' has dependencies and cannot be deleted.", id)
+		}
+	}
+	if c.fsxFileSystemUses(id) {
+		return dependencyViolation("The subnet '%s' has dependencies and cannot be deleted.", id)
+	}
+	for _, eni := range c.interfaces {
+		if eni.SubnetId == id {
+			return dependencyViolation("The subnet '%s' has dependencies and cannot be deleted.", id)
+		}
+	}
+	for _, rt := range c.routeTables {
+		rt.Associations = slices.DeleteFunc(rt.Associations, func(assoc *awsclient.RouteTableAssociation) bool {
+			return ptr.Deref(assoc.SubnetId, "") == id
+		})
+	}
+	for _, acl := range c.networkAcls {
+		acl.Associations = slices.DeleteFunc(acl.Associations, func(assoc *awsclient.NetworkAclAssociation) bool {
+			return assoc.SubnetId == id
+		})
+	}
+	delete(c.cidrReservations, id)
+	delete(c.subnets, id)
+	return nil
+}
+
+// CreateCIDRReservation creates a CIDR reservation in the subnet.
+func (c *Client) CreateCIDRReservation(_ context.Context, subnet *awsclient.Subnet, cidr string, _ string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.subnets[subnet.SubnetId]; !ok {
+		return "", notFoundError(subnet.SubnetId)
+	}
+	if slices.Contains(c.cidrReservations[subnet.SubnetId], cidr) {
+		return "", apiError("InvalidSubnetCidrReservation.Duplicate", "The CIDR reservation %s already exists", cidr)
+	}
+	c.cidrReservations[subnet.SubnetId] = append(c.cidrReservations[subnet.SubnetId], cidr)
+	return cidr, nil
+}
+
+// GetIPv6CIDRReservations returns the CIDR reservations of the subnet.
+func (c *Client) GetIPv6CIDRReservations(_ context.Context, subnet *awsclient.Subnet) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.subnets[subnet.SubnetId]; !ok {
+		return nil, notFoundError(subnet.SubnetId)
+	}
+	return slices.Clone(c.cidrReservations[subnet.SubnetId]), nil
+}
+
+// CreateRouteTableAssociation associates the subnet with the route table.
+func (c *Client) CreateRouteTableAssociation(_ context.Context, routeTableId, subnetId string) (*string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rt, ok := c.routeTables[routeTableId]
+	if !ok {
+		return nil, notFoundError(routeTableId)
+	}
+	subnet, ok := c.subnets[subnetId]
+	if !ok {
+		return nil, notFoundError(subnetId)
+	}
+	if ptr.Deref(rt.VpcId, "") != ptr.Deref(subnet.VpcId, "") {
+		return nil, apiError("InvalidParameterValue", "route table %s and subnet %s belong to different networks", routeTableId, subnetId)
+	}
+	for _, other := range c.routeTables {
+		for _, assoc := range other.Associations {
+			if ptr.Deref(assoc.SubnetId, "") == subnetId {
+				return nil, apiError("Resource.AlreadyAssociated", "the specified association for route table %s conflicts with an existing association", routeTableId)
+			}
+		}
+	}
+	assoc := &awsclient.RouteTableAssociation{
+		RouteTableAssociationId: c.newID("rtbassoc"),
+		SubnetId:                ptr.To(subnetId),
+	}
+	rt.Associations = append(rt.Associations, assoc)
+	return ptr.To(assoc.RouteTableAssociationId), nil
+}
+
+// DeleteRouteTableAssociation removes the route table association.
+func (c *Client) DeleteRouteTableAssociation(_ context.Context, associationId string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, rt := range c.routeTables {
+		for i, assoc := range rt.Associations {
+			if assoc.RouteTableAssociationId != associationId {
+				continue
+			}
+			if assoc.Main {
+				return apiError("InvalidParameterValue", "cannot disassociate the main route table association %s", associationId)
+			}
+			rt.Associations = slices.Delete(rt.Associations, i, i+1)
+			return nil
+		}
+	}
+	return nil
+}
+
+// GetRouteTableAssociationIDs returns the association IDs of all route tables of the VPC which are associated with
+// one of the subnets.
+func (c *Client) GetRouteTableAssociationIDs(_ context.Context, vpc string, subnetIDs []string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []string
+	for _, id := range sortedKeys(c.routeTables) {
+		rt := c.routeTables[id]
+		if ptr.Deref(rt.VpcId, "") != vpc {
+			continue
+		}
+		if !slices.ContainsFunc(rt.Associations, func(assoc *awsclient.RouteTableAssociation) bool {
+			return slices.Contains(subnetIDs, ptr.Deref(assoc.SubnetId, ""))
+		}) {
+			continue
+		}
+		for _, assoc := range rt.Associations {
+			result = append(result, assoc.RouteTableAssociationId)
+		}
+	}
+	return result, nil
+}
+
+// CreateElasticIP allocates an elastic IP.
+func (c *Client) CreateElasticIP(_ context.Context, eip *awsclient.ElasticIP) (*awsclient.ElasticIP, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pool := ptr.Deref(eip.PublicIPv4Pool, ptr.Deref(eip.IPAMPoolID, ""))
+	if pool != "" {
+		free, ok := c.addressPools[pool]
+		if !ok {
+			return nil, apiError("InvalidPublicIpv4PoolID.NotFound", "The pool ID '%s' does not exist", pool)
+		}
+		if free == 0 {
+			return nil, apiError("InsufficientAddressCapacity", "The pool '%s' has no free addresses", pool)
+		}
+		c.addressPools[pool] = free - 1
+	}
+
+	id := c.newID("eipalloc")
+	n := c.counters["eipalloc"]
+	created := &awsclient.ElasticIP{
+		Tags:           eip.Tags.Clone(),
+		AllocationId:   id,
+		PublicIp:       fmt.Sprintf("198.51.%d.%d", n/254, n%254+1),
+		Vpc:            eip.Vpc,
+		PublicIPv4Pool: clonePtr(eip.PublicIPv4Pool),
+		IPAMPoolID:     clonePtr(eip.IPAMPoolID),
+	}
+	c.elasticIPs[id] = created
+	c.created(id)
+	return cloneElasticIP(created), nil
+}
+
+// GetElasticIP returns the elastic IP with the given allocation ID or nil if not found.
+func (c *Client) GetElasticIP(_ context.Context, id string) (*awsclient.ElasticIP, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if eip, ok := c.elasticIPs[id]; ok && c.visible(id) {
+		return cloneElasticIP(eip), nil
+	}
+	return nil, nil
+}
+
+// FindElasticIPsByTags returns all elastic IPs with the given tags.
+func (c *Client) FindElasticIPsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.ElasticIP, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.ElasticIP
+	for _, id := range sortedKeys(c.elasticIPs) {
+		if eip := c.elasticIPs[id]; matchTags(tags, eip.Tags) && c.visible(id) {
+			result = append(result, cloneElasticIP(eip))
+		}
+	}
+	return result, nil
+}
+
+// DeleteElasticIP releases the elastic IP. It fails while the elastic IP is associated.
+func (c *Client) DeleteElasticIP(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	eip, ok := c.elasticIPs[id]
+	if !ok {
+		return nil
+	}
+	if eip.AssociationID != nil {
+		return apiError("InvalidIPAddress.InUse", "Address %s is in use.", eip.PublicIp)
+	}
+	delete(c.elasticIPs, id)
+	return nil
+}
+
+// CreateNATGateway creates a NAT gateway in state "pending" and associates the elastic IP with it.
+func (c *Client) CreateNATGateway(_ context.Context, gateway *awsclient.NATGateway) (*awsclient.NATGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subnet, ok := c.subnets[gateway.SubnetId]
+	if !ok {
+		return nil, notFoundError(gateway.SubnetId)
+	}
+	eip, ok := c.elasticIPs[gateway.EIPAllocationId]
+	if !ok {
+		return nil, notFoundError(gateway.EIPAllocationId)
+	}
+	if eip.AssociationID != nil {
+		return nil, apiError("Resource.AlreadyAssociated", "Elastic IP address [%s] is already associated", eip.AllocationId)
+	}
+	eip.AssociationID = ptr.To(c.newID("eipassoc"))
+	created := &awsclient.NATGateway{
+		Tags:            gateway.Tags.Clone(),
+		NATGatewayId:    c.newID("nat"),
+		EIPAllocationId: eip.AllocationId,
+		PublicIP:        eip.PublicIp,
+		SubnetId:        subnet.SubnetId,
+		State:           string(ec2types.NatGatewayStatePending),
+		VpcId:           clonePtr(subnet.VpcId),
+	}
+	c.natGateways[created.NATGatewayId] = created
+	c.created(created.NATGatewayId)
+	return cloneNATGateway(created), nil
+}
+
+// WaitForNATGatewayAvailable transitions a pending NAT gateway to "available".
+func (c *Client) WaitForNATGatewayAvailable(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nat, ok := c.natGateways[id]
+	if !ok {
+		return notFoundError(id)
+	}
+	if nat.State == string(ec2types.NatGatewayStatePending) {
+		nat.State = string(ec2types.NatGatewayStateAvailable)
+	}
+	if nat.State != string(ec2types.NatGatewayStateAvailable) {
+		return fmt.Errorf("NAT gateway %s is in state %s", id, nat.State)
+	}
+	return nil
+}
+
+// GetNATGateway returns the NAT gateway with the given ID or nil if not found.
+func (c *Client) GetNATGateway(_ context.Context, id string) (*awsclient.NATGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if nat, ok := c.natGateways[id]; ok && c.visible(id) {
+		return cloneNATGateway(nat), nil
+	}
+	return nil, nil
+}
+
+// FindNATGatewaysByTags returns all NAT gateways with the given tags.
+func (c *Client) FindNATGatewaysByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.NATGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.NATGateway
+	for _, id := range sortedKeys(c.natGateways) {
+		if nat := c.natGateways[id]; matchTags(tags, nat.Tags) && c.visible(id) {
+			result = append(result, cloneNATGateway(nat))
+		}
+	}
+	return result, nil
+}
+
+// FindNATGateways returns all NAT gateways matching the filters.
+func (c *Client) FindNATGateways(_ context.Context, filters []ec2types.Filter) ([]*awsclient.NATGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.NATGateway
+	for _, id := range sortedKeys(c.natGateways) {
+		nat := c.natGateways[id]
+		ok, err := matchFilters(filters, nat.Tags, attributes{
+			"vpc-id":         {ptr.Deref(nat.VpcId, "")},
+			"subnet-id":      {nat.SubnetId},
+			"nat-gateway-id": {id},
+			"state":          {nat.State},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok && c.visible(id) {
+			result = append(result, cloneNATGateway(nat))
+		}
+	}
+	return result, nil
+}
+
+// DeleteNATGateway deletes the NAT gateway and disassociates its elastic IP.
+func (c *Client) DeleteNATGateway(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nat, ok := c.natGateways[id]
+	if !ok {
+		return nil
+	}
+	if eip, ok := c.elasticIPs[nat.EIPAllocationId]; ok {
+		eip.AssociationID = nil
+	}
+	for _, address := range nat.SecondaryAddresses {
+		if eip, ok := c.elasticIPs[address.AllocationId]; ok {
+			eip.AssociationID = nil
+		}
+	}
+	delete(c.natGateways, id)
+	return nil
+}
+
+// AssociateNATGatewayAddresses associates the elastic IPs as secondary addresses with the NAT gateway.
+func (c *Client) AssociateNATGatewayAddresses(_ context.Context, id string, allocationIDs []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nat, ok := c.natGateways[id]
+	if !ok {
+		return notFoundError(id)
+	}
+	for _, allocationID := range allocationIDs {
+		eip, ok := c.elasticIPs[allocationID]
+		if !ok {
+			return notFoundError(allocationID)
+		}
+		if eip.AssociationID != nil {
+			return apiError("Resource.AlreadyAssociated", "Elastic IP address [%s] is already associated", eip.AllocationId)
+		}
+	}
+	for _, allocationID := range allocationIDs {
+		eip := c.elasticIPs[allocationID]
+		eip.AssociationID = ptr.To(c.newID("eipassoc"))
+		n := c.counters["eipassoc"]
+		nat.SecondaryAddresses = append(nat.SecondaryAddresses, &awsclient.NATGatewayAddress{
+			AllocationId:  allocationID,
+			AssociationId: *eip.AssociationID,
+			PublicIP:      eip.PublicIp,
+			PrivateIP:     fmt.Sprintf("10.0.%d.%d", n/254, n%254+1),
+			Status:        string(ec2types.NatGatewayAddressStatusSucceeded),
+		})
+	}
+	return nil
+}
+
+// DisassociateNATGatewayAddresses removes the secondary addresses from the NAT gateway.
+func (c *Client) DisassociateNATGatewayAddresses(_ context.Context, id string, addresses []*awsclient.NATGatewayAddress) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nat, ok := c.natGateways[id]
+	if !ok {
+		return nil
+	}
+	nat.SecondaryAddresses = slices.DeleteFunc(nat.SecondaryAddresses, func(address *awsclient.NATGatewayAddress) bool {
+		if !slices.ContainsFunc(addresses, func(a *awsclient.NATGatewayAddress) bool { return a.PrivateIP == address.PrivateIP }) {
+			return false
+		}
+		if eip, ok := c.elasticIPs[address.AllocationId]; ok {
+			eip.AssociationID = nil
+		}
+		return true
+	})
+	return nil
+}
+
+// CreateEgressOnlyInternetGateway creates an egress only internet gateway attached to the VPC.
+func (c *Client) CreateEgressOnlyInternetGateway(_ context.Context, gateway *awsclient.EgressOnlyInternetGateway) (*awsclient.EgressOnlyInternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpcID := ptr.Deref(gateway.VpcId, "")
+	if _, ok := c.vpcs[vpcID]; !ok {
+		return nil, notFoundError(vpcID)
+	}
+	created := &awsclient.EgressOnlyInternetGateway{
+		Tags:                        gateway.Tags.Clone(),
+		EgressOnlyInternetGatewayId: c.newID("eigw"),
+		VpcId:                       ptr.To(vpcID),
+	}
+	c.egressOnlyIGWs[created.EgressOnlyInternetGatewayId] = created
+	c.created(created.EgressOnlyInternetGatewayId)
+	return cloneEgressOnlyInternetGateway(created), nil
+}
+
+// GetEgressOnlyInternetGateway returns the egress only internet gateway with the given ID or nil if not found.
+func (c *Client) GetEgressOnlyInternetGateway(_ context.Context, id string) (*awsclient.EgressOnlyInternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if eigw, ok := c.egressOnlyIGWs[id]; ok && c.visible(id) {
+		return cloneEgressOnlyInternetGateway(eigw), nil
+	}
+	return nil, nil
+}
+
+// FindEgressOnlyInternetGatewaysByTags returns all egress only internet gateways with the given tags.
+func (c *Client) FindEgressOnlyInternetGatewaysByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.EgressOnlyInternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.EgressOnlyInternetGateway
+	for _, id := range sortedKeys(c.egressOnlyIGWs) {
+		if eigw := c.egressOnlyIGWs[id]; matchTags(tags, eigw.Tags) && c.visible(id) {
+			result = append(result, cloneEgressOnlyInternetGateway(eigw))
+		}
+	}
+	return result, nil
+}
+
+// FindEgressOnlyInternetGatewayByVPC returns the egress only internet gateway of the VPC or nil if not found.
+func (c *Client) FindEgressOnlyInternetGatewayByVPC(_ context.Context, vpcId string) (*awsclient.EgressOnlyInternetGateway, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range sortedKeys(c.egressOnlyIGWs) {
+		if eigw := c.egressOnlyIGWs[id]; ptr.Deref(eigw.VpcId, "") == vpcId && c.visible(id) {
+			return cloneEgressOnlyInternetGateway(eigw), nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteEgressOnlyInternetGateway deletes the egress only internet gateway.
+func (c *Client) DeleteEgressOnlyInternetGateway(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.egressOnlyIGWs, id)
+	return nil
+}
+
+// CreateFlowLog creates a flow log for a VPC. Like AWS, the destination of a CloudWatch Logs flow log is returned as
+// log group ARN and requires a delivery role.
+func (c *Client) CreateFlowLog(_ context.Context, flowLog *awsclient.FlowLog) (*awsclient.FlowLog, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.vpcs[flowLog.ResourceId]; !ok {
+		return nil, notFoundError(flowLog.ResourceId)
+	}
+	created := cloneFlowLog(flowLog)
+	created.FlowLogId = c.newID("fl")
+	switch created.LogDestinationType {
+	case "s3":
+		if created.LogDestination == nil {
+			return nil, apiError("InvalidParameter", "LogDestination must be specified for destination type s3")
+		}
+	case "cloud-watch-logs":
+		if !c.roleARNExists(ptr.Deref(created.DeliverLogsPermissionArn, "")) {
+			return nil, apiError("InvalidParameter", "DeliverLogsPermissionArn %q is not a valid role", ptr.Deref(created.DeliverLogsPermissionArn, ""))
+		}
+		if created.LogGroupName == nil {
+			return nil, apiError("InvalidParameter", "LogGroupName must be specified for destination type cloud-watch-logs")
+		}
+		created.LogDestination = ptr.To(fmt.Sprintf("arn:%s:logs:%s:%s:log-group:%s", c.GetPartition(), c.region, c.accountID, *created.LogGroupName))
+	default:
+		return nil, apiError("InvalidParameter", "LogDestinationType %q is not supported", created.LogDestinationType)
+	}
+	if created.TrafficType == "" {
+		created.TrafficType = "ALL"
+	}
+	if created.MaxAggregationInterval == nil {
+		created.MaxAggregationInterval = ptr.To[int32](600)
+	}
+	c.flowLogs[created.FlowLogId] = created
+	c.created(created.FlowLogId)
+	return cloneFlowLog(created), nil
+}
+
+// GetFlowLog returns the flow log with the given ID or nil if not found.
+func (c *Client) GetFlowLog(_ context.Context, id string) (*awsclient.FlowLog, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if flowLog, ok := c.flowLogs[id]; ok && c.visible(id) {
+		return cloneFlowLog(flowLog), nil
+	}
+	return nil, nil
+}
+
+// FindFlowLogsByTags returns all flow logs with the given tags.
+func (c *Client) FindFlowLogsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.FlowLog, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.FlowLog
+	for _, id := range sortedKeys(c.flowLogs) {
+		if flowLog := c.flowLogs[id]; matchTags(tags, flowLog.Tags) && c.visible(id) {
+			result = append(result, cloneFlowLog(flowLog))
+		}
+	}
+	return result, nil
+}
+
+// FindFlowLogsByResourceID returns all flow logs of the given resource.
+func (c *Client) FindFlowLogsByResourceID(_ context.Context, resourceID string) ([]*awsclient.FlowLog, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.FlowLog
+	for _, id := range sortedKeys(c.flowLogs) {
+		if flowLog := c.flowLogs[id]; flowLog.ResourceId == resourceID && c.visible(id) {
+			result = append(result, cloneFlowLog(flowLog))
+		}
+	}
+	return result, nil
+}
+
+// DeleteFlowLog deletes the flow log.
+func (c *Client) DeleteFlowLog(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.flowLogs, id)
+	return nil
+}
+
+// CreateNetworkAcl creates a network ACL. Like AWS, the entries of the given network ACL are ignored and the new
+// network ACL denies all traffic.
+func (c *Client) CreateNetworkAcl(_ context.Context, acl *awsclient.NetworkAcl) (*awsclient.NetworkAcl, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vpcID := ptr.Deref(acl.VpcId, "")
+	if _, ok := c.vpcs[vpcID]; !ok {
+		return nil, notFoundError(vpcID)
+	}
+	created := &awsclient.NetworkAcl{
+		Tags:         acl.Tags.Clone(),
+		NetworkAclId: c.newID("acl"),
+		VpcId:        ptr.To(vpcID),
+		Entries:      defaultNetworkAclEntries(),
+	}
+	c.networkAcls[created.NetworkAclId] = created
+	c.created(created.NetworkAclId)
+	return cloneNetworkAcl(created), nil
+}
+
+func defaultNetworkAclEntries() []*awsclient.NetworkAclEntry {
+	return []*awsclient.NetworkAclEntry{
+		{RuleNumber: awsclient.DefaultNetworkAclRuleNumber, Protocol: "-1", RuleAction: "deny", CidrBlock: ptr.To("0.0.0.0/0")},
+		{RuleNumber: awsclient.DefaultNetworkAclRuleNumber, Egress: true, Protocol: "-1", RuleAction: "deny", CidrBlock: ptr.To("0.0.0.0/0")},
+	}
+}
+
+// GetNetworkAcl returns the network ACL with the given ID or nil if not found.
+func (c *Client) GetNetworkAcl(_ context.Context, id string) (*awsclient.NetworkAcl, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if acl, ok := c.networkAcls[id]; ok && c.visible(id) {
+		return cloneNetworkAcl(acl), nil
+	}
+	return nil, nil
+}
+
+// FindNetworkAclsByTags returns all network ACLs with the given tags.
+func (c *Client) FindNetworkAclsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.NetworkAcl, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*awsclient.NetworkAcl
+	for _, id := range sortedKeys(c.networkAcls) {
+		if acl := c.networkAcls[id]; matchTags(tags, acl.Tags) && c.visible(id) {
+			result = append(result, cloneNetworkAcl(acl))
+		}
+	}
+	return result, nil
+}
+
+// FindDefaultNetworkAclByVpcId returns the default network ACL of the VPC or nil if not found.
+func (c *Client) FindDefaultNetworkAclByVpcId(_ context.Context, vpcId string) (*awsclient.NetworkAcl, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range sortedKeys(c.networkAcls) {
+		if acl := c.networkAcls[id]; ptr.Deref(acl.VpcId, "") == vpcId && acl.IsDefault && c.visible(id) {
+			return cloneNetworkAcl(acl), nil
+		}
+	}
+	return nil, nil
+}
+
+// FindNetworkAclBySubnetId returns the network ACL the subnet is associated with or nil if not found.
+func (c *Client) FindNetworkAclBySubnetId(_ context.Context, subnetId string) (*awsclient.NetworkAcl, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range sortedKeys(c.networkAcls) {
+		acl := c.networkAcls[id]
+		if slices.ContainsFunc(acl.Associations, func(assoc *awsclient.NetworkAclAssociation) bool {
+			return assoc.SubnetId == subnetId
+		}) && c.visible(id) {
+			return cloneNetworkAcl(acl), nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateNetworkAclEntry adds the entry to the network ACL. The rule number must be unique per direction.
+func (c *Client) CreateNetworkAclEntry(_ context.Context, id string, entry *awsclient.NetworkAclEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acl, ok := c.networkAcls[id]
+	if !ok {
+		return notFoundError(id)
+	}
+	if err := validateNetworkAclEntry(entry); err != nil {
+		return err
+	}
+	if slices.IndexFunc(acl.Entries, sameNetworkAclEntry(entry)) >= 0 {
+		return apiError("NetworkAclEntryAlreadyExists", "The network acl entry identified by %d already exists.", entry.RuleNumber)
+	}
+	acl.Entries = append(acl.Entries, cloneNetworkAclEntry(entry))
+	return nil
+}
+
+// ReplaceNetworkAclEntry replaces the entry of the network ACL with the same direction and rule number.
+func (c *Client) ReplaceNetworkAclEntry(_ context.Context, id string, entry *awsclient.NetworkAclEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acl, ok := c.networkAcls[id]
+	if !ok {
+		return notFoundError(id)
+	}
+	if err := validateNetworkAclEntry(entry); err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(acl.Entries, sameNetworkAclEntry(entry))
+	if idx < 0 {
+		return apiError("InvalidNetworkAclEntry.NotFound", "The network acl entry identified by %d does not exist.", entry.RuleNumber)
+	}
+	acl.Entries[idx] = cloneNetworkAclEntry(entry)
+	return nil
+}
+
+// DeleteNetworkAclEntry removes the entry of the network ACL with the same direction and rule number.
+func (c *Client) DeleteNetworkAclEntry(_ context.Context, id string, entry *awsclient.NetworkAclEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acl, ok := c.networkAcls[id]
+	if !ok {
+		return notFoundError(id)
+	}
+	if entry.RuleNumber == awsclient.DefaultNetworkAclRuleNumber {
+		return apiError("InvalidParameterValue", "The default network acl entry cannot be deleted.")
+	}
+	acl.Entries = slices.DeleteFunc(acl.Entries, sameNetworkAclEntry(entry))
+	return nil
+}
+
+func validateNetworkAclEntry(entry *awsclient.NetworkAclEntry) error {
+	if entry.RuleNumber < 1 || entry.RuleNumber >= awsclient.DefaultNetworkAclRuleNumber {
+		return apiError("InvalidParameterValue", "Value (%d) for parameter ruleNumber is invalid.", entry.RuleNumber)
+	}
+	if entry.HasPortRange() && (entry.FromPort == nil || entry.ToPort == nil) {
+		return apiError("MissingParameter", "The request must contain the parameter portRange")
+	}
+	return nil
+}
+
+func sameNetworkAclEntry(entry *awsclient.NetworkAclEntry) func(*awsclient.NetworkAclEntry) bool {
+	return func(other *awsclient.NetworkAclEntry) bool {
+		return other.Egress == entry.Egress && other.RuleNumber == entry.RuleNumber
+	}
+}
+
+// ReplaceNetworkAclAssociation associates the subnet of the given association with another network ACL of the same
+// VPC.
+func (c *Client) ReplaceNetworkAclAssociation(_ context.Context, associationId, networkAclId string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target, ok := c.networkAcls[networkAclId]
+	if !ok {
+		return "", notFoundError(networkAclId)
+	}
+	for _, acl := range c.networkAcls {
+		idx := slices.IndexFunc(acl.Associations, func(assoc *awsclient.NetworkAclAssociation) bool {
+			return assoc.NetworkAclAssociationId == associationId
+		})
+		if idx < 0 {
+			continue
+		}
+		if ptr.Deref(acl.VpcId, "") != ptr.Deref(target.VpcId, "") {
+			return "", apiError("InvalidParameterValue", "network ACL %s and association %s belong to different VPCs", networkAclId, associationId)
+		}
+		assoc := &awsclient.NetworkAclAssociation{
+			NetworkAclAssociationId: c.newID("aclassoc"),
+			SubnetId:                acl.Associations[idx].SubnetId,
+		}
+		acl.Associations = slices.Delete(acl.Associations, idx, idx+1)
+		target.Associations = append(target.Associations, assoc)
+		return assoc.NetworkAclAssociationId, nil
+	}
+	return "", apiError("InvalidAssociationID.NotFound", "The association ID '%s' does not exist", associationId)
+}
+
+// DeleteNetworkAcl deletes the network ACL. Default network ACLs and network ACLs still associated with subnets
+// cannot be deleted.
+func (c *Client) DeleteNetworkAcl(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acl, ok := c.networkAcls[id]
+	if !ok {
+		return nil
+	}
+	if acl.IsDefault {
+		return apiError("InvalidParameterValue", "cannot delete default network ACL %s", id)
+	}
+	if len(acl.Associations) > 0 {
+		return dependencyViolation("The networkAcl '%s' has dependencies and cannot be deleted.", id)
+	}
+	delete(c.networkAcls, id)
+	return nil
+}
+
+// ImportKeyPair imports a public key.
+func (c *Client) ImportKeyPair(_ context.Context, keyName string, publicKey []byte, tags awsclient.Tags) (*awsclient.KeyPairInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.keyPairs[keyName]; ok {
+		return nil, apiError("InvalidKeyPair.Duplicate", "The keypair '%s' already exists.", keyName)
+	}
+	created := &awsclient.KeyPairInfo{
+		Tags:           tags.Clone(),
+		KeyName:        keyName,
+		KeyFingerprint: fingerprint(publicKey),
+	}
+	c.keyPairs[keyName] = created
+	return cloneKeyPair(created), nil
+}
+
+// GetKeyPair returns the key pair with the given name or nil if not found.
+func (c *Client) GetKeyPair(_ context.Context, keyName string) (*awsclient.KeyPairInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if keyPair, ok := c.keyPairs[keyName]; ok {
+		return cloneKeyPair(keyPair), nil
+	}
+	return nil, nil
+}
+
+// DeleteKeyPair deletes the key pair.
+func (c *Client) DeleteKeyPair(_ context.Context, keyName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.keyPairs, keyName)
+	return nil
+}
+
+// CreateEC2Tags adds the tags to the given EC2 resources.
+func (c *Client) CreateEC2Tags(_ context.Context, resources []string, tags awsclient.Tags) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range resources {
+		resourceTags, err := c.ec2Tags(id)
+		if err != nil {
+			return err
+		}
+		maps.Copy(*resourceTags, tags)
+	}
+	return nil
+}
+
+// DeleteEC2Tags removes the tags from the given EC2 resources. Like AWS, tags with an empty value are removed
+// regardless of their value.
+func (c *Client) DeleteEC2Tags(_ context.Context, resources []string, tags awsclient.Tags) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range resources {
+		resourceTags, err := c.ec2Tags(id)
+		if err != nil {
+			return err
+		}
+		for k, v := range tags {
+			if current, ok := (*resourceTags)[k]; ok && (v == "" || current == v) {
+				delete(*resourceTags, k)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) ec2Tags(id string) (*awsclient.Tags, error) {
+	var tags *awsclient.Tags
+	if r, ok := c.dhcpOptions[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.vpcs[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.securityGroups[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.internetGateways[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.egressOnlyIGWs[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.vpcEndpoints[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.routeTables[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.subnets[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.elasticIPs[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.natGateways[id]; ok {
+		tags = &r.Tags
+	} else if r, ok := c.networkAcls[id]; ok {
+		tags = &r.Tags
+	} else {
+		return nil, notFoundError(id)
+	}
+	if *tags == nil {
+		*tags = awsclient.Tags{}
+	}
+	return tags, nil
+}
+
+func (c *Client) newIPv6CidrBlock() string {
+	c.counters["ipv6"]++
+	n := c.counters["ipv6"]
+	return fmt.Sprintf("2a05:d018:%x:%02x00::/56", 0x1000+n/256, n%256)
+}
+
+func localRoutes(vpc *awsclient.VPC) []*awsclient.Route {
+	routes := []*awsclient.Route{{DestinationCidrBlock: ptr.To(vpc.CidrBlock), GatewayId: ptr.To("local")}}
+	if vpc.IPv6CidrBlock != "" {
+		routes = append(routes, &awsclient.Route{DestinationIpv6CidrBlock: ptr.To(vpc.IPv6CidrBlock), GatewayId: ptr.To("local")})
+	}
+	return routes
+}
+
+func defaultEgressRule() *awsclient.SecurityGroupRule {
+	return &awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeEgress, Protocol: "-1", CidrBlocks: []string{"0.0.0.0/0"}}
+}
+
+func isMainRouteTable(rt *awsclient.RouteTable) bool {
+	return slices.ContainsFunc(rt.Associations, func(assoc *awsclient.RouteTableAssociation) bool { return assoc.Main })
+}
+
+func equivalentRule(rule *awsclient.SecurityGroupRule) func(*awsclient.SecurityGroupRule) bool {
+	a := rule.SortedClone()
+	return func(other *awsclient.SecurityGroupRule) bool {
+		b := other.SortedClone()
+		return !a.LessThan(b) && !b.LessThan(a)
+	}
+}
+
+func sameDestination(destination string) func(*awsclient.Route) bool {
+	return func(route *awsclient.Route) bool {
+		id, err := route.DestinationId()
+		return err == nil && id == destination
+	}
+}
+
+// prefixListID returns a stable prefix list ID for the service of a gateway endpoint.
+func prefixListID(serviceName string) string {
+	sum := md5.Sum([]byte(serviceName)) // #nosec G401 -- only used to derive a stable identifier
+	return fmt.Sprintf("pl-%x", sum[:4])
+}
+
+func fingerprint(publicKey []byte) string {
+	sum := md5.Sum(publicKey) // #nosec G401 -- AWS uses MD5 fingerprints for imported key pairs
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":")
+}
+
+func containsNetwork(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && innerOnes >= outerOnes && outer.Contains(inner.IP)
+}
+
+func trueOrNil(b *bool) *bool {
+	if ptr.Deref(b, false) {
+		return ptr.To(true)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,438 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"k8s.io/utils/ptr"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+type mountTarget struct {
+	efstypes.MountTargetDescription
+	securityGroups []string
+}
+
+// GetFileSystem returns the EFS file system with the given ID or nil if not found.
+func (c *Client) GetFileSystem(_ context.Context, fileSystemID string) (*efstypes.FileSystemDescription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if fs, ok := c.fileSystems[fileSystemID]; ok {
+		return cloneFileSystem(fs), nil
+	}
+	return nil, nil
+}
+
+// FindFileSystemsByTags returns all EFS file systems with the given tags.
+func (c *Client) FindFileSystemsByTags(_ context.Context, tags awsclient.Tags) ([]*efstypes.FileSystemDescription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*efstypes.FileSystemDescription
+	for _, id := range sortedKeys(c.fileSystems) {
+		if fs := c.fileSystems[id]; tags.ContainEfsTags(fs.Tags) {
+			result = append(result, cloneFileSystem(fs))
+		}
+	}
+	return result, nil
+}
+
+// CreateFileSystem creates an EFS file system which is immediately available. A second file system with the same
+// creation token cannot be created.
+func (c *Client) CreateFileSystem(_ context.Context, input *efs.CreateFileSystemInput) (*efstypes.FileSystemDescription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	token := aws.ToString(input.CreationToken)
+	for _, fs := range c.fileSystems {
+		if aws.ToString(fs.CreationToken) == token {
+			return nil, &efstypes.FileSystemAlreadyExists{
+				Message:      ptr.To(fmt.Sprintf("File system '%s' already exists with creation token '%s'", aws.ToString(fs.FileSystemId), token)),
+				FileSystemId: clonePtr(fs.FileSystemId),
+			}
+		}
+	}
+	id := c.newID("fs")
+	fs := &efstypes.FileSystemDescription{
+		CreationTime:                 ptr.To(time.Now()),
+		CreationToken:                ptr.To(token),
+		FileSystemId:                 ptr.To(id),
+		FileSystemArn:                ptr.To(fmt.Sprintf("arn:%s:elasticfilesystem:%s:%s:file-system/%s", c.GetPartition(), c.region, c.accountID, id)),
+		LifeCycleState:               efstypes.LifeCycleStateAvailable,
+		NumberOfMountTargets:         0,
+		OwnerId:                      ptr.To(c.accountID),
+		PerformanceMode:              input.PerformanceMode,
+		ThroughputMode:               input.ThroughputMode,
+		ProvisionedThroughputInMibps: clonePtr(input.ProvisionedThroughputInMibps),
+		Encrypted:                    clonePtr(input.Encrypted),
+		KmsKeyId:                     clonePtr(input.KmsKeyId),
+		SizeInBytes:                  &efstypes.FileSystemSize{Value: 0},
+		Tags:                         slices.Clone(input.Tags),
+	}
+	if fs.PerformanceMode == "" {
+		fs.PerformanceMode = efstypes.PerformanceModeGeneralPurpose
+	}
+	if fs.ThroughputMode == "" {
+		fs.ThroughputMode = efstypes.ThroughputModeBursting
+	}
+	c.fileSystems[id] = fs
+	if aws.ToBool(input.Backup) {
+		c.backupPolicies[id] = efstypes.StatusEnabled
+	}
+	return cloneFileSystem(fs), nil
+}
+
+// UpdateFileSystem updates the throughput mode and the provisioned throughput of the file system.
+func (c *Client) UpdateFileSystem(_ context.Context, input *efs.UpdateFileSystemInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fs, err := c.getFileSystem(aws.ToString(input.FileSystemId))
+	if err != nil {
+		return err
+	}
+	if input.ThroughputMode != "" {
+		fs.ThroughputMode = input.ThroughputMode
+	}
+	if fs.ThroughputMode == efstypes.ThroughputModeProvisioned {
+		if input.ProvisionedThroughputInMibps != nil {
+			fs.ProvisionedThroughputInMibps = clonePtr(input.ProvisionedThroughputInMibps)
+		}
+		if fs.ProvisionedThroughputInMibps == nil {
+			return &efstypes.BadRequest{Message: ptr.To("ProvisionedThroughputInMibps is required for throughput mode provisioned")}
+		}
+	} else {
+		fs.ProvisionedThroughputInMibps = nil
+	}
+	return nil
+}
+
+// DescribeLifecycleConfiguration returns the lifecycle policies of the file system.
+func (c *Client) DescribeLifecycleConfiguration(_ context.Context, input *efs.DescribeLifecycleConfigurationInput) ([]efstypes.LifecyclePolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := aws.ToString(input.FileSystemId)
+	if _, err := c.getFileSystem(id); err != nil {
+		return nil, err
+	}
+	return slices.Clone(c.lifecyclePolicies[id]), nil
+}
+
+// PutLifecycleConfiguration replaces the lifecycle policies of the file system.
+func (c *Client) PutLifecycleConfiguration(_ context.Context, input *efs.PutLifecycleConfigurationInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := aws.ToString(input.FileSystemId)
+	if _, err := c.getFileSystem(id); err != nil {
+		return err
+	}
+	c.lifecyclePolicies[id] = slices.Clone(input.LifecyclePolicies)
+	return nil
+}
+
+// DescribeBackupPolicy returns the backup policy of the file system or nil if it has none.
+func (c *Client) DescribeBackupPolicy(_ context.Context, input *efs.DescribeBackupPolicyInput) (*efstypes.BackupPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := aws.ToString(input.FileSystemId)
+	if _, err := c.getFileSystem(id); err != nil {
+		return nil, err
+	}
+	status, ok := c.backupPolicies[id]
+	if !ok {
+		return nil, nil
+	}
+	return &efstypes.BackupPolicy{Status: status}, nil
+}
+
+// PutBackupPolicy updates the backup policy of the file system.
+func (c *Client) PutBackupPolicy(_ context.Context, input *efs.PutBackupPolicyInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := aws.ToString(input.FileSystemId)
+	if _, err := c.getFileSystem(id); err != nil {
+		return err
+	}
+	if input.BackupPolicy == nil {
+		return &efstypes.BadRequest{Message: ptr.To("BackupPolicy is required")}
+	}
+	c.backupPolicies[id] = input.BackupPolicy.Status
+	return nil
+}
+
+// DescribeAccessPoints returns the access points of a file system or a single access point.
+func (c *Client) DescribeAccessPoints(_ context.Context, input *efs.DescribeAccessPointsInput) ([]efstypes.AccessPointDescription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []efstypes.AccessPointDescription
+	switch {
+	case input.AccessPointId != nil:
+		if ap, ok := c.accessPoints[*input.AccessPointId]; ok {
+			result = append(result, *cloneAccessPoint(ap))
+		}
+	case input.FileSystemId != nil:
+		if _, ok := c.fileSystems[*input.FileSystemId]; !ok {
+			return nil, nil
+		}
+		for _, id := range sortedKeys(c.accessPoints) {
+			if ap := c.accessPoints[id]; aws.ToString(ap.FileSystemId) == *input.FileSystemId {
+				result = append(result, *cloneAccessPoint(ap))
+			}
+		}
+	default:
+		for _, id := range sortedKeys(c.accessPoints) {
+			result = append(result, *cloneAccessPoint(c.accessPoints[id]))
+		}
+	}
+	return result, nil
+}
+
+// CreateAccessPoint creates an access point which is immediately available. A second access point with the same
+// client token cannot be created.
+func (c *Client) CreateAccessPoint(_ context.Context, input *efs.CreateAccessPointInput) (*efstypes.AccessPointDescription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fsID := aws.ToString(input.FileSystemId)
+	if _, err := c.getFileSystem(fsID); err != nil {
+		return nil, err
+	}
+	token := aws.ToString(input.ClientToken)
+	for _, ap := range c.accessPoints {
+		if token != "" && aws.ToString(ap.ClientToken) == token {
+			return nil, &efstypes.AccessPointAlreadyExists{
+				Message:       ptr.To(fmt.Sprintf("Access point '%s' already exists with client token '%s'", aws.ToString(ap.AccessPointId), token)),
+				AccessPointId: clonePtr(ap.AccessPointId),
+			}
+		}
+	}
+	id := c.newID("fsap")
+	ap := &efstypes.AccessPointDescription{
+		AccessPointId:  ptr.To(id),
+		AccessPointArn: ptr.To(fmt.Sprintf("arn:%s:elasticfilesystem:%s:%s:access-point/%s", c.GetPartition(), c.region, c.accountID, id)),
+		ClientToken:    ptr.To(token),
+		FileSystemId:   ptr.To(fsID),
+		LifeCycleState: efstypes.LifeCycleStateAvailable,
+		OwnerId:        ptr.To(c.accountID),
+		PosixUser:      input.PosixUser,
+		RootDirectory:  input.RootDirectory,
+		Tags:           slices.Clone(input.Tags),
+	}
+	c.accessPoints[id] = cloneAccessPoint(ap)
+	return ap, nil
+}
+
+// DeleteAccessPoint deletes the access point.
+func (c *Client) DeleteAccessPoint(_ context.Context, input *efs.DeleteAccessPointInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.accessPoints, aws.ToString(input.AccessPointId))
+	return nil
+}
+
+// DeleteFileSystem deletes the EFS file system. It fails while the file system has mount targets.
+func (c *Client) DeleteFileSystem(_ context.Context, input *efs.DeleteFileSystemInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := aws.ToString(input.FileSystemId)
+	fs, ok := c.fileSystems[id]
+	if !ok {
+		return nil
+	}
+	if fs.NumberOfMountTargets > 0 {
+		return &efstypes.FileSystemInUse{Message: ptr.To(fmt.Sprintf("File system '%s' has mount targets created in it.", id))}
+	}
+	delete(c.fileSystems, id)
+	delete(c.lifecyclePolicies, id)
+	delete(c.backupPolicies, id)
+	for apID, ap := range c.accessPoints {
+		if aws.ToString(ap.FileSystemId) == id {
+			delete(c.accessPoints, apID)
+		}
+	}
+	return nil
+}
+
+// DescribeMountTargetsEfs returns the mount targets of a file system or a single mount target.
+// It returns nil if the file system or mount target does not exist.
+func (c *Client) DescribeMountTargetsEfs(_ context.Context, input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	output := &efs.DescribeMountTargetsOutput{}
+	switch {
+	case input.MountTargetId != nil:
+		mt, ok := c.mountTargets[*input.MountTargetId]
+		if !ok {
+			return nil, nil
+		}
+		output.MountTargets = append(output.MountTargets, mt.MountTargetDescription)
+	case input.FileSystemId != nil:
+		if _, ok := c.fileSystems[*input.FileSystemId]; !ok {
+			return nil, nil
+		}
+		for _, id := range sortedKeys(c.mountTargets) {
+			if mt := c.mountTargets[id]; aws.ToString(mt.FileSystemId) == *input.FileSystemId {
+				output.MountTargets = append(output.MountTargets, mt.MountTargetDescription)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("either FileSystemId or MountTargetId must be specified")
+	}
+	return output, nil
+}
+
+// CreateMountTargetEfs creates a mount target for the file system in the subnet. There can be only one mount target
+// per availability zone and file system.
+func (c *Client) CreateMountTargetEfs(_ context.Context, input *efs.CreateMountTargetInput) (*efs.CreateMountTargetOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fsID := aws.ToString(input.FileSystemId)
+	fs, ok := c.fileSystems[fsID]
+	if !ok {
+		return nil, &efstypes.FileSystemNotFound{Message: ptr.To(fmt.Sprintf("File system '%s' does not exist.", fsID))}
+	}
+	subnetID := aws.ToString(input.SubnetId)
+	subnet, ok := c.subnets[subnetID]
+	if !ok {
+		return nil, &efstypes.SubnetNotFound{Message: ptr.To(fmt.Sprintf("The subnet ID '%s' does not exist", subnetID))}
+	}
+	for _, sg := range input.SecurityGroups {
+		if _, ok := c.securityGroups[sg]; !ok {
+			return nil, &efstypes.SecurityGroupNotFound{Message: ptr.To(fmt.Sprintf("The security group '%s' does not exist", sg))}
+		}
+	}
+	for _, mt := range c.mountTargets {
+		if aws.ToString(mt.FileSystemId) == fsID && aws.ToString(mt.AvailabilityZoneName) == subnet.AvailabilityZone {
+			return nil, &efstypes.MountTargetConflict{Message: ptr.To(fmt.Sprintf("mount target already exists in this AZ: %s", subnet.AvailabilityZone))}
+		}
+	}
+	mt := &mountTarget{
+		MountTargetDescription: efstypes.MountTargetDescription{
+			FileSystemId:         ptr.To(fsID),
+			LifeCycleState:       efstypes.LifeCycleStateAvailable,
+			MountTargetId:        ptr.To(c.newID("fsmt")),
+			SubnetId:             ptr.To(subnetID),
+			AvailabilityZoneName: ptr.To(subnet.AvailabilityZone),
+			IpAddress:            clonePtr(input.IpAddress),
+			OwnerId:              ptr.To(c.accountID),
+			VpcId:                clonePtr(subnet.VpcId),
+		},
+		securityGroups: slices.Clone(input.SecurityGroups),
+	}
+	c.mountTargets[*mt.MountTargetId] = mt
+	fs.NumberOfMountTargets++
+	return &efs.CreateMountTargetOutput{
+		FileSystemId:         mt.FileSystemId,
+		LifeCycleState:       mt.LifeCycleState,
+		MountTargetId:        mt.MountTargetId,
+		SubnetId:             mt.SubnetId,
+		AvailabilityZoneName: mt.AvailabilityZoneName,
+		IpAddress:            mt.IpAddress,
+		OwnerId:              mt.OwnerId,
+		VpcId:                mt.VpcId,
+	}, nil
+}
+
+// DeleteMountTargetEfs deletes the mount target.
+func (c *Client) DeleteMountTargetEfs(_ context.Context, input *efs.DeleteMountTargetInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := aws.ToString(input.MountTargetId)
+	mt, ok := c.mountTargets[id]
+	if !ok {
+		return nil
+	}
+	if fs, ok := c.fileSystems[aws.ToString(mt.FileSystemId)]; ok {
+		fs.NumberOfMountTargets--
+	}
+	delete(c.mountTargets, id)
+	return nil
+}
+
+// TagResourceEfs adds or overwrites tags of a file system.
+func (c *Client) TagResourceEfs(_ context.Context, input *efs.TagResourceInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fs, err := c.getFileSystem(aws.ToString(input.ResourceId))
+	if err != nil {
+		return err
+	}
+	for _, tag := range input.Tags {
+		fs.Tags = slices.DeleteFunc(fs.Tags, func(existing efstypes.Tag) bool {
+			return aws.ToString(existing.Key) == aws.ToString(tag.Key)
+		})
+		fs.Tags = append(fs.Tags, efstypes.Tag{Key: clonePtr(tag.Key), Value: clonePtr(tag.Value)})
+	}
+	return nil
+}
+
+// UntagResourceEfs removes tags from a file system.
+func (c *Client) UntagResourceEfs(_ context.Context, input *efs.UntagResourceInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fs, err := c.getFileSystem(aws.ToString(input.ResourceId))
+	if err != nil {
+		return err
+	}
+	fs.Tags = slices.DeleteFunc(fs.Tags, func(existing efstypes.Tag) bool {
+		return slices.Contains(input.TagKeys, aws.ToString(existing.Key))
+	})
+	return nil
+}
+
+func (c *Client) getFileSystem(id string) (*efstypes.FileSystemDescription, error) {
+	fs, ok := c.fileSystems[id]
+	if !ok {
+		return nil, &efstypes.FileSystemNotFound{Message: ptr.To(fmt.Sprintf("File system '%s' does not exist.", id))}
+	}
+	return fs, nil
+}
+
+func cloneAccessPoint(in *efstypes.AccessPointDescription) *efstypes.AccessPointDescription {
+	out := *in
+	out.Tags = slices.Clone(in.Tags)
+	if in.PosixUser != nil {
+		user := *in.PosixUser
+		user.SecondaryGids = slices.Clone(in.PosixUser.SecondaryGids)
+		out.PosixUser = &user
+	}
+	if in.RootDirectory != nil {
+		root := *in.RootDirectory
+		if in.RootDirectory.CreationInfo != nil {
+			info := *in.RootDirectory.CreationInfo
+			root.CreationInfo = &info
+		}
+		out.RootDirectory = &root
+	}
+	return &out
+}
+
+func cloneFileSystem(in *efstypes.FileSystemDescription) *efstypes.FileSystemDescription {
+	out := *in
+	out.Tags = slices.Clone(in.Tags)
+	out.ProvisionedThroughputInMibps = clonePtr(in.ProvisionedThroughputInMibps)
+	return &out
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// LoadBalancer is a classic or v2 load balancer. Load balancers are created by the cloud controller manager and not
+// by the extension, so they can only be added to the fake with AddLoadBalancer.
+type LoadBalancer struct {
+	// Name is the name of a classic load balancer or the ARN of a v2 load balancer.
+	Name string
+	// V2 is true for application and network load balancers.
+	V2 bool
+	// VpcID is the ID of the VPC of the load balancer. The VPC cannot be deleted while it contains load balancers.
+	VpcID string
+	// DNSName is the DNS name of the load balancer.
+	DNSName string
+	// Tags are the tags of the load balancer.
+	Tags awsclient.Tags
+	// Targets are the IDs of the registered instances resp. targets. Deregistered targets are draining until the load
+	// balancer is drained again.
+	Targets []string
+
+	draining bool
+}
+
+// AddLoadBalancer adds a load balancer to the fake.
+func (c *Client) AddLoadBalancer(lb LoadBalancer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lb.Tags = lb.Tags.Clone()
+	lb.Targets = slices.Clone(lb.Targets)
+	c.loadBalancers[lb.Name] = &lb
+}
+
+// ListKubernetesELBs returns the names of the classic load balancers in the VPC owned by the cluster.
+func (c *Client) ListKubernetesELBs(_ context.Context, vpcID, clusterName string) ([]string, error) {
+	return c.listKubernetesLoadBalancers(false, vpcID, clusterName), nil
+}
+
+// ListKubernetesELBsV2 returns the ARNs of the v2 load balancers in the VPC owned by the cluster.
+func (c *Client) ListKubernetesELBsV2(_ context.Context, vpcID, clusterName string) ([]string, error) {
+	return c.listKubernetesLoadBalancers(true, vpcID, clusterName), nil
+}
+
+// ListKubernetesSecurityGroups returns the IDs of the security groups in the VPC owned by the cluster.
+func (c *Client) ListKubernetesSecurityGroups(_ context.Context, vpcID, clusterName string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []string
+	for _, id := range sortedKeys(c.securityGroups) {
+		sg := c.securityGroups[id]
+		if _, ok := sg.Tags[clusterTagKey(clusterName)]; !ok || sg.VpcId == nil || *sg.VpcId != vpcID {
+			continue
+		}
+		for _, v := range sg.Tags {
+			if v == "owned" {
+				result = append(result, id)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// DeleteELB deletes the classic load balancer.
+func (c *Client) DeleteELB(_ context.Context, name string) error {
+	return c.deleteLoadBalancer(false, name)
+}
+
+// DeleteELBV2 deletes the v2 load balancer.
+func (c *Client) DeleteELBV2(_ context.Context, arn string) error {
+	return c.deleteLoadBalancer(true, arn)
+}
+
+// FindKubernetesELBByDNSName returns the name of the classic load balancer with the DNS name owned by the cluster.
+func (c *Client) FindKubernetesELBByDNSName(_ context.Context, dnsName, clusterName string) (string, error) {
+	return c.findKubernetesLoadBalancerByDNSName(false, dnsName, clusterName), nil
+}
+
+// FindKubernetesELBV2ByDNSName returns the ARN of the v2 load balancer with the DNS name owned by the cluster.
+func (c *Client) FindKubernetesELBV2ByDNSName(_ context.Context, dnsName, clusterName string) (string, error) {
+	return c.findKubernetesLoadBalancerByDNSName(true, dnsName, clusterName), nil
+}
+
+// DrainELB deregisters the instances of the classic load balancer and returns true once they have been drained.
+func (c *Client) DrainELB(_ context.Context, name string) (bool, error) {
+	return c.drainLoadBalancer(false, name), nil
+}
+
+// DrainELBV2 deregisters the targets of the v2 load balancer and returns true once they have been drained.
+func (c *Client) DrainELBV2(_ context.Context, arn string) (bool, error) {
+	return c.drainLoadBalancer(true, arn), nil
+}
+
+func (c *Client) drainLoadBalancer(v2 bool, name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lb, ok := c.loadBalancers[name]
+	if !ok || lb.V2 != v2 {
+		return true
+	}
+	if lb.draining {
+		lb.draining = false
+		return false
+	}
+	if len(lb.Targets) > 0 {
+		lb.Targets = nil
+		lb.draining = true
+		return false
+	}
+	return true
+}
+
+func (c *Client) listKubernetesLoadBalancers(v2 bool, vpcID, clusterName string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []string
+	for _, name := range sortedKeys(c.loadBalancers) {
+		if lb := c.loadBalancers[name]; lb.V2 == v2 && lb.VpcID == vpcID && lb.Tags[clusterTagKey(clusterName)] == "owned" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func (c *Client) findKubernetesLoadBalancerByDNSName(v2 bool, dnsName, clusterName string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, name := range sortedKeys(c.loadBalancers) {
+		if lb := c.loadBalancers[name]; lb.V2 == v2 && strings.EqualFold(lb.DNSName, dnsName) && lb.Tags[clusterTagKey(clusterName)] == "owned" {
+			return name
+		}
+	}
+	return ""
+}
+
+func (c *Client) deleteLoadBalancer(v2 bool, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if lb, ok := c.loadBalancers[name]; ok && lb.V2 == v2 {
+		delete(c.loadBalancers, name)
+	}
+	return nil
+}
+
+func clusterTagKey(clusterName string) string {
+	return fmt.Sprintf("kubernetes.io/cluster/%s", clusterName)
+}
@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake contains a stateful in-memory implementation of the AWS client interface. It is meant to be used in
+// controller tests which need to run complete reconcile and delete cycles without talking to AWS.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/aws/smithy-go"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// DefaultAccountID is the account ID returned by GetAccountID if no other account ID is configured.
+const DefaultAccountID = "123456789012"
+
+// Client is an in-memory implementation of awsclient.Interface.
+// It keeps track of the created resources, supports filtering by tags and returns the same errors as AWS for
+// dependency violations and missing resources. Returned objects are copies and can be modified by the caller.
+type Client struct {
+	mu sync.Mutex
+
+	accountID        string
+	region           string
+	consistencyReads int
+
+	counters map[string]int
+	// hidden holds the number of describe calls newly created resources are still invisible for.
+	hidden map[string]int
+
+	dhcpOptions      map[string]*awsclient.DhcpOptions
+	vpcs             map[string]*awsclient.VPC
+	securityGroups   map[string]*awsclient.SecurityGroup
+	internetGateways map[string]*awsclient.InternetGateway
+	egressOnlyIGWs   map[string]*awsclient.EgressOnlyInternetGateway
+	flowLogs         map[string]*awsclient.FlowLog
+	networkAcls      map[string]*awsclient.NetworkAcl
+	vpcEndpoints     map[string]*awsclient.VpcEndpoint
+	routeTables      map[string]*awsclient.RouteTable
+	subnets          map[string]*awsclient.Subnet
+	interfaces       map[string]*awsclient.NetworkInterface
+	cidrReservations map[string][]string
+	elasticIPs       map[string]*awsclient.ElasticIP
+	natGateways      map[string]*awsclient.NATGateway
+	addressPools     map[string]int
+	keyPairs         map[string]*awsclient.KeyPairInfo
+
+	roles            map[string]*awsclient.IAMRole
+	instanceProfiles map[string]*awsclient.IAMInstanceProfile
+	rolePolicies     map[string]*awsclient.IAMRolePolicy
+	accessKeys       map[string]*awsclient.AccessKeyMetadata
+	deniedActions    sets.Set[string]
+	simulations      []awsclient.PolicySimulationContext
+
+	fileSystems       map[string]*efstypes.FileSystemDescription
+	mountTargets      map[string]*mountTarget
+	lifecyclePolicies map[string][]efstypes.LifecyclePolicy
+	backupPolicies    map[string]efstypes.Status
+	accessPoints      map[string]*efstypes.AccessPointDescription
+
+	fsxFileSystems map[string]*fsxFileSystem
+
+	buckets     map[string]*bucket
+	hostedZones map[string]*hostedZone
+
+	loadBalancers map[string]*LoadBalancer
+
+	serviceQuotas map[string]float64
+	instanceTypes map[string]int
+	instances     []instance
+}
+
+var _ awsclient.Interface = &Client{}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithAccountID sets the account ID of the fake.
+func WithAccountID(accountID string) Option {
+	return func(c *Client) {
+		c.accountID = accountID
+	}
+}
+
+// WithRegion sets the region used for availability zones and ARNs.
+func WithRegion(region string) Option {
+	return func(c *Client) {
+		c.region = region
+	}
+}
+
+// WithEventualConsistency simulates the eventual consistency of the EC2 API. Newly created EC2 resources are not
+// returned by the given number of describe calls (Get* and Find*) which would otherwise return them.
+func WithEventualConsistency(reads int) Option {
+	return func(c *Client) {
+		c.consistencyReads = reads
+	}
+}
+
+// New creates a new empty fake client.
+func New(opts ...Option) *Client {
+	c := &Client{
+		accountID: DefaultAccountID,
+		region:    "eu-west-1",
+		counters:  map[string]int{},
+		hidden:    map[string]int{},
+
+		dhcpOptions:      map[string]*awsclient.DhcpOptions{},
+		vpcs:             map[string]*awsclient.VPC{},
+		securityGroups:   map[string]*awsclient.SecurityGroup{},
+		internetGateways: map[string]*awsclient.InternetGateway{},
+		egressOnlyIGWs:   map[string]*awsclient.EgressOnlyInternetGateway{},
+		flowLogs:         map[string]*awsclient.FlowLog{},
+		networkAcls:      map[string]*awsclient.NetworkAcl{},
+		vpcEndpoints:     map[string]*awsclient.VpcEndpoint{},
+		routeTables:      map[string]*awsclient.RouteTable{},
+		subnets:          map[string]*awsclient.Subnet{},
+		interfaces:       map[string]*awsclient.NetworkInterface{},
+		cidrReservations: map[string][]string{},
+		elasticIPs:       map[string]*awsclient.ElasticIP{},
+		natGateways:      map[string]*awsclient.NATGateway{},
+		addressPools:     map[string]int{},
+		keyPairs:         map[string]*awsclient.KeyPairInfo{},
+
+		roles:            map[string]*awsclient.IAMRole{},
+		instanceProfiles: map[string]*awsclient.IAMInstanceProfile{},
+		rolePolicies:     map[string]*awsclient.IAMRolePolicy{},
+		accessKeys:       map[string]*awsclient.AccessKeyMetadata{},
+		deniedActions:    sets.New[string](),
+
+		fileSystems:       map[string]*efstypes.FileSystemDescription{},
+		mountTargets:      map[string]*mountTarget{},
+		lifecyclePolicies: map[string][]efstypes.LifecyclePolicy{},
+		backupPolicies:    map[string]efstypes.Status{},
+		accessPoints:      map[string]*efstypes.AccessPointDescription{},
+
+		fsxFileSystems: map[string]*fsxFileSystem{},
+
+		buckets:     map[string]*bucket{},
+		hostedZones: map[string]*hostedZone{},
+
+		loadBalancers: map[string]*LoadBalancer{},
+
+		serviceQuotas: map[string]float64{},
+		instanceTypes: map[string]int{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GetAccountID returns the configured account ID.
+func (c *Client) GetAccountID(_ context.Context) (string, error) {
+	return c.accountID, nil
+}
+
+// GetPartition returns the partition of the configured region.
+func (c *Client) GetPartition() string {
+	return awsclient.PartitionForRegion(c.region)
+}
+
+// ResourceIDs returns the sorted identifiers of all resources which still exist in the fake, excluding the default
+// security groups, main route tables and default network ACLs of existing VPCs. It is empty after a complete deletion.
+func (c *Client) ResourceIDs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var ids []string
+	add := func(kind, id string) {
+		ids = append(ids, kind+"/"+id)
+	}
+	for id := range c.dhcpOptions {
+		add("dhcp-options", id)
+	}
+	for id := range c.vpcs {
+		add("vpc", id)
+	}
+	for id, sg := range c.securityGroups {
+		if sg.GroupName != "default" {
+			add("security-group", id)
+		}
+	}
+	for id := range c.internetGateways {
+		add("internet-gateway", id)
+	}
+	for id := range c.egressOnlyIGWs {
+		add("egress-only-internet-gateway", id)
+	}
+	for id := range c.vpcEndpoints {
+		add("vpc-endpoint", id)
+	}
+	for id := range c.flowLogs {
+		add("flow-log", id)
+	}
+	for id, acl := range c.networkAcls {
+		if !acl.IsDefault {
+			add("network-acl", id)
+		}
+	}
+	for id, rt := range c.routeTables {
+		if !isMainRouteTable(rt) {
+			add("route-table", id)
+		}
+	}
+	for id := range c.subnets {
+		add("subnet", id)
+	}
+	for id := range c.interfaces {
+		add("network-interface", id)
+	}
+	for id := range c.elasticIPs {
+		add("elastic-ip", id)
+	}
+	for id := range c.natGateways {
+		add("nat-gateway", id)
+	}
+	for name := range c.keyPairs {
+		add("key-pair", name)
+	}
+	for name := range c.roles {
+		add("iam-role", name)
+	}
+	for name := range c.instanceProfiles {
+		add("iam-instance-profile", name)
+	}
+	for key := range c.rolePolicies {
+		add("iam-role-policy", key)
+	}
+	for id := range c.fileSystems {
+		add("efs-file-system", id)
+	}
+	for id := range c.mountTargets {
+		add("efs-mount-target", id)
+	}
+	for id := range c.accessPoints {
+		add("efs-access-point", id)
+	}
+	for id := range c.fsxFileSystems {
+		add("fsx-file-system", id)
+	}
+	for name := range c.buckets {
+		add("s3-bucket", name)
+	}
+	for name := range c.loadBalancers {
+		add("load-balancer", name)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// newID generates a new unique identifier with the given prefix, e.g. "vpc-00000000000000001".
+func (c *Client) newID(prefix string) string {
+	c.counters[prefix]++
+	return fmt.Sprintf("%s-%017x", prefix, c.counters[prefix])
+}
+
+// created marks a newly created resource as invisible for the configured number of describe calls.
+func (c *Client) created(id string) {
+	if c.consistencyReads > 0 {
+		c.hidden[id] = c.consistencyReads
+	}
+}
+
+// visible returns true if the resource can be returned by a describe call. Each call for a hidden resource counts
+// as one read.
+func (c *Client) visible(id string) bool {
+	n, ok := c.hidden[id]
+	if !ok {
+		return true
+	}
+	if n <= 1 {
+		delete(c.hidden, id)
+	} else {
+		c.hidden[id] = n - 1
+	}
+	return false
+}
+
+func apiError(code, format string, args ...any) error {
+	return &smithy.GenericAPIError{Code: code, Message: fmt.Sprintf(format, args...), Fault: smithy.FaultClient}
+}
+
+var notFoundCodes = map[string]string{
+	"dopt":     "InvalidDhcpOptionID.NotFound",
+	"vpc":      "InvalidVpcID.NotFound",
+	"subnet":   "InvalidSubnetID.NotFound",
+	"eni":      "InvalidNetworkInterfaceID.NotFound",
+	"sg":       "InvalidGroup.NotFound",
+	"igw":      "InvalidInternetGatewayID.NotFound",
+	"eigw":     "InvalidEgressOnlyInternetGatewayId.NotFound",
+	"vpce":     "InvalidVpcEndpointId.NotFound",
+	"rtb":      "InvalidRouteTableID.NotFound",
+	"rtbassoc": "InvalidAssociationID.NotFound",
+	"eipalloc": "InvalidAllocationID.NotFound",
+	"nat":      "NatGatewayNotFound",
+}
+
+// notFoundError returns the error AWS returns for a missing EC2 resource identified by id.
+func notFoundError(id string) error {
+	prefix, _, _ := strings.Cut(id, "-")
+	code, ok := notFoundCodes[prefix]
+	if !ok {
+		return apiError("InvalidID", "The ID '%s' is not valid", id)
+	}
+	return apiError(code, "The ID '%s' does not exist", id)
+}
+
+func dependencyViolation(format string, args ...any) error {
+	return apiError("DependencyViolation", format, args...)
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
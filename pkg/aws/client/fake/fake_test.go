@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/fake"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		ctx  = context.Background()
+		c    *Client
+		tags = awsclient.Tags{"kubernetes.io/cluster/shoot--foo--bar": "1"}
+	)
+
+	BeforeEach(func() {
+		c = New()
+	})
+
+	createVpc := func() *awsclient.VPC {
+		vpc, err := c.CreateVpc(ctx, &awsclient.VPC{Tags: tags, CidrBlock: "10.0.0.0/16"})
+		Expect(err).NotTo(HaveOccurred())
+		return vpc
+	}
+
+	createSubnet := func(vpcID, cidr string) *awsclient.Subnet {
+		subnet, err := c.CreateSubnet(ctx, &awsclient.Subnet{Tags: tags, VpcId: ptr.To(vpcID), CidrBlock: cidr, AvailabilityZone: "eu-west-1a"}, 0)
+		Expect(err).NotTo(HaveOccurred())
+		return subnet
+	}
+
+	It("should create a VPC with default security group and main route table", func() {
+		vpc := createVpc()
+
+		Expect(c.GetVpc(ctx, vpc.VpcId)).To(Equal(vpc))
+		sg, err := c.FindDefaultSecurityGroupByVpcId(ctx, vpc.VpcId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sg.GroupName).To(Equal("default"))
+		Expect(c.DeleteSecurityGroup(ctx, sg.GroupId)).To(MatchError(ContainSubstring("CannotDelete")))
+		Expect(c.ResourceIDs()).To(ConsistOf("vpc/" + vpc.VpcId))
+	})
+
+	It("should find resources by tags and filters", func() {
+		vpc := createVpc()
+		subnet := createSubnet(vpc.VpcId, "10.0.0.0/24")
+		_, err := c.CreateVpc(ctx, &awsclient.VPC{Tags: awsclient.Tags{"foo": "bar"}, CidrBlock: "10.1.0.0/16"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.FindVpcsByTags(ctx, tags)).To(ConsistOf(vpc))
+		Expect(c.FindSubnets(ctx, awsclient.WithFilters().WithVpcId(vpc.VpcId).WithTags(tags).Build())).To(ConsistOf(subnet))
+		Expect(c.FindSubnets(ctx, awsclient.WithFilters().WithVpcId("vpc-other").Build())).To(BeEmpty())
+		_, err = c.FindSubnets(ctx, []ec2types.Filter{{Name: ptr.To("unknown"), Values: []string{"x"}}})
+		Expect(awsclient.GetAWSAPIErrorCode(err)).To(Equal("InvalidParameterValue"))
+	})
+
+	It("should reject overlapping subnets", func() {
+		vpc := createVpc()
+		createSubnet(vpc.VpcId, "10.0.0.0/24")
+
+		_, err := c.CreateSubnet(ctx, &awsclient.Subnet{VpcId: ptr.To(vpc.VpcId), CidrBlock: "10.0.0.128/25"}, 0)
+		Expect(awsclient.GetAWSAPIErrorCode(err)).To(Equal("InvalidSubnet.Conflict"))
+		_, err = c.CreateSubnet(ctx, &awsclient.Subnet{VpcId: ptr.To(vpc.VpcId), CidrBlock: "10.1.0.0/24"}, 0)
+		Expect(awsclient.GetAWSAPIErrorCode(err)).To(Equal("InvalidSubnet.Range"))
+	})
+
+	It("should return dependency violations like AWS", func() {
+		vpc := createVpc()
+		subnet := createSubnet(vpc.VpcId, "10.0.0.0/24")
+		eip, err := c.CreateElasticIP(ctx, &awsclient.ElasticIP{Vpc: true})
+		Expect(err).NotTo(HaveOccurred())
+		nat, err := c.CreateNATGateway(ctx, &awsclient.NATGateway{SubnetId: subnet.SubnetId, EIPAllocationId: eip.AllocationId})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(awsclient.GetAWSAPIErrorCode(c.DeleteVpc(ctx, vpc.VpcId))).To(Equal("DependencyViolation"))
+		Expect(awsclient.GetAWSAPIErrorCode(c.DeleteSubnet(ctx, subnet.SubnetId))).To(Equal("DependencyViolation"))
+		Expect(awsclient.GetAWSAPIErrorCode(c.DeleteElasticIP(ctx, eip.AllocationId))).To(Equal("InvalidIPAddress.InUse"))
+
+		Expect(c.DeleteNATGateway(ctx, nat.NATGatewayId)).To(Succeed())
+		Expect(c.DeleteElasticIP(ctx, eip.AllocationId)).To(Succeed())
+		Expect(c.DeleteSubnet(ctx, subnet.SubnetId)).To(Succeed())
+		Expect(c.DeleteVpc(ctx, vpc.VpcId)).To(Succeed())
+		Expect(c.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should find network interfaces and security groups depending on subnets and security groups", func() {
+		vpc := createVpc()
+		subnet := createSubnet(vpc.VpcId, "10.0.0.0/24")
+		sg, err := c.CreateSecurityGroup(ctx, &awsclient.SecurityGroup{Tags: tags, VpcId: ptr.To(vpc.VpcId), GroupName: "nodes"})
+		Expect(err).NotTo(HaveOccurred())
+		foreign, err := c.CreateSecurityGroup(ctx, &awsclient.SecurityGroup{VpcId: ptr.To(vpc.VpcId), GroupName: "foreign"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.AuthorizeSecurityGroupRules(ctx, foreign.GroupId, []*awsclient.SecurityGroupRule{
+			{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "-1", Foreign: ptr.To(sg.GroupId)},
+		})).To(Succeed())
+		eniID := c.AddNetworkInterface(awsclient.NetworkInterface{SubnetId: subnet.SubnetId, InterfaceType: "lambda", RequesterId: ptr.To("lambda"), SecurityGroupIds: []string{sg.GroupId}})
+
+		enis, err := c.FindNetworkInterfaces(ctx, awsclient.WithFilters().WithVpcId(vpc.VpcId).Build())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enis).To(ConsistOf(HaveField("NetworkInterfaceId", eniID)))
+		Expect(enis[0].VpcId).To(Equal(vpc.VpcId))
+		Expect(enis[0].OwnerId).To(Equal(DefaultAccountID))
+		Expect(c.FindSecurityGroups(ctx, []ec2types.Filter{{Name: ptr.To("ip-permission.group-id"), Values: []string{sg.GroupId}}})).To(ConsistOf(HaveField("GroupId", foreign.GroupId)))
+		Expect(awsclient.GetAWSAPIErrorCode(c.DeleteSubnet(ctx, subnet.SubnetId))).To(Equal("DependencyViolation"))
+		Expect(awsclient.GetAWSAPIErrorCode(c.DeleteSecurityGroup(ctx, sg.GroupId))).To(Equal("DependencyViolation"))
+
+		c.RemoveNetworkInterface(eniID)
+		Expect(c.DeleteSecurityGroup(ctx, foreign.GroupId)).To(Succeed())
+		Expect(c.DeleteSecurityGroup(ctx, sg.GroupId)).To(Succeed())
+		Expect(c.DeleteSubnet(ctx, subnet.SubnetId)).To(Succeed())
+	})
+
+	It("should return not found errors which are recognized by the client package", func() {
+		err := c.AttachInternetGateway(ctx, "vpc-1", "igw-1")
+		Expect(awsclient.IsNotFoundError(err)).To(BeTrue())
+		Expect(c.GetVpc(ctx, "vpc-1")).To(BeNil())
+		Expect(c.DeleteVpc(ctx, "vpc-1")).To(Succeed())
+	})
+
+	It("should hide new resources from describe calls if eventual consistency is simulated", func() {
+		c = New(WithEventualConsistency(2))
+		vpc := createVpc()
+
+		Expect(c.GetVpc(ctx, vpc.VpcId)).To(BeNil())
+		Expect(c.FindVpcsByTags(ctx, tags)).To(BeEmpty())
+		Expect(c.GetVpc(ctx, vpc.VpcId)).To(Equal(vpc))
+	})
+
+	It("should add and remove routes of gateway endpoints", func() {
+		vpc := createVpc()
+		rt, err := c.CreateRouteTable(ctx, &awsclient.RouteTable{VpcId: ptr.To(vpc.VpcId)})
+		Expect(err).NotTo(HaveOccurred())
+		endpoint, err := c.CreateVpcEndpoint(ctx, &awsclient.VpcEndpoint{VpcId: ptr.To(vpc.VpcId), ServiceName: "com.amazonaws.eu-west-1.s3"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.CreateVpcEndpointRouteTableAssociation(ctx, rt.RouteTableId, endpoint.VpcEndpointId)).To(Succeed())
+		current, err := c.GetRouteTable(ctx, rt.RouteTableId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current.Routes).To(HaveLen(2))
+
+		Expect(c.DeleteVpcEndpoint(ctx, endpoint.VpcEndpointId)).To(Succeed())
+		current, err = c.GetRouteTable(ctx, rt.RouteTableId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current.Routes).To(ConsistOf(&awsclient.Route{DestinationCidrBlock: ptr.To("10.0.0.0/16"), GatewayId: ptr.To("local")}))
+	})
+})
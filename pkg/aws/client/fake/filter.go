@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// attributes maps the names of EC2 describe filters to the values of a resource.
+type attributes map[string][]string
+
+// matchFilters returns true if the resource with the given tags and attributes matches all filters.
+// Like AWS, multiple values of a filter are or-ed and multiple filters are and-ed.
+// An error is returned for filter names which are not supported for the resource.
+func matchFilters(filters []ec2types.Filter, tags awsclient.Tags, attrs attributes) (bool, error) {
+	for _, filter := range filters {
+		name := aws.ToString(filter.Name)
+		var values []string
+		switch {
+		case strings.HasPrefix(name, "tag:"):
+			if v, ok := tags[strings.TrimPrefix(name, "tag:")]; ok {
+				values = []string{v}
+			}
+		case name == "tag-key":
+			for k := range tags {
+				values = append(values, k)
+			}
+		case name == "tag-value":
+			for _, v := range tags {
+				values = append(values, v)
+			}
+		default:
+			var ok bool
+			if values, ok = attrs[name]; !ok {
+				return false, apiError("InvalidParameterValue", "The filter '%s' is invalid", name)
+			}
+		}
+		if !slices.ContainsFunc(filter.Values, func(v string) bool { return slices.Contains(values, v) }) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchTags returns true if the resource tags contain all given tags.
+func matchTags(tags, resourceTags awsclient.Tags) bool {
+	for k, v := range tags {
+		if rv, ok := resourceTags[k]; !ok || rv != v {
+			return false
+		}
+	}
+	return true
+}
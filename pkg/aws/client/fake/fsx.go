@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	"k8s.io/utils/ptr"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+type fsxFileSystem struct {
+	fsxtypes.FileSystem
+	securityGroups []string
+}
+
+// GetFSxFileSystem returns the FSx file system with the given ID or nil if not found.
+func (c *Client) GetFSxFileSystem(_ context.Context, fileSystemID string) (*fsxtypes.FileSystem, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if fs, ok := c.fsxFileSystems[fileSystemID]; ok {
+		return cloneFSxFileSystem(&fs.FileSystem), nil
+	}
+	return nil, nil
+}
+
+// FindFSxFileSystemsByTags returns all FSx file systems with the given tags.
+func (c *Client) FindFSxFileSystemsByTags(_ context.Context, tags awsclient.Tags) ([]*fsxtypes.FileSystem, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*fsxtypes.FileSystem
+	for _, id := range sortedKeys(c.fsxFileSystems) {
+		if fs := c.fsxFileSystems[id]; tags.ContainFSxTags(fs.Tags) {
+			result = append(result, cloneFSxFileSystem(&fs.FileSystem))
+		}
+	}
+	return result, nil
+}
+
+// CreateFSxFileSystem creates an FSx file system which is immediately available. The subnet and the security groups
+// must exist.
+func (c *Client) CreateFSxFileSystem(_ context.Context, input *fsx.CreateFileSystemInput) (*fsxtypes.FileSystem, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(input.SubnetIds) != 1 {
+		return nil, &fsxtypes.BadRequest{Message: ptr.To("exactly one subnet is supported")}
+	}
+	subnet, ok := c.subnets[input.SubnetIds[0]]
+	if !ok {
+		return nil, &fsxtypes.InvalidNetworkSettings{Message: ptr.To(fmt.Sprintf("subnet %s does not exist", input.SubnetIds[0]))}
+	}
+	for _, sgID := range input.SecurityGroupIds {
+		if _, ok := c.securityGroups[sgID]; !ok {
+			return nil, &fsxtypes.InvalidNetworkSettings{Message: ptr.To(fmt.Sprintf("security group %s does not exist", sgID))}
+		}
+	}
+
+	id := c.newID("fs")
+	fs := &fsxFileSystem{
+		FileSystem: fsxtypes.FileSystem{
+			CreationTime:    ptr.To(time.Now()),
+			DNSName:         ptr.To(fmt.Sprintf("%s.fsx.%s.amazonaws.com", id, c.region)),
+			FileSystemId:    ptr.To(id),
+			FileSystemType:  input.FileSystemType,
+			Lifecycle:       fsxtypes.FileSystemLifecycleAvailable,
+			OwnerId:         ptr.To(c.accountID),
+			ResourceARN:     ptr.To(fmt.Sprintf("arn:%s:fsx:%s:%s:file-system/%s", c.GetPartition(), c.region, c.accountID, id)),
+			StorageCapacity: clonePtr(input.StorageCapacity),
+			SubnetIds:       slices.Clone(input.SubnetIds),
+			Tags:            slices.Clone(input.Tags),
+			VpcId:           clonePtr(subnet.VpcId),
+		},
+		securityGroups: slices.Clone(input.SecurityGroupIds),
+	}
+	if input.LustreConfiguration != nil {
+		fs.LustreConfiguration = &fsxtypes.LustreFileSystemConfiguration{
+			DeploymentType:           input.LustreConfiguration.DeploymentType,
+			MountName:                ptr.To(fmt.Sprintf("%08x", c.counters["fs"])),
+			PerUnitStorageThroughput: clonePtr(input.LustreConfiguration.PerUnitStorageThroughput),
+		}
+	}
+	if input.OpenZFSConfiguration != nil {
+		fs.OpenZFSConfiguration = &fsxtypes.OpenZFSFileSystemConfiguration{
+			DeploymentType:     input.OpenZFSConfiguration.DeploymentType,
+			ThroughputCapacity: clonePtr(input.OpenZFSConfiguration.ThroughputCapacity),
+		}
+	}
+	c.fsxFileSystems[id] = fs
+	return cloneFSxFileSystem(&fs.FileSystem), nil
+}
+
+// DeleteFSxFileSystem deletes the FSx file system immediately. A missing file system is ignored.
+func (c *Client) DeleteFSxFileSystem(_ context.Context, fileSystemID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.fsxFileSystems, fileSystemID)
+	return nil
+}
+
+func cloneFSxFileSystem(in *fsxtypes.FileSystem) *fsxtypes.FileSystem {
+	out := *in
+	out.Tags = slices.Clone(in.Tags)
+	out.SubnetIds = slices.Clone(in.SubnetIds)
+	if in.LustreConfiguration != nil {
+		lustre := *in.LustreConfiguration
+		out.LustreConfiguration = &lustre
+	}
+	if in.OpenZFSConfiguration != nil {
+		openZFS := *in.OpenZFSConfiguration
+		out.OpenZFSConfiguration = &openZFS
+	}
+	return &out
+}
+
+// fsxFileSystemUses returns true if an FSx file system uses the given subnet or security group.
+func (c *Client) fsxFileSystemUses(id string) bool {
+	for _, fs := range c.fsxFileSystems {
+		if slices.Contains(fs.SubnetIds, id) || slices.Contains(fs.securityGroups, id) {
+			return true
+		}
+	}
+	return false
+}
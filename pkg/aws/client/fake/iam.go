@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"k8s.io/utils/ptr"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// CreateIAMRole creates an IAM role.
+func (c *Client) CreateIAMRole(_ context.Context, role *awsclient.IAMRole) (*awsclient.IAMRole, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.roles[role.RoleName]; ok {
+		return nil, &iamtypes.EntityAlreadyExistsException{Message: ptr.To(fmt.Sprintf("Role with name %s already exists.", role.RoleName))}
+	}
+	path := iamPath(role.Path)
+	created := &awsclient.IAMRole{
+		RoleId:                   iamID("AROA", c.newID("role")),
+		RoleName:                 role.RoleName,
+		Path:                     path,
+		AssumeRolePolicyDocument: role.AssumeRolePolicyDocument,
+		ARN:                      fmt.Sprintf("arn:%s:iam::%s:role%s%s", c.GetPartition(), c.accountID, path, role.RoleName),
+	}
+	c.roles[role.RoleName] = created
+	cp := *created
+	return &cp, nil
+}
+
+// GetIAMRole returns the IAM role with the given name or nil if not found.
+func (c *Client) GetIAMRole(_ context.Context, roleName string) (*awsclient.IAMRole, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if role, ok := c.roles[roleName]; ok {
+		cp := *role
+		return &cp, nil
+	}
+	return nil, nil
+}
+
+// DeleteIAMRole deletes the IAM role. It fails while the role has inline policies or is part of an instance profile.
+func (c *Client) DeleteIAMRole(_ context.Context, roleName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.roles[roleName]; !ok {
+		return nil
+	}
+	for _, policy := range c.rolePolicies {
+		if policy.RoleName == roleName {
+			return &iamtypes.DeleteConflictException{Message: ptr.To("Cannot delete entity, must delete policies first.")}
+		}
+	}
+	for _, profile := range c.instanceProfiles {
+		if profile.RoleName == roleName {
+			return &iamtypes.DeleteConflictException{Message: ptr.To("Cannot delete entity, must remove roles from instance profile first.")}
+		}
+	}
+	delete(c.roles, roleName)
+	return nil
+}
+
+// UpdateAssumeRolePolicy updates the assume role policy of the IAM role.
+func (c *Client) UpdateAssumeRolePolicy(_ context.Context, roleName, assumeRolePolicy string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	role, ok := c.roles[roleName]
+	if !ok {
+		return noSuchEntity("role", roleName)
+	}
+	role.AssumeRolePolicyDocument = assumeRolePolicy
+	return nil
+}
+
+// CreateIAMInstanceProfile creates an IAM instance profile without role.
+func (c *Client) CreateIAMInstanceProfile(_ context.Context, profile *awsclient.IAMInstanceProfile) (*awsclient.IAMInstanceProfile, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.instanceProfiles[profile.InstanceProfileName]; ok {
+		return nil, &iamtypes.EntityAlreadyExistsException{Message: ptr.To(fmt.Sprintf("Instance Profile %s already exists.", profile.InstanceProfileName))}
+	}
+	created := &awsclient.IAMInstanceProfile{
+		InstanceProfileId:   iamID("AIPA", c.newID("instance-profile")),
+		InstanceProfileName: profile.InstanceProfileName,
+		Path:                iamPath(profile.Path),
+	}
+	c.instanceProfiles[profile.InstanceProfileName] = created
+	cp := *created
+	return &cp, nil
+}
+
+// GetIAMInstanceProfile returns the IAM instance profile with the given name or nil if not found.
+func (c *Client) GetIAMInstanceProfile(_ context.Context, profileName string) (*awsclient.IAMInstanceProfile, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if profile, ok := c.instanceProfiles[profileName]; ok {
+		cp := *profile
+		return &cp, nil
+	}
+	return nil, nil
+}
+
+// DeleteIAMInstanceProfile deletes the IAM instance profile. It fails while the profile contains a role.
+func (c *Client) DeleteIAMInstanceProfile(_ context.Context, profileName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	profile, ok := c.instanceProfiles[profileName]
+	if !ok {
+		return nil
+	}
+	if profile.RoleName != "" {
+		return &iamtypes.DeleteConflictException{Message: ptr.To("Cannot delete entity, must remove roles from instance profile first.")}
+	}
+	delete(c.instanceProfiles, profileName)
+	return nil
+}
+
+// AddRoleToIAMInstanceProfile adds the role to the instance profile. An instance profile can contain only one role.
+func (c *Client) AddRoleToIAMInstanceProfile(_ context.Context, profileName, roleName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	profile, ok := c.instanceProfiles[profileName]
+	if !ok {
+		return noSuchEntity("instance profile", profileName)
+	}
+	if _, ok := c.roles[roleName]; !ok {
+		return noSuchEntity("role", roleName)
+	}
+	if profile.RoleName != "" {
+		return &iamtypes.LimitExceededException{Message: ptr.To("Cannot exceed quota for InstanceSessionsPerInstanceProfile: 1")}
+	}
+	profile.RoleName = roleName
+	return nil
+}
+
+// RemoveRoleFromIAMInstanceProfile removes the role from the instance profile.
+func (c *Client) RemoveRoleFromIAMInstanceProfile(_ context.Context, profileName, roleName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if profile, ok := c.instanceProfiles[profileName]; ok && profile.RoleName == roleName {
+		profile.RoleName = ""
+	}
+	return nil
+}
+
+// PutIAMRolePolicy creates or updates the inline policy of the role.
+func (c *Client) PutIAMRolePolicy(_ context.Context, policy *awsclient.IAMRolePolicy) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.roles[policy.RoleName]; !ok {
+		return noSuchEntity("role", policy.RoleName)
+	}
+	cp := *policy
+	c.rolePolicies[rolePolicyKey(policy.PolicyName, policy.RoleName)] = &cp
+	return nil
+}
+
+// GetIAMRolePolicy returns the inline policy of the role or nil if not found.
+func (c *Client) GetIAMRolePolicy(_ context.Context, policyName, roleName string) (*awsclient.IAMRolePolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if policy, ok := c.rolePolicies[rolePolicyKey(policyName, roleName)]; ok {
+		cp := *policy
+		return &cp, nil
+	}
+	return nil, nil
+}
+
+// DeleteIAMRolePolicy deletes the inline policy of the role.
+func (c *Client) DeleteIAMRolePolicy(_ context.Context, policyName, roleName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.rolePolicies, rolePolicyKey(policyName, roleName))
+	return nil
+}
+
+func (c *Client) roleARNExists(arn string) bool {
+	for _, role := range c.roles {
+		if role.ARN == arn {
+			return true
+		}
+	}
+	return false
+}
+
+func noSuchEntity(kind, name string) error {
+	return &iamtypes.NoSuchEntityException{Message: ptr.To(fmt.Sprintf("The %s with name %s cannot be found.", kind, name))}
+}
+
+func rolePolicyKey(policyName, roleName string) string {
+	return roleName + "/" + policyName
+}
+
+func iamPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// iamID converts a generated identifier to the upper case format of IAM unique IDs.
+func iamID(prefix, id string) string {
+	return prefix + strings.ToUpper(id[strings.LastIndex(id, "-")+1:])
+}
+
+// AddAccessKey adds the metadata of an access key to the fake. Access keys are created by the owner of the account and
+// not by the extension, so they can only be added with AddAccessKey.
+func (c *Client) AddAccessKey(metadata awsclient.AccessKeyMetadata) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.accessKeys[metadata.AccessKeyID] = &metadata
+}
+
+// GetAccessKeyMetadata returns the metadata of the access key with the given ID or nil if not found.
+func (c *Client) GetAccessKeyMetadata(_ context.Context, accessKeyID string) (*awsclient.AccessKeyMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if metadata, ok := c.accessKeys[accessKeyID]; ok {
+		cp := *metadata
+		return &cp, nil
+	}
+	return nil, nil
+}
+
+// DenyActions makes the policy simulation of the fake deny the given actions.
+func (c *Client) DenyActions(actions ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deniedActions.Insert(actions...)
+}
+
+// SimulationContexts returns the contexts of all policy simulations.
+func (c *Client) SimulationContexts() []awsclient.PolicySimulationContext {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.simulations)
+}
+
+// GetCallerPrincipalARN returns the ARN of a user in the configured account.
+func (c *Client) GetCallerPrincipalARN(_ context.Context) (string, error) {
+	return fmt.Sprintf("arn:%s:iam::%s:user/gardener", c.GetPartition(), c.accountID), nil
+}
+
+// SimulatePrincipalPolicy returns the given actions which were denied with DenyActions.
+func (c *Client) SimulatePrincipalPolicy(_ context.Context, _ string, actions []string, simulationContext awsclient.PolicySimulationContext) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.simulations = append(c.simulations, simulationContext)
+
+	var denied []string
+	for _, action := range actions {
+		if c.deniedActions.Has(action) {
+			denied = append(denied, action)
+		}
+	}
+	return denied, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+type hostedZone struct {
+	name    string
+	records map[string]*route53types.ResourceRecordSet
+}
+
+// CreateDNSHostedZone creates a hosted zone and returns its ID in the "/hostedzone/<id>" format of the Route53 API.
+func (c *Client) CreateDNSHostedZone(_ context.Context, name, _ string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counters["hostedzone"]++
+	id := fmt.Sprintf("Z%013X", c.counters["hostedzone"])
+	c.hostedZones[id] = &hostedZone{
+		name:    normalizeName(name),
+		records: map[string]*route53types.ResourceRecordSet{},
+	}
+	return "/hostedzone/" + id, nil
+}
+
+// DeleteDNSHostedZone deletes the hosted zone. It fails while the zone contains record sets.
+func (c *Client) DeleteDNSHostedZone(_ context.Context, zoneId string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := normalizeZoneId(zoneId)
+	zone, ok := c.hostedZones[id]
+	if !ok {
+		return nil
+	}
+	if len(zone.records) > 0 {
+		return &route53types.HostedZoneNotEmpty{Message: aws.String(fmt.Sprintf("The specified hosted zone %s contains non-required resource record sets", id))}
+	}
+	delete(c.hostedZones, id)
+	return nil
+}
+
+// GetDNSHostedZones returns the names of all hosted zones mapped to their IDs.
+func (c *Client) GetDNSHostedZones(_ context.Context) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	zones := map[string]string{}
+	for id, zone := range c.hostedZones {
+		zones[zone.name] = id
+	}
+	return zones, nil
+}
+
+// CreateOrUpdateDNSRecordSet creates or updates the record set. Load balancer alias targets are not simulated,
+// the record set is always stored with the given type and values.
+func (c *Client) CreateOrUpdateDNSRecordSet(_ context.Context, zoneId, name, recordType string, values []string, ttl int64, _ awsclient.IPStack) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	zone, err := c.hostedZone(zoneId)
+	if err != nil {
+		return err
+	}
+	rrs := &route53types.ResourceRecordSet{
+		Name: aws.String(normalizeName(name)),
+		Type: route53types.RRType(recordType),
+		TTL:  aws.Int64(ttl),
+	}
+	for _, value := range values {
+		rrs.ResourceRecords = append(rrs.ResourceRecords, route53types.ResourceRecord{Value: aws.String(value)})
+	}
+	zone.records[recordKey(name, recordType)] = rrs
+	return nil
+}
+
+// DeleteDNSRecordSet deletes the record set regardless of its values and TTL.
+func (c *Client) DeleteDNSRecordSet(_ context.Context, zoneId, name, recordType string, _ []string, _ int64, _ awsclient.IPStack) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	zone, err := c.hostedZone(zoneId)
+	if err != nil {
+		return err
+	}
+	delete(zone.records, recordKey(name, recordType))
+	return nil
+}
+
+// GetDNSRecordSets returns the record set with the given name and type.
+func (c *Client) GetDNSRecordSets(_ context.Context, zoneId, name, recordType string) ([]*route53types.ResourceRecordSet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	zone, err := c.hostedZone(zoneId)
+	if err != nil {
+		return nil, err
+	}
+	rrs, ok := zone.records[recordKey(name, recordType)]
+	if !ok {
+		return nil, nil
+	}
+	cp := *rrs
+	return []*route53types.ResourceRecordSet{&cp}, nil
+}
+
+func (c *Client) hostedZone(zoneId string) (*hostedZone, error) {
+	id := normalizeZoneId(zoneId)
+	zone, ok := c.hostedZones[id]
+	if !ok {
+		return nil, &route53types.NoSuchHostedZone{Message: aws.String(fmt.Sprintf("No hosted zone found with ID: %s", id))}
+	}
+	return zone, nil
+}
+
+func recordKey(name, recordType string) string {
+	return normalizeName(name) + "/" + recordType
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(name, ".")
+}
+
+func normalizeZoneId(zoneId string) string {
+	return zoneId[strings.LastIndex(zoneId, "/")+1:]
+}
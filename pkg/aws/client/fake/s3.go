@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+type bucket struct {
+	region     string
+	versioning bool
+	objectLock *s3types.ObjectLockConfiguration
+	objects    sets.Set[string]
+}
+
+// CreateBucket creates the bucket. Creating an existing bucket is a no-op. Object lock implies versioning.
+func (c *Client) CreateBucket(_ context.Context, name, region string, objectLockEnabled bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.buckets[name]; ok {
+		return nil
+	}
+	b := &bucket{region: region, objects: sets.New[string]()}
+	if objectLockEnabled {
+		b.versioning = true
+		b.objectLock = &s3types.ObjectLockConfiguration{ObjectLockEnabled: s3types.ObjectLockEnabledEnabled}
+	}
+	c.buckets[name] = b
+	return nil
+}
+
+// GetBucketVersioningStatus returns the versioning status of the bucket.
+func (c *Client) GetBucketVersioningStatus(_ context.Context, name string) (*s3.GetBucketVersioningOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[name]
+	if !ok {
+		return nil, noSuchBucket(name)
+	}
+	output := &s3.GetBucketVersioningOutput{}
+	if b.versioning {
+		output.Status = s3types.BucketVersioningStatusEnabled
+	}
+	return output, nil
+}
+
+// EnableBucketVersioning enables versioning of the bucket.
+func (c *Client) EnableBucketVersioning(_ context.Context, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[name]
+	if !ok {
+		return noSuchBucket(name)
+	}
+	b.versioning = true
+	return nil
+}
+
+// GetObjectLockConfiguration returns the object lock configuration of the bucket.
+func (c *Client) GetObjectLockConfiguration(_ context.Context, name string) (*s3.GetObjectLockConfigurationOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[name]
+	if !ok {
+		return nil, noSuchBucket(name)
+	}
+	if b.objectLock == nil {
+		return nil, apiError("ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket")
+	}
+	cp := *b.objectLock
+	if b.objectLock.Rule != nil && b.objectLock.Rule.DefaultRetention != nil {
+		retention := *b.objectLock.Rule.DefaultRetention
+		cp.Rule = &s3types.ObjectLockRule{DefaultRetention: &retention}
+	}
+	return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: &cp}, nil
+}
+
+// UpdateObjectLockConfiguration sets the default retention of the bucket. Object lock must be enabled for the
+// bucket, which requires versioning.
+func (c *Client) UpdateObjectLockConfiguration(_ context.Context, name string, mode apisaws.ModeType, days int32) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[name]
+	if !ok {
+		return noSuchBucket(name)
+	}
+	if !b.versioning {
+		return apiError("InvalidBucketState", "Versioning must be 'Enabled' on the bucket to apply a Object Lock configuration")
+	}
+	b.objectLock = &s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+		Rule: &s3types.ObjectLockRule{
+			DefaultRetention: &s3types.DefaultRetention{
+				Days: aws.Int32(days),
+				Mode: awsclient.GetBucketRetentiontMode(mode),
+			},
+		},
+	}
+	return nil
+}
+
+// RemoveObjectLockConfiguration removes the default retention of the bucket. Object lock stays enabled.
+func (c *Client) RemoveObjectLockConfiguration(_ context.Context, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[name]
+	if !ok {
+		return noSuchBucket(name)
+	}
+	if b.objectLock == nil {
+		return apiError("InvalidBucketState", "Object Lock configuration cannot be enabled on existing buckets")
+	}
+	b.objectLock = &s3types.ObjectLockConfiguration{ObjectLockEnabled: s3types.ObjectLockEnabledEnabled}
+	return nil
+}
+
+// DeleteObjectsWithPrefix deletes all objects with the given prefix. A missing bucket is ignored.
+func (c *Client) DeleteObjectsWithPrefix(_ context.Context, name, prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if b, ok := c.buckets[name]; ok {
+		for key := range b.objects {
+			if strings.HasPrefix(key, prefix) {
+				b.objects.Delete(key)
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteBucketIfExists deletes the bucket together with its objects. A missing bucket is ignored.
+func (c *Client) DeleteBucketIfExists(_ context.Context, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.buckets, name)
+	return nil
+}
+
+// PutObject adds an object to the bucket. It can be used to seed buckets in tests.
+func (c *Client) PutObject(name, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[name]
+	if !ok {
+		return noSuchBucket(name)
+	}
+	b.objects.Insert(key)
+	return nil
+}
+
+// ListObjects returns the sorted object keys of the bucket.
+func (c *Client) ListObjects(name string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[name]
+	if !ok {
+		return nil, noSuchBucket(name)
+	}
+	keys := b.objects.UnsortedList()
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func noSuchBucket(name string) error {
+	return apiError(awsclient.NoSuchBucket, "The specified bucket %s does not exist", name)
+}
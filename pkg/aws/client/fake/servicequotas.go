@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/utils/ptr"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+type instance struct {
+	instanceType string
+	tags         awsclient.Tags
+}
+
+// SetServiceQuota sets the value of a service quota. Service quotas which are not set do not exist.
+func (c *Client) SetServiceQuota(serviceCode, quotaCode string, value float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.serviceQuotas[serviceCode+"/"+quotaCode] = value
+}
+
+// AddInstanceType adds an instance type with the given number of vCPUs.
+func (c *Client) AddInstanceType(instanceType string, vcpus int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.instanceTypes[instanceType] = vcpus
+}
+
+// AddInstance adds a running on-demand instance of an instance type added with AddInstanceType. Instances are created
+// by the machine controller manager and not by the extension, so they can only be added with AddInstance.
+func (c *Client) AddInstance(instanceType string, tags awsclient.Tags) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.instances = append(c.instances, instance{instanceType: instanceType, tags: tags.Clone()})
+}
+
+// GetServiceQuota returns the value set with SetServiceQuota or nil.
+func (c *Client) GetServiceQuota(_ context.Context, serviceCode, quotaCode string) (*float64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if value, ok := c.serviceQuotas[serviceCode+"/"+quotaCode]; ok {
+		return ptr.To(value), nil
+	}
+	return nil, nil
+}
+
+// CountVpcs returns the number of VPCs.
+func (c *Client) CountVpcs(_ context.Context) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.vpcs), nil
+}
+
+// CountElasticIPs returns the number of elastic IPs.
+func (c *Client) CountElasticIPs(_ context.Context) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.elasticIPs), nil
+}
+
+// CountNATGatewaysByZone returns the number of pending and available NAT gateways per zone of their subnet.
+func (c *Client) CountNATGatewaysByZone(_ context.Context) (map[string]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counts := map[string]int{}
+	for _, nat := range c.natGateways {
+		if nat.State != string(ec2types.NatGatewayStatePending) && nat.State != string(ec2types.NatGatewayStateAvailable) {
+			continue
+		}
+		if subnet, ok := c.subnets[nat.SubnetId]; ok {
+			counts[subnet.AvailabilityZone]++
+		}
+	}
+	return counts, nil
+}
+
+// GetInstanceTypeVCPUs returns the vCPUs of the given instance types added with AddInstanceType.
+func (c *Client) GetInstanceTypeVCPUs(_ context.Context, instanceTypes []string) (map[string]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vcpus := map[string]int{}
+	for _, instanceType := range instanceTypes {
+		if v, ok := c.instanceTypes[instanceType]; ok {
+			vcpus[instanceType] = v
+		}
+	}
+	return vcpus, nil
+}
+
+// GetRunningOnDemandVCPUs returns the vCPUs per instance type of the instances added with AddInstance which have the
+// given tags.
+func (c *Client) GetRunningOnDemandVCPUs(_ context.Context, tags awsclient.Tags) (map[string]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vcpus := map[string]int{}
+	for _, inst := range c.instances {
+		if matchTags(tags, inst.tags) {
+			vcpus[inst.instanceType] += c.instanceTypes[inst.instanceType]
+		}
+	}
+	return vcpus, nil
+}
@@ -12,6 +12,14 @@ import (
 const (
 	// FilterVpcID is the name of the VPC ID filter.
 	FilterVpcID = "vpc-id"
+	// FilterSubnetID is the name of the subnet ID filter.
+	FilterSubnetID = "subnet-id"
+	// FilterGroupID is the name of the security group ID filter.
+	FilterGroupID = "group-id"
+	// FilterIngressGroupID is the name of the filter for security groups referenced by inbound rules.
+	FilterIngressGroupID = "ip-permission.group-id"
+	// FilterEgressGroupID is the name of the filter for security groups referenced by outbound rules.
+	FilterEgressGroupID = "egress.ip-permission.group-id"
 )
 
 type filterBuilder struct {
@@ -29,6 +37,12 @@ func (f filterBuilder) WithVpcId(vpcId string) filterBuilder {
 	return f
 }
 
+// With adds a filter with the given name and values to the builder. Nothing is added if no value is given.
+func (f filterBuilder) With(name string, values ...string) filterBuilder {
+	f.filters = append(f.filters, filter(name, values...)...)
+	return f
+}
+
 // WithTags adds a tags filter to the builder.
 func (f filterBuilder) WithTags(tags Tags) filterBuilder {
 	f.filters = append(f.filters, tags.ToFilters()...)
@@ -28,6 +28,15 @@ var _ = Describe("Client Filter", func() {
 		}))
 	})
 
+	It("#With", func() {
+		a := awsclient.WithFilters().With(awsclient.FilterSubnetID, "subnet-1", "subnet-2").With(awsclient.FilterGroupID).Build()
+
+		Expect(a).To(ConsistOf(ec2types.Filter{
+			Name:   aws.String(awsclient.FilterSubnetID),
+			Values: []string{"subnet-1", "subnet-2"},
+		}))
+	})
+
 	It("#WithTags", func() {
 		var tagTest = "test-tag"
 		var tagValue = "test-tag-value"
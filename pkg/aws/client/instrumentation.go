@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "provider_aws"
+	metricsSubsystem = "api"
+
+	// instrumentationName is the name of the OpenTelemetry tracer of the AWS client.
+	instrumentationName = "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var (
+	// callsTotal counts the calls of AWS API operations.
+	callsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "calls_total",
+		Help:      "Total number of AWS API calls, including calls which failed.",
+	}, []string{"service", "operation", "controller", "namespace"})
+	// errorsTotal counts the failed calls of AWS API operations by error code.
+	errorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "errors_total",
+		Help:      "Total number of failed AWS API calls by error code.",
+	}, []string{"service", "operation", "controller", "namespace", "code"})
+	// retriesTotal counts the retried attempts of AWS API calls.
+	retriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "retries_total",
+		Help:      "Total number of retried attempts of AWS API calls.",
+	}, []string{"service", "operation", "controller", "namespace"})
+	// throttlesTotal counts the attempts of AWS API calls which were throttled.
+	throttlesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "throttles_total",
+		Help:      "Total number of attempts of AWS API calls which were throttled.",
+	}, []string{"service", "operation", "controller", "namespace"})
+	// callDuration observes the duration of AWS API calls including all retries. It has no namespace label to limit the
+	// number of series.
+	callDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "call_duration_seconds",
+		Help:      "Duration of AWS API calls including all retries.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"service", "operation", "controller"})
+
+	isThrottle = retry.IsErrorThrottles(retry.DefaultThrottles)
+)
+
+func init() {
+	metrics.Registry.MustRegister(callsTotal, errorsTotal, retriesTotal, throttlesTotal, callDuration)
+}
+
+type callerKey struct{}
+
+// Caller identifies the controller and shoot on whose behalf AWS API calls are made. It labels the metrics and spans
+// of the calls.
+type Caller struct {
+	// Controller is the name of the controller, e.g. "infrastructure".
+	Controller string
+	// Namespace is the namespace of the shoot in the seed.
+	Namespace string
+}
+
+// WithCaller returns a copy of the given context which attributes the AWS API calls made with it to the given
+// controller and shoot namespace. The context is returned unchanged if it is already attributed to them.
+func WithCaller(ctx context.Context, controller, namespace string) context.Context {
+	caller := Caller{Controller: controller, Namespace: namespace}
+	if CallerFromContext(ctx) == caller {
+		return ctx
+	}
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the caller of the given context. It is empty if the context has none.
+func CallerFromContext(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+	return caller
+}
+
+// addInstrumentationMiddleware adds a middleware recording metrics and spans of every API call to the given stack. It
+// is added after the service metadata middleware, so that the service and operation are known, and wraps the retries.
+func addInstrumentationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+		"gardenerInstrumentation",
+		func(ctx context.Context, input middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			var (
+				caller    = CallerFromContext(ctx)
+				service   = awsmiddleware.GetServiceID(ctx)
+				operation = awsmiddleware.GetOperationName(ctx)
+				start     = time.Now()
+			)
+
+			ctx, span := otel.Tracer(instrumentationName).Start(ctx, fmt.Sprintf("%s.%s", service, operation),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("rpc.system", "aws-api"),
+					attribute.String("rpc.service", service),
+					attribute.String("rpc.method", operation),
+					attribute.String("gardener.controller", caller.Controller),
+					attribute.String("gardener.shoot.namespace", caller.Namespace),
+				),
+			)
+			defer span.End()
+
+			output, metadata, err := next.HandleInitialize(ctx, input)
+
+			callsTotal.WithLabelValues(service, operation, caller.Controller, caller.Namespace).Inc()
+			callDuration.WithLabelValues(service, operation, caller.Controller).Observe(time.Since(start).Seconds())
+
+			if results, ok := retry.GetAttemptResults(metadata); ok {
+				if retries := len(results.Results) - 1; retries > 0 {
+					retriesTotal.WithLabelValues(service, operation, caller.Controller, caller.Namespace).Add(float64(retries))
+					span.SetAttributes(attribute.Int("aws.retries", retries))
+				}
+				for _, result := range results.Results {
+					if result.Err != nil && isThrottle.IsErrorThrottle(result.Err).Bool() {
+						throttlesTotal.WithLabelValues(service, operation, caller.Controller, caller.Namespace).Inc()
+					}
+				}
+			}
+			if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				span.SetAttributes(attribute.String("aws.request_id", requestID))
+			}
+
+			if err != nil {
+				code := GetAWSAPIErrorCode(err)
+				if code == "" {
+					code = "Unknown"
+				}
+				errorsTotal.WithLabelValues(service, operation, caller.Controller, caller.Namespace, code).Inc()
+				span.SetAttributes(attribute.String("aws.error_code", code))
+				span.RecordError(err)
+				span.SetStatus(codes.Error, code)
+			}
+
+			return output, metadata, err
+		},
+	), middleware.After)
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	getCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/gardener</Arn>
+    <UserId>AIDAEXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata><RequestId>request-2</RequestId></ResponseMetadata>
+</GetCallerIdentityResponse>`
+	throttlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error>
+  <RequestId>request-1</RequestId>
+</ErrorResponse>`
+	accessDeniedResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error><Type>Sender</Type><Code>AccessDenied</Code><Message>Access denied</Message></Error>
+  <RequestId>request-1</RequestId>
+</ErrorResponse>`
+)
+
+var _ = Describe("Instrumentation", func() {
+	var (
+		ctx      context.Context
+		requests atomic.Int32
+		handler  http.HandlerFunc
+		server   *httptest.Server
+		client   *Client
+		exporter *tracetest.InMemoryExporter
+	)
+
+	BeforeEach(func() {
+		requests.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		exporter = tracetest.NewInMemoryExporter()
+		oldProvider := otel.GetTracerProvider()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+		DeferCleanup(func() { otel.SetTracerProvider(oldProvider) })
+
+		var err error
+		client, err = NewClient(AuthConfig{
+			Region:    "eu-west-1",
+			Endpoint:  server.URL,
+			AccessKey: &AccessKey{ID: "id", Secret: "secret"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should record calls, retries and throttles of the caller", func() {
+		ctx = WithCaller(context.Background(), "infrastructure", "shoot--instrumentation--success")
+		handler = func(w http.ResponseWriter, _ *http.Request) {
+			if requests.Load() == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(throttlingResponse))
+				return
+			}
+			w.Header().Set("X-Amzn-Requestid", "request-2")
+			_, _ = w.Write([]byte(getCallerIdentityResponse))
+		}
+
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		labels := map[string]string{"service": "STS", "operation": "GetCallerIdentity", "controller": "infrastructure", "namespace": "shoot--instrumentation--success"}
+		Expect(metricValue("provider_aws_api_calls_total", labels)).To(Equal(1.0))
+		Expect(metricValue("provider_aws_api_retries_total", labels)).To(Equal(1.0))
+		Expect(metricValue("provider_aws_api_throttles_total", labels)).To(Equal(1.0))
+		Expect(metricValue("provider_aws_api_errors_total", labels)).To(BeZero())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name).To(Equal("STS.GetCallerIdentity"))
+		Expect(spans[0].Attributes).To(ContainElements(
+			attribute.String("gardener.shoot.namespace", "shoot--instrumentation--success"),
+			attribute.String("aws.request_id", "request-2"),
+			attribute.Int("aws.retries", 1),
+		))
+	})
+
+	It("should record errors by code", func() {
+		ctx = WithCaller(context.Background(), "worker", "shoot--instrumentation--error")
+		handler = func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(accessDeniedResponse))
+		}
+
+		_, err := client.GetAccountID(ctx)
+		Expect(err).To(HaveOccurred())
+
+		labels := map[string]string{"service": "STS", "operation": "GetCallerIdentity", "controller": "worker", "namespace": "shoot--instrumentation--error"}
+		Expect(metricValue("provider_aws_api_calls_total", labels)).To(Equal(1.0))
+		Expect(metricValue("provider_aws_api_errors_total", labels, "code", "AccessDenied")).To(Equal(1.0))
+		Expect(metricValue("provider_aws_api_retries_total", labels)).To(BeZero())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Attributes).To(ContainElement(attribute.String("aws.error_code", "AccessDenied")))
+	})
+
+	It("should return an empty caller for contexts without caller", func() {
+		Expect(CallerFromContext(context.Background())).To(Equal(Caller{}))
+	})
+
+	It("should not wrap contexts which are already attributed to the caller", func() {
+		ctx = WithCaller(context.Background(), "dnsrecord", "shoot--foo--bar")
+		Expect(WithCaller(ctx, "dnsrecord", "shoot--foo--bar")).To(BeIdenticalTo(ctx))
+		Expect(CallerFromContext(WithCaller(ctx, "bastion", "shoot--foo--bar"))).To(Equal(Caller{Controller: "bastion", Namespace: "shoot--foo--bar"}))
+	})
+})
+
+// metricValue returns the value of the counter with the given name and labels, and the additional label pairs, from
+// the controller-runtime registry. It returns zero if there is no such counter.
+func metricValue(name string, labels map[string]string, extraLabels ...string) float64 {
+	want := map[string]string{}
+	for k, v := range labels {
+		want[k] = v
+	}
+	for i := 0; i+1 < len(extraLabels); i += 2 {
+		want[extraLabels[i]] = extraLabels[i+1]
+	}
+
+	families, err := metrics.Registry.Gather()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if hasLabels(metric, want) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func hasLabels(metric *dto.Metric, want map[string]string) bool {
+	matched := 0
+	for _, pair := range metric.GetLabel() {
+		if value, ok := want[pair.GetName()]; ok {
+			if value != pair.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+	return matched == len(want)
+}
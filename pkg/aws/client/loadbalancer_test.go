@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("Load balancers", func() {
+	DescribeTable("#LoadBalancerNameFromDNSName",
+		func(dnsName, name string) {
+			Expect(LoadBalancerNameFromDNSName(dnsName)).To(Equal(name))
+		},
+		Entry("classic load balancer", "a1b2c3d4e5f6-1234567890.eu-west-1.elb.amazonaws.com", "a1b2c3d4e5f6"),
+		Entry("internal classic load balancer", "internal-a1b2c3d4e5f6-1234567890.eu-west-1.elb.amazonaws.com", "a1b2c3d4e5f6"),
+		Entry("network load balancer", "k8s-default-svc-0123456789-0123456789abcdef.elb.eu-west-1.amazonaws.com", "k8s-default-svc-0123456789"),
+		Entry("China region", "a1b2c3d4e5f6-1234567890.cn-north-1.elb.amazonaws.com.cn", "a1b2c3d4e5f6"),
+		Entry("no load balancer", "example.com", ""),
+	)
+})
@@ -17,6 +17,8 @@ import (
 	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	efs "github.com/aws/aws-sdk-go-v2/service/efs"
 	types0 "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	fsx "github.com/aws/aws-sdk-go-v2/service/fsx"
+	types1 "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 	s3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	client "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
@@ -76,6 +78,20 @@ func (mr *MockInterfaceMockRecorder) AddVpcDhcpOptionAssociation(vpcId, dhcpOpti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpcDhcpOptionAssociation", reflect.TypeOf((*MockInterface)(nil).AddVpcDhcpOptionAssociation), vpcId, dhcpOptionsId)
 }
 
+// AssociateNATGatewayAddresses mocks base method.
+func (m *MockInterface) AssociateNATGatewayAddresses(ctx context.Context, id string, allocationIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateNATGatewayAddresses", ctx, id, allocationIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateNATGatewayAddresses indicates an expected call of AssociateNATGatewayAddresses.
+func (mr *MockInterfaceMockRecorder) AssociateNATGatewayAddresses(ctx, id, allocationIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateNATGatewayAddresses", reflect.TypeOf((*MockInterface)(nil).AssociateNATGatewayAddresses), ctx, id, allocationIDs)
+}
+
 // AttachInternetGateway mocks base method.
 func (m *MockInterface) AttachInternetGateway(ctx context.Context, vpcId, internetGatewayId string) error {
 	m.ctrl.T.Helper()
@@ -104,6 +120,66 @@ func (mr *MockInterfaceMockRecorder) AuthorizeSecurityGroupRules(ctx, id, rules
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupRules", reflect.TypeOf((*MockInterface)(nil).AuthorizeSecurityGroupRules), ctx, id, rules)
 }
 
+// CountElasticIPs mocks base method.
+func (m *MockInterface) CountElasticIPs(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountElasticIPs", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountElasticIPs indicates an expected call of CountElasticIPs.
+func (mr *MockInterfaceMockRecorder) CountElasticIPs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountElasticIPs", reflect.TypeOf((*MockInterface)(nil).CountElasticIPs), ctx)
+}
+
+// CountNATGatewaysByZone mocks base method.
+func (m *MockInterface) CountNATGatewaysByZone(ctx context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNATGatewaysByZone", ctx)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNATGatewaysByZone indicates an expected call of CountNATGatewaysByZone.
+func (mr *MockInterfaceMockRecorder) CountNATGatewaysByZone(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNATGatewaysByZone", reflect.TypeOf((*MockInterface)(nil).CountNATGatewaysByZone), ctx)
+}
+
+// CountVpcs mocks base method.
+func (m *MockInterface) CountVpcs(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountVpcs", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountVpcs indicates an expected call of CountVpcs.
+func (mr *MockInterfaceMockRecorder) CountVpcs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountVpcs", reflect.TypeOf((*MockInterface)(nil).CountVpcs), ctx)
+}
+
+// CreateAccessPoint mocks base method.
+func (m *MockInterface) CreateAccessPoint(ctx context.Context, input *efs.CreateAccessPointInput) (*types0.AccessPointDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessPoint", ctx, input)
+	ret0, _ := ret[0].(*types0.AccessPointDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessPoint indicates an expected call of CreateAccessPoint.
+func (mr *MockInterfaceMockRecorder) CreateAccessPoint(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessPoint", reflect.TypeOf((*MockInterface)(nil).CreateAccessPoint), ctx, input)
+}
+
 // CreateBucket mocks base method.
 func (m *MockInterface) CreateBucket(ctx context.Context, bucket, region string, objectLockEnabled bool) error {
 	m.ctrl.T.Helper()
@@ -177,6 +253,21 @@ func (mr *MockInterfaceMockRecorder) CreateElasticIP(ctx, eip any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateElasticIP", reflect.TypeOf((*MockInterface)(nil).CreateElasticIP), ctx, eip)
 }
 
+// CreateFSxFileSystem mocks base method.
+func (m *MockInterface) CreateFSxFileSystem(ctx context.Context, input *fsx.CreateFileSystemInput) (*types1.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFSxFileSystem", ctx, input)
+	ret0, _ := ret[0].(*types1.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFSxFileSystem indicates an expected call of CreateFSxFileSystem.
+func (mr *MockInterfaceMockRecorder) CreateFSxFileSystem(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFSxFileSystem", reflect.TypeOf((*MockInterface)(nil).CreateFSxFileSystem), ctx, input)
+}
+
 // CreateFileSystem mocks base method.
 func (m *MockInterface) CreateFileSystem(ctx context.Context, input *efs.CreateFileSystemInput) (*types0.FileSystemDescription, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +283,21 @@ func (mr *MockInterfaceMockRecorder) CreateFileSystem(ctx, input any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFileSystem", reflect.TypeOf((*MockInterface)(nil).CreateFileSystem), ctx, input)
 }
 
+// CreateFlowLog mocks base method.
+func (m *MockInterface) CreateFlowLog(ctx context.Context, flowLog *client.FlowLog) (*client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFlowLog", ctx, flowLog)
+	ret0, _ := ret[0].(*client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFlowLog indicates an expected call of CreateFlowLog.
+func (mr *MockInterfaceMockRecorder) CreateFlowLog(ctx, flowLog any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlowLog", reflect.TypeOf((*MockInterface)(nil).CreateFlowLog), ctx, flowLog)
+}
+
 // CreateIAMInstanceProfile mocks base method.
 func (m *MockInterface) CreateIAMInstanceProfile(ctx context.Context, profile *client.IAMInstanceProfile) (*client.IAMInstanceProfile, error) {
 	m.ctrl.T.Helper()
@@ -267,6 +373,35 @@ func (mr *MockInterfaceMockRecorder) CreateNATGateway(ctx, gateway any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNATGateway", reflect.TypeOf((*MockInterface)(nil).CreateNATGateway), ctx, gateway)
 }
 
+// CreateNetworkAcl mocks base method.
+func (m *MockInterface) CreateNetworkAcl(ctx context.Context, acl *client.NetworkAcl) (*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkAcl", ctx, acl)
+	ret0, _ := ret[0].(*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkAcl indicates an expected call of CreateNetworkAcl.
+func (mr *MockInterfaceMockRecorder) CreateNetworkAcl(ctx, acl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkAcl", reflect.TypeOf((*MockInterface)(nil).CreateNetworkAcl), ctx, acl)
+}
+
+// CreateNetworkAclEntry mocks base method.
+func (m *MockInterface) CreateNetworkAclEntry(ctx context.Context, id string, entry *client.NetworkAclEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkAclEntry", ctx, id, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetworkAclEntry indicates an expected call of CreateNetworkAclEntry.
+func (mr *MockInterfaceMockRecorder) CreateNetworkAclEntry(ctx, id, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkAclEntry", reflect.TypeOf((*MockInterface)(nil).CreateNetworkAclEntry), ctx, id, entry)
+}
+
 // CreateOrUpdateDNSRecordSet mocks base method.
 func (m *MockInterface) CreateOrUpdateDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack client.IPStack) error {
 	m.ctrl.T.Helper()
@@ -414,6 +549,20 @@ func (mr *MockInterfaceMockRecorder) CreateVpcEndpointRouteTableAssociation(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcEndpointRouteTableAssociation", reflect.TypeOf((*MockInterface)(nil).CreateVpcEndpointRouteTableAssociation), ctx, routeTableId, vpcEndpointId)
 }
 
+// DeleteAccessPoint mocks base method.
+func (m *MockInterface) DeleteAccessPoint(ctx context.Context, input *efs.DeleteAccessPointInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccessPoint", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccessPoint indicates an expected call of DeleteAccessPoint.
+func (mr *MockInterfaceMockRecorder) DeleteAccessPoint(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessPoint", reflect.TypeOf((*MockInterface)(nil).DeleteAccessPoint), ctx, input)
+}
+
 // DeleteBucketIfExists mocks base method.
 func (m *MockInterface) DeleteBucketIfExists(ctx context.Context, bucket string) error {
 	m.ctrl.T.Helper()
@@ -512,6 +661,20 @@ func (mr *MockInterfaceMockRecorder) DeleteElasticIP(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteElasticIP", reflect.TypeOf((*MockInterface)(nil).DeleteElasticIP), ctx, id)
 }
 
+// DeleteFSxFileSystem mocks base method.
+func (m *MockInterface) DeleteFSxFileSystem(ctx context.Context, fileSystemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFSxFileSystem", ctx, fileSystemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFSxFileSystem indicates an expected call of DeleteFSxFileSystem.
+func (mr *MockInterfaceMockRecorder) DeleteFSxFileSystem(ctx, fileSystemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFSxFileSystem", reflect.TypeOf((*MockInterface)(nil).DeleteFSxFileSystem), ctx, fileSystemID)
+}
+
 // DeleteFileSystem mocks base method.
 func (m *MockInterface) DeleteFileSystem(ctx context.Context, input *efs.DeleteFileSystemInput) error {
 	m.ctrl.T.Helper()
@@ -526,6 +689,20 @@ func (mr *MockInterfaceMockRecorder) DeleteFileSystem(ctx, input any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileSystem", reflect.TypeOf((*MockInterface)(nil).DeleteFileSystem), ctx, input)
 }
 
+// DeleteFlowLog mocks base method.
+func (m *MockInterface) DeleteFlowLog(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlowLog", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlowLog indicates an expected call of DeleteFlowLog.
+func (mr *MockInterfaceMockRecorder) DeleteFlowLog(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLog", reflect.TypeOf((*MockInterface)(nil).DeleteFlowLog), ctx, id)
+}
+
 // DeleteIAMInstanceProfile mocks base method.
 func (m *MockInterface) DeleteIAMInstanceProfile(ctx context.Context, profileName string) error {
 	m.ctrl.T.Helper()
@@ -624,6 +801,34 @@ func (mr *MockInterfaceMockRecorder) DeleteNATGateway(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNATGateway", reflect.TypeOf((*MockInterface)(nil).DeleteNATGateway), ctx, id)
 }
 
+// DeleteNetworkAcl mocks base method.
+func (m *MockInterface) DeleteNetworkAcl(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkAcl", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkAcl indicates an expected call of DeleteNetworkAcl.
+func (mr *MockInterfaceMockRecorder) DeleteNetworkAcl(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkAcl", reflect.TypeOf((*MockInterface)(nil).DeleteNetworkAcl), ctx, id)
+}
+
+// DeleteNetworkAclEntry mocks base method.
+func (m *MockInterface) DeleteNetworkAclEntry(ctx context.Context, id string, entry *client.NetworkAclEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkAclEntry", ctx, id, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkAclEntry indicates an expected call of DeleteNetworkAclEntry.
+func (mr *MockInterfaceMockRecorder) DeleteNetworkAclEntry(ctx, id, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkAclEntry", reflect.TypeOf((*MockInterface)(nil).DeleteNetworkAclEntry), ctx, id, entry)
+}
+
 // DeleteObjectsWithPrefix mocks base method.
 func (m *MockInterface) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
 	m.ctrl.T.Helper()
@@ -764,6 +969,51 @@ func (mr *MockInterfaceMockRecorder) DeleteVpcEndpointRouteTableAssociation(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpointRouteTableAssociation", reflect.TypeOf((*MockInterface)(nil).DeleteVpcEndpointRouteTableAssociation), ctx, routeTableId, vpcEndpointId)
 }
 
+// DescribeAccessPoints mocks base method.
+func (m *MockInterface) DescribeAccessPoints(ctx context.Context, input *efs.DescribeAccessPointsInput) ([]types0.AccessPointDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAccessPoints", ctx, input)
+	ret0, _ := ret[0].([]types0.AccessPointDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAccessPoints indicates an expected call of DescribeAccessPoints.
+func (mr *MockInterfaceMockRecorder) DescribeAccessPoints(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAccessPoints", reflect.TypeOf((*MockInterface)(nil).DescribeAccessPoints), ctx, input)
+}
+
+// DescribeBackupPolicy mocks base method.
+func (m *MockInterface) DescribeBackupPolicy(ctx context.Context, input *efs.DescribeBackupPolicyInput) (*types0.BackupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBackupPolicy", ctx, input)
+	ret0, _ := ret[0].(*types0.BackupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBackupPolicy indicates an expected call of DescribeBackupPolicy.
+func (mr *MockInterfaceMockRecorder) DescribeBackupPolicy(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBackupPolicy", reflect.TypeOf((*MockInterface)(nil).DescribeBackupPolicy), ctx, input)
+}
+
+// DescribeLifecycleConfiguration mocks base method.
+func (m *MockInterface) DescribeLifecycleConfiguration(ctx context.Context, input *efs.DescribeLifecycleConfigurationInput) ([]types0.LifecyclePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLifecycleConfiguration", ctx, input)
+	ret0, _ := ret[0].([]types0.LifecyclePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLifecycleConfiguration indicates an expected call of DescribeLifecycleConfiguration.
+func (mr *MockInterfaceMockRecorder) DescribeLifecycleConfiguration(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLifecycleConfiguration", reflect.TypeOf((*MockInterface)(nil).DescribeLifecycleConfiguration), ctx, input)
+}
+
 // DescribeMountTargetsEfs mocks base method.
 func (m *MockInterface) DescribeMountTargetsEfs(ctx context.Context, input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error) {
 	m.ctrl.T.Helper()
@@ -793,6 +1043,50 @@ func (mr *MockInterfaceMockRecorder) DetachInternetGateway(ctx, vpcId, internetG
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachInternetGateway", reflect.TypeOf((*MockInterface)(nil).DetachInternetGateway), ctx, vpcId, internetGatewayId)
 }
 
+// DisassociateNATGatewayAddresses mocks base method.
+func (m *MockInterface) DisassociateNATGatewayAddresses(ctx context.Context, id string, addresses []*client.NATGatewayAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateNATGatewayAddresses", ctx, id, addresses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisassociateNATGatewayAddresses indicates an expected call of DisassociateNATGatewayAddresses.
+func (mr *MockInterfaceMockRecorder) DisassociateNATGatewayAddresses(ctx, id, addresses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateNATGatewayAddresses", reflect.TypeOf((*MockInterface)(nil).DisassociateNATGatewayAddresses), ctx, id, addresses)
+}
+
+// DrainELB mocks base method.
+func (m *MockInterface) DrainELB(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainELB", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainELB indicates an expected call of DrainELB.
+func (mr *MockInterfaceMockRecorder) DrainELB(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainELB", reflect.TypeOf((*MockInterface)(nil).DrainELB), ctx, name)
+}
+
+// DrainELBV2 mocks base method.
+func (m *MockInterface) DrainELBV2(ctx context.Context, arn string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainELBV2", ctx, arn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainELBV2 indicates an expected call of DrainELBV2.
+func (mr *MockInterfaceMockRecorder) DrainELBV2(ctx, arn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainELBV2", reflect.TypeOf((*MockInterface)(nil).DrainELBV2), ctx, arn)
+}
+
 // EnableBucketVersioning mocks base method.
 func (m *MockInterface) EnableBucketVersioning(ctx context.Context, bucket string) error {
 	m.ctrl.T.Helper()
@@ -807,6 +1101,21 @@ func (mr *MockInterfaceMockRecorder) EnableBucketVersioning(ctx, bucket any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableBucketVersioning", reflect.TypeOf((*MockInterface)(nil).EnableBucketVersioning), ctx, bucket)
 }
 
+// FindDefaultNetworkAclByVpcId mocks base method.
+func (m *MockInterface) FindDefaultNetworkAclByVpcId(ctx context.Context, vpcId string) (*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDefaultNetworkAclByVpcId", ctx, vpcId)
+	ret0, _ := ret[0].(*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDefaultNetworkAclByVpcId indicates an expected call of FindDefaultNetworkAclByVpcId.
+func (mr *MockInterfaceMockRecorder) FindDefaultNetworkAclByVpcId(ctx, vpcId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultNetworkAclByVpcId", reflect.TypeOf((*MockInterface)(nil).FindDefaultNetworkAclByVpcId), ctx, vpcId)
+}
+
 // FindDefaultSecurityGroupByVpcId mocks base method.
 func (m *MockInterface) FindDefaultSecurityGroupByVpcId(ctx context.Context, vpcId string) (*client.SecurityGroup, error) {
 	m.ctrl.T.Helper()
@@ -867,6 +1176,21 @@ func (mr *MockInterfaceMockRecorder) FindElasticIPsByTags(ctx, tags any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindElasticIPsByTags", reflect.TypeOf((*MockInterface)(nil).FindElasticIPsByTags), ctx, tags)
 }
 
+// FindFSxFileSystemsByTags mocks base method.
+func (m *MockInterface) FindFSxFileSystemsByTags(ctx context.Context, tags client.Tags) ([]*types1.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFSxFileSystemsByTags", ctx, tags)
+	ret0, _ := ret[0].([]*types1.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFSxFileSystemsByTags indicates an expected call of FindFSxFileSystemsByTags.
+func (mr *MockInterfaceMockRecorder) FindFSxFileSystemsByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFSxFileSystemsByTags", reflect.TypeOf((*MockInterface)(nil).FindFSxFileSystemsByTags), ctx, tags)
+}
+
 // FindFileSystemsByTags mocks base method.
 func (m *MockInterface) FindFileSystemsByTags(ctx context.Context, tags client.Tags) ([]*types0.FileSystemDescription, error) {
 	m.ctrl.T.Helper()
@@ -882,6 +1206,36 @@ func (mr *MockInterfaceMockRecorder) FindFileSystemsByTags(ctx, tags any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFileSystemsByTags", reflect.TypeOf((*MockInterface)(nil).FindFileSystemsByTags), ctx, tags)
 }
 
+// FindFlowLogsByResourceID mocks base method.
+func (m *MockInterface) FindFlowLogsByResourceID(ctx context.Context, resourceID string) ([]*client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFlowLogsByResourceID", ctx, resourceID)
+	ret0, _ := ret[0].([]*client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFlowLogsByResourceID indicates an expected call of FindFlowLogsByResourceID.
+func (mr *MockInterfaceMockRecorder) FindFlowLogsByResourceID(ctx, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlowLogsByResourceID", reflect.TypeOf((*MockInterface)(nil).FindFlowLogsByResourceID), ctx, resourceID)
+}
+
+// FindFlowLogsByTags mocks base method.
+func (m *MockInterface) FindFlowLogsByTags(ctx context.Context, tags client.Tags) ([]*client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFlowLogsByTags", ctx, tags)
+	ret0, _ := ret[0].([]*client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFlowLogsByTags indicates an expected call of FindFlowLogsByTags.
+func (mr *MockInterfaceMockRecorder) FindFlowLogsByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlowLogsByTags", reflect.TypeOf((*MockInterface)(nil).FindFlowLogsByTags), ctx, tags)
+}
+
 // FindInternetGatewayByVPC mocks base method.
 func (m *MockInterface) FindInternetGatewayByVPC(ctx context.Context, vpcId string) (*client.InternetGateway, error) {
 	m.ctrl.T.Helper()
@@ -912,6 +1266,36 @@ func (mr *MockInterfaceMockRecorder) FindInternetGatewaysByTags(ctx, tags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInternetGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindInternetGatewaysByTags), ctx, tags)
 }
 
+// FindKubernetesELBByDNSName mocks base method.
+func (m *MockInterface) FindKubernetesELBByDNSName(ctx context.Context, dnsName, clusterName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKubernetesELBByDNSName", ctx, dnsName, clusterName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKubernetesELBByDNSName indicates an expected call of FindKubernetesELBByDNSName.
+func (mr *MockInterfaceMockRecorder) FindKubernetesELBByDNSName(ctx, dnsName, clusterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKubernetesELBByDNSName", reflect.TypeOf((*MockInterface)(nil).FindKubernetesELBByDNSName), ctx, dnsName, clusterName)
+}
+
+// FindKubernetesELBV2ByDNSName mocks base method.
+func (m *MockInterface) FindKubernetesELBV2ByDNSName(ctx context.Context, dnsName, clusterName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKubernetesELBV2ByDNSName", ctx, dnsName, clusterName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKubernetesELBV2ByDNSName indicates an expected call of FindKubernetesELBV2ByDNSName.
+func (mr *MockInterfaceMockRecorder) FindKubernetesELBV2ByDNSName(ctx, dnsName, clusterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKubernetesELBV2ByDNSName", reflect.TypeOf((*MockInterface)(nil).FindKubernetesELBV2ByDNSName), ctx, dnsName, clusterName)
+}
+
 // FindNATGateways mocks base method.
 func (m *MockInterface) FindNATGateways(ctx context.Context, filters []types.Filter) ([]*client.NATGateway, error) {
 	m.ctrl.T.Helper()
@@ -942,6 +1326,51 @@ func (mr *MockInterfaceMockRecorder) FindNATGatewaysByTags(ctx, tags any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNATGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindNATGatewaysByTags), ctx, tags)
 }
 
+// FindNetworkAclBySubnetId mocks base method.
+func (m *MockInterface) FindNetworkAclBySubnetId(ctx context.Context, subnetId string) (*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNetworkAclBySubnetId", ctx, subnetId)
+	ret0, _ := ret[0].(*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNetworkAclBySubnetId indicates an expected call of FindNetworkAclBySubnetId.
+func (mr *MockInterfaceMockRecorder) FindNetworkAclBySubnetId(ctx, subnetId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNetworkAclBySubnetId", reflect.TypeOf((*MockInterface)(nil).FindNetworkAclBySubnetId), ctx, subnetId)
+}
+
+// FindNetworkAclsByTags mocks base method.
+func (m *MockInterface) FindNetworkAclsByTags(ctx context.Context, tags client.Tags) ([]*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNetworkAclsByTags", ctx, tags)
+	ret0, _ := ret[0].([]*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNetworkAclsByTags indicates an expected call of FindNetworkAclsByTags.
+func (mr *MockInterfaceMockRecorder) FindNetworkAclsByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNetworkAclsByTags", reflect.TypeOf((*MockInterface)(nil).FindNetworkAclsByTags), ctx, tags)
+}
+
+// FindNetworkInterfaces mocks base method.
+func (m *MockInterface) FindNetworkInterfaces(ctx context.Context, filters []types.Filter) ([]*client.NetworkInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNetworkInterfaces", ctx, filters)
+	ret0, _ := ret[0].([]*client.NetworkInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNetworkInterfaces indicates an expected call of FindNetworkInterfaces.
+func (mr *MockInterfaceMockRecorder) FindNetworkInterfaces(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNetworkInterfaces", reflect.TypeOf((*MockInterface)(nil).FindNetworkInterfaces), ctx, filters)
+}
+
 // FindRouteTablesByTags mocks base method.
 func (m *MockInterface) FindRouteTablesByTags(ctx context.Context, tags client.Tags) ([]*client.RouteTable, error) {
 	m.ctrl.T.Helper()
@@ -957,6 +1386,21 @@ func (mr *MockInterfaceMockRecorder) FindRouteTablesByTags(ctx, tags any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRouteTablesByTags", reflect.TypeOf((*MockInterface)(nil).FindRouteTablesByTags), ctx, tags)
 }
 
+// FindSecurityGroups mocks base method.
+func (m *MockInterface) FindSecurityGroups(ctx context.Context, filters []types.Filter) ([]*client.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSecurityGroups", ctx, filters)
+	ret0, _ := ret[0].([]*client.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSecurityGroups indicates an expected call of FindSecurityGroups.
+func (mr *MockInterfaceMockRecorder) FindSecurityGroups(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroups", reflect.TypeOf((*MockInterface)(nil).FindSecurityGroups), ctx, filters)
+}
+
 // FindSecurityGroupsByTags mocks base method.
 func (m *MockInterface) FindSecurityGroupsByTags(ctx context.Context, tags client.Tags) ([]*client.SecurityGroup, error) {
 	m.ctrl.T.Helper()
@@ -1032,6 +1476,21 @@ func (mr *MockInterfaceMockRecorder) FindVpcsByTags(ctx, tags any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcsByTags), ctx, tags)
 }
 
+// GetAccessKeyMetadata mocks base method.
+func (m *MockInterface) GetAccessKeyMetadata(ctx context.Context, accessKeyID string) (*client.AccessKeyMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessKeyMetadata", ctx, accessKeyID)
+	ret0, _ := ret[0].(*client.AccessKeyMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessKeyMetadata indicates an expected call of GetAccessKeyMetadata.
+func (mr *MockInterfaceMockRecorder) GetAccessKeyMetadata(ctx, accessKeyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessKeyMetadata", reflect.TypeOf((*MockInterface)(nil).GetAccessKeyMetadata), ctx, accessKeyID)
+}
+
 // GetAccountID mocks base method.
 func (m *MockInterface) GetAccountID(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -1062,6 +1521,21 @@ func (mr *MockInterfaceMockRecorder) GetBucketVersioningStatus(ctx, bucket any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketVersioningStatus", reflect.TypeOf((*MockInterface)(nil).GetBucketVersioningStatus), ctx, bucket)
 }
 
+// GetCallerPrincipalARN mocks base method.
+func (m *MockInterface) GetCallerPrincipalARN(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCallerPrincipalARN", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCallerPrincipalARN indicates an expected call of GetCallerPrincipalARN.
+func (mr *MockInterfaceMockRecorder) GetCallerPrincipalARN(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCallerPrincipalARN", reflect.TypeOf((*MockInterface)(nil).GetCallerPrincipalARN), ctx)
+}
+
 // GetDHCPOptions mocks base method.
 func (m *MockInterface) GetDHCPOptions(ctx context.Context, vpcID string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -1137,6 +1611,21 @@ func (mr *MockInterfaceMockRecorder) GetElasticIPsAssociationIDForAllocationIDs(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticIPsAssociationIDForAllocationIDs", reflect.TypeOf((*MockInterface)(nil).GetElasticIPsAssociationIDForAllocationIDs), ctx, allocationIDs)
 }
 
+// GetFSxFileSystem mocks base method.
+func (m *MockInterface) GetFSxFileSystem(ctx context.Context, fileSystemID string) (*types1.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFSxFileSystem", ctx, fileSystemID)
+	ret0, _ := ret[0].(*types1.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFSxFileSystem indicates an expected call of GetFSxFileSystem.
+func (mr *MockInterfaceMockRecorder) GetFSxFileSystem(ctx, fileSystemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFSxFileSystem", reflect.TypeOf((*MockInterface)(nil).GetFSxFileSystem), ctx, fileSystemID)
+}
+
 // GetFileSystem mocks base method.
 func (m *MockInterface) GetFileSystem(ctx context.Context, fileSystemID string) (*types0.FileSystemDescription, error) {
 	m.ctrl.T.Helper()
@@ -1152,6 +1641,21 @@ func (mr *MockInterfaceMockRecorder) GetFileSystem(ctx, fileSystemID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystem", reflect.TypeOf((*MockInterface)(nil).GetFileSystem), ctx, fileSystemID)
 }
 
+// GetFlowLog mocks base method.
+func (m *MockInterface) GetFlowLog(ctx context.Context, id string) (*client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlowLog", ctx, id)
+	ret0, _ := ret[0].(*client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlowLog indicates an expected call of GetFlowLog.
+func (mr *MockInterfaceMockRecorder) GetFlowLog(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlowLog", reflect.TypeOf((*MockInterface)(nil).GetFlowLog), ctx, id)
+}
+
 // GetIAMInstanceProfile mocks base method.
 func (m *MockInterface) GetIAMInstanceProfile(ctx context.Context, profileName string) (*client.IAMInstanceProfile, error) {
 	m.ctrl.T.Helper()
@@ -1197,6 +1701,21 @@ func (mr *MockInterfaceMockRecorder) GetIAMRolePolicy(ctx, policyName, roleName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMRolePolicy", reflect.TypeOf((*MockInterface)(nil).GetIAMRolePolicy), ctx, policyName, roleName)
 }
 
+// GetIPAMPoolFreeAddressCount mocks base method.
+func (m *MockInterface) GetIPAMPoolFreeAddressCount(ctx context.Context, poolID string) (*int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPAMPoolFreeAddressCount", ctx, poolID)
+	ret0, _ := ret[0].(*int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPAMPoolFreeAddressCount indicates an expected call of GetIPAMPoolFreeAddressCount.
+func (mr *MockInterfaceMockRecorder) GetIPAMPoolFreeAddressCount(ctx, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPAMPoolFreeAddressCount", reflect.TypeOf((*MockInterface)(nil).GetIPAMPoolFreeAddressCount), ctx, poolID)
+}
+
 // GetIPv6CIDRReservations mocks base method.
 func (m *MockInterface) GetIPv6CIDRReservations(ctx context.Context, subnet *client.Subnet) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1227,6 +1746,21 @@ func (mr *MockInterfaceMockRecorder) GetIPv6Cidr(ctx, vpcID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPv6Cidr", reflect.TypeOf((*MockInterface)(nil).GetIPv6Cidr), ctx, vpcID)
 }
 
+// GetInstanceTypeVCPUs mocks base method.
+func (m *MockInterface) GetInstanceTypeVCPUs(ctx context.Context, instanceTypes []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeVCPUs", ctx, instanceTypes)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTypeVCPUs indicates an expected call of GetInstanceTypeVCPUs.
+func (mr *MockInterfaceMockRecorder) GetInstanceTypeVCPUs(ctx, instanceTypes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeVCPUs", reflect.TypeOf((*MockInterface)(nil).GetInstanceTypeVCPUs), ctx, instanceTypes)
+}
+
 // GetInternetGateway mocks base method.
 func (m *MockInterface) GetInternetGateway(ctx context.Context, id string) (*client.InternetGateway, error) {
 	m.ctrl.T.Helper()
@@ -1287,6 +1821,21 @@ func (mr *MockInterfaceMockRecorder) GetNATGatewayAddressAllocations(ctx, shootN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNATGatewayAddressAllocations", reflect.TypeOf((*MockInterface)(nil).GetNATGatewayAddressAllocations), ctx, shootNamespace)
 }
 
+// GetNetworkAcl mocks base method.
+func (m *MockInterface) GetNetworkAcl(ctx context.Context, id string) (*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkAcl", ctx, id)
+	ret0, _ := ret[0].(*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkAcl indicates an expected call of GetNetworkAcl.
+func (mr *MockInterfaceMockRecorder) GetNetworkAcl(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkAcl", reflect.TypeOf((*MockInterface)(nil).GetNetworkAcl), ctx, id)
+}
+
 // GetObjectLockConfiguration mocks base method.
 func (m *MockInterface) GetObjectLockConfiguration(ctx context.Context, bucket string) (*s3.GetObjectLockConfigurationOutput, error) {
 	m.ctrl.T.Helper()
@@ -1302,6 +1851,35 @@ func (mr *MockInterfaceMockRecorder) GetObjectLockConfiguration(ctx, bucket any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectLockConfiguration", reflect.TypeOf((*MockInterface)(nil).GetObjectLockConfiguration), ctx, bucket)
 }
 
+// GetPartition mocks base method.
+func (m *MockInterface) GetPartition() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartition")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPartition indicates an expected call of GetPartition.
+func (mr *MockInterfaceMockRecorder) GetPartition() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartition", reflect.TypeOf((*MockInterface)(nil).GetPartition))
+}
+
+// GetPublicIPv4PoolFreeAddressCount mocks base method.
+func (m *MockInterface) GetPublicIPv4PoolFreeAddressCount(ctx context.Context, poolID string) (*int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicIPv4PoolFreeAddressCount", ctx, poolID)
+	ret0, _ := ret[0].(*int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicIPv4PoolFreeAddressCount indicates an expected call of GetPublicIPv4PoolFreeAddressCount.
+func (mr *MockInterfaceMockRecorder) GetPublicIPv4PoolFreeAddressCount(ctx, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicIPv4PoolFreeAddressCount", reflect.TypeOf((*MockInterface)(nil).GetPublicIPv4PoolFreeAddressCount), ctx, poolID)
+}
+
 // GetRouteTable mocks base method.
 func (m *MockInterface) GetRouteTable(ctx context.Context, id string) (*client.RouteTable, error) {
 	m.ctrl.T.Helper()
@@ -1332,6 +1910,21 @@ func (mr *MockInterfaceMockRecorder) GetRouteTableAssociationIDs(ctx, vpc, subne
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteTableAssociationIDs", reflect.TypeOf((*MockInterface)(nil).GetRouteTableAssociationIDs), ctx, vpc, subnetIDs)
 }
 
+// GetRunningOnDemandVCPUs mocks base method.
+func (m *MockInterface) GetRunningOnDemandVCPUs(ctx context.Context, tags client.Tags) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningOnDemandVCPUs", ctx, tags)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningOnDemandVCPUs indicates an expected call of GetRunningOnDemandVCPUs.
+func (mr *MockInterfaceMockRecorder) GetRunningOnDemandVCPUs(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningOnDemandVCPUs", reflect.TypeOf((*MockInterface)(nil).GetRunningOnDemandVCPUs), ctx, tags)
+}
+
 // GetSecurityGroup mocks base method.
 func (m *MockInterface) GetSecurityGroup(ctx context.Context, id string) (*client.SecurityGroup, error) {
 	m.ctrl.T.Helper()
@@ -1347,6 +1940,21 @@ func (mr *MockInterfaceMockRecorder) GetSecurityGroup(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroup", reflect.TypeOf((*MockInterface)(nil).GetSecurityGroup), ctx, id)
 }
 
+// GetServiceQuota mocks base method.
+func (m *MockInterface) GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceQuota", ctx, serviceCode, quotaCode)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceQuota indicates an expected call of GetServiceQuota.
+func (mr *MockInterfaceMockRecorder) GetServiceQuota(ctx, serviceCode, quotaCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceQuota", reflect.TypeOf((*MockInterface)(nil).GetServiceQuota), ctx, serviceCode, quotaCode)
+}
+
 // GetSubnets mocks base method.
 func (m *MockInterface) GetSubnets(ctx context.Context, ids []string) ([]*client.Subnet, error) {
 	m.ctrl.T.Helper()
@@ -1497,6 +2105,20 @@ func (mr *MockInterfaceMockRecorder) ListKubernetesSecurityGroups(ctx, vpcID, cl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSecurityGroups", reflect.TypeOf((*MockInterface)(nil).ListKubernetesSecurityGroups), ctx, vpcID, clusterName)
 }
 
+// PutBackupPolicy mocks base method.
+func (m *MockInterface) PutBackupPolicy(ctx context.Context, input *efs.PutBackupPolicyInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutBackupPolicy", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutBackupPolicy indicates an expected call of PutBackupPolicy.
+func (mr *MockInterfaceMockRecorder) PutBackupPolicy(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBackupPolicy", reflect.TypeOf((*MockInterface)(nil).PutBackupPolicy), ctx, input)
+}
+
 // PutIAMRolePolicy mocks base method.
 func (m *MockInterface) PutIAMRolePolicy(ctx context.Context, policy *client.IAMRolePolicy) error {
 	m.ctrl.T.Helper()
@@ -1511,6 +2133,20 @@ func (mr *MockInterfaceMockRecorder) PutIAMRolePolicy(ctx, policy any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutIAMRolePolicy", reflect.TypeOf((*MockInterface)(nil).PutIAMRolePolicy), ctx, policy)
 }
 
+// PutLifecycleConfiguration mocks base method.
+func (m *MockInterface) PutLifecycleConfiguration(ctx context.Context, input *efs.PutLifecycleConfigurationInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutLifecycleConfiguration", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutLifecycleConfiguration indicates an expected call of PutLifecycleConfiguration.
+func (mr *MockInterfaceMockRecorder) PutLifecycleConfiguration(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecycleConfiguration", reflect.TypeOf((*MockInterface)(nil).PutLifecycleConfiguration), ctx, input)
+}
+
 // RemoveObjectLockConfiguration mocks base method.
 func (m *MockInterface) RemoveObjectLockConfiguration(ctx context.Context, bucket string) error {
 	m.ctrl.T.Helper()
@@ -1539,6 +2175,35 @@ func (mr *MockInterfaceMockRecorder) RemoveRoleFromIAMInstanceProfile(ctx, profi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoleFromIAMInstanceProfile", reflect.TypeOf((*MockInterface)(nil).RemoveRoleFromIAMInstanceProfile), ctx, profileName, roleName)
 }
 
+// ReplaceNetworkAclAssociation mocks base method.
+func (m *MockInterface) ReplaceNetworkAclAssociation(ctx context.Context, associationId, networkAclId string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNetworkAclAssociation", ctx, associationId, networkAclId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceNetworkAclAssociation indicates an expected call of ReplaceNetworkAclAssociation.
+func (mr *MockInterfaceMockRecorder) ReplaceNetworkAclAssociation(ctx, associationId, networkAclId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkAclAssociation", reflect.TypeOf((*MockInterface)(nil).ReplaceNetworkAclAssociation), ctx, associationId, networkAclId)
+}
+
+// ReplaceNetworkAclEntry mocks base method.
+func (m *MockInterface) ReplaceNetworkAclEntry(ctx context.Context, id string, entry *client.NetworkAclEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNetworkAclEntry", ctx, id, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceNetworkAclEntry indicates an expected call of ReplaceNetworkAclEntry.
+func (mr *MockInterfaceMockRecorder) ReplaceNetworkAclEntry(ctx, id, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkAclEntry", reflect.TypeOf((*MockInterface)(nil).ReplaceNetworkAclEntry), ctx, id, entry)
+}
+
 // RevokeSecurityGroupRules mocks base method.
 func (m *MockInterface) RevokeSecurityGroupRules(ctx context.Context, id string, rules []*client.SecurityGroupRule) error {
 	m.ctrl.T.Helper()
@@ -1553,6 +2218,49 @@ func (mr *MockInterfaceMockRecorder) RevokeSecurityGroupRules(ctx, id, rules any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupRules", reflect.TypeOf((*MockInterface)(nil).RevokeSecurityGroupRules), ctx, id, rules)
 }
 
+// SimulatePrincipalPolicy mocks base method.
+func (m *MockInterface) SimulatePrincipalPolicy(ctx context.Context, principalARN string, actions []string, simulationContext client.PolicySimulationContext) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulatePrincipalPolicy", ctx, principalARN, actions, simulationContext)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulatePrincipalPolicy indicates an expected call of SimulatePrincipalPolicy.
+func (mr *MockInterfaceMockRecorder) SimulatePrincipalPolicy(ctx, principalARN, actions, simulationContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulatePrincipalPolicy", reflect.TypeOf((*MockInterface)(nil).SimulatePrincipalPolicy), ctx, principalARN, actions, simulationContext)
+}
+
+// TagResourceEfs mocks base method.
+func (m *MockInterface) TagResourceEfs(ctx context.Context, input *efs.TagResourceInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResourceEfs", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagResourceEfs indicates an expected call of TagResourceEfs.
+func (mr *MockInterfaceMockRecorder) TagResourceEfs(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceEfs", reflect.TypeOf((*MockInterface)(nil).TagResourceEfs), ctx, input)
+}
+
+// UntagResourceEfs mocks base method.
+func (m *MockInterface) UntagResourceEfs(ctx context.Context, input *efs.UntagResourceInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResourceEfs", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UntagResourceEfs indicates an expected call of UntagResourceEfs.
+func (mr *MockInterfaceMockRecorder) UntagResourceEfs(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceEfs", reflect.TypeOf((*MockInterface)(nil).UntagResourceEfs), ctx, input)
+}
+
 // UpdateAmazonProvidedIPv6CidrBlock mocks base method.
 func (m *MockInterface) UpdateAmazonProvidedIPv6CidrBlock(ctx context.Context, desired, current *client.VPC) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1582,6 +2290,20 @@ func (mr *MockInterfaceMockRecorder) UpdateAssumeRolePolicy(ctx, roleName, assum
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssumeRolePolicy", reflect.TypeOf((*MockInterface)(nil).UpdateAssumeRolePolicy), ctx, roleName, assumeRolePolicy)
 }
 
+// UpdateFileSystem mocks base method.
+func (m *MockInterface) UpdateFileSystem(ctx context.Context, input *efs.UpdateFileSystemInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileSystem", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileSystem indicates an expected call of UpdateFileSystem.
+func (mr *MockInterfaceMockRecorder) UpdateFileSystem(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileSystem", reflect.TypeOf((*MockInterface)(nil).UpdateFileSystem), ctx, input)
+}
+
 // UpdateObjectLockConfiguration mocks base method.
 func (m *MockInterface) UpdateObjectLockConfiguration(ctx context.Context, bucket string, mode aws.ModeType, days int32) error {
 	m.ctrl.T.Helper()
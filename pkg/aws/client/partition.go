@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"strings"
+)
+
+const (
+	// PartitionAWS is the partition of the standard AWS regions.
+	PartitionAWS = "aws"
+	// PartitionAWSChina is the partition of the AWS China regions.
+	PartitionAWSChina = "aws-cn"
+	// PartitionAWSGovCloud is the partition of the AWS GovCloud (US) regions.
+	PartitionAWSGovCloud = "aws-us-gov"
+	// PartitionAWSEUSC is the partition of the AWS European Sovereign Cloud regions.
+	PartitionAWSEUSC = "aws-eusc"
+)
+
+// PartitionForRegion returns the ARN partition of the given region.
+// Different available partitions in AWS are defined at
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference-arns.html
+// https://github.com/aws/aws-sdk-go-v2/blob/main/internal/endpoints/awsrulesfn/partitions.json
+func PartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return PartitionAWSChina
+	case strings.HasPrefix(region, "us-gov-"):
+		return PartitionAWSGovCloud
+	case strings.HasPrefix(region, "eusc-"): // e.g. "eusc-de-east-1"
+		return PartitionAWSEUSC
+	default:
+		return PartitionAWS
+	}
+}
+
+// ServicePrincipal returns the principal of the given AWS service, e.g. "ec2", in the given partition. It is used in
+// trust policies of IAM roles.
+func ServicePrincipal(service, partition string) string {
+	if partition == PartitionAWSChina {
+		return service + ".amazonaws.com.cn"
+	}
+	return service + ".amazonaws.com"
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("Partition", func() {
+	DescribeTable("#PartitionForRegion",
+		func(region, partition string) {
+			Expect(PartitionForRegion(region)).To(Equal(partition))
+		},
+		Entry("standard region", "eu-west-1", PartitionAWS),
+		Entry("China region", "cn-north-1", PartitionAWSChina),
+		Entry("GovCloud region", "us-gov-west-1", PartitionAWSGovCloud),
+		Entry("European Sovereign Cloud region", "eusc-de-east-1", PartitionAWSEUSC),
+	)
+
+	DescribeTable("#ServicePrincipal",
+		func(partition, principal string) {
+			Expect(ServicePrincipal("ec2", partition)).To(Equal(principal))
+		},
+		Entry("standard partition", PartitionAWS, "ec2.amazonaws.com"),
+		Entry("China partition", PartitionAWSChina, "ec2.amazonaws.com.cn"),
+		Entry("GovCloud partition", PartitionAWSGovCloud, "ec2.amazonaws.com"),
+	)
+
+	Describe("#NewClient", func() {
+		It("should derive the partition from the region", func() {
+			c, err := NewClient(AuthConfig{Region: "cn-north-1", AccessKey: &AccessKey{ID: "id", Secret: "secret"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.GetPartition()).To(Equal(PartitionAWSChina))
+		})
+
+		It("should use the configured partition and endpoint for all services", func() {
+			c, err := NewClient(AuthConfig{
+				Region:    "local-1",
+				Partition: "aws-local",
+				Endpoint:  "http://localhost:4566",
+				AccessKey: &AccessKey{ID: "id", Secret: "secret"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.GetPartition()).To(Equal("aws-local"))
+			Expect(c.EC2.Options().BaseEndpoint).To(HaveValue(Equal("http://localhost:4566")))
+			Expect(c.IAM.Options().BaseEndpoint).To(HaveValue(Equal("http://localhost:4566")))
+			Expect(c.S3.Options().BaseEndpoint).To(HaveValue(Equal("http://localhost:4566")))
+			Expect(c.S3.Options().UsePathStyle).To(BeTrue())
+		})
+
+		It("should use the FIPS endpoints", func() {
+			c, err := NewClient(AuthConfig{Region: "us-gov-west-1", UseFIPSEndpoints: true, AccessKey: &AccessKey{ID: "id", Secret: "secret"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.GetPartition()).To(Equal(PartitionAWSGovCloud))
+			Expect(c.EC2.Options().EndpointOptions.UseFIPSEndpoint).To(Equal(aws.FIPSEndpointStateEnabled))
+		})
+	})
+})
@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// apiRateLimiterCacheTTL is the TTL to keep API rate limiters in a time-based eviction cache.
+	apiRateLimiterCacheTTL = 1 * time.Hour
+	// minLimitDivisor is the divisor of the configured rate which gives the lowest rate an AdaptiveRateLimiter is
+	// reduced to while AWS throttles the API calls.
+	minLimitDivisor = 10
+	// recoverySteps is the number of successful API calls after which an AdaptiveRateLimiter has recovered the
+	// configured rate from the lowest rate.
+	recoverySteps = 50
+	// accountIDCacheTTL is the TTL to keep the account IDs of access keys in a time-based eviction cache.
+	accountIDCacheTTL = 24 * time.Hour
+	// accountIDFailureCacheTTL is the TTL to keep the access key ID as account key if the account ID of an access key
+	// could not be determined, so that new clients are not delayed by repeated lookups.
+	accountIDFailureCacheTTL = 10 * time.Minute
+	// accountIDTimeout is the timeout of the lookup of the account ID of an access key.
+	accountIDTimeout = 10 * time.Second
+	// describeCallTimeout is the timeout of a shared EC2 Describe* call, which is detached from the contexts of the
+	// callers.
+	describeCallTimeout = 2 * time.Minute
+)
+
+// APIRateLimiterOptions are the options of the client-side rate limiting of EC2, ELB and IAM API calls.
+type APIRateLimiterOptions struct {
+	// Limit is the number of API calls per second which may be made for each AWS account and region. API calls are not
+	// rate limited if it is zero.
+	Limit rate.Limit
+	// Burst is the number of API calls which may be made at once.
+	Burst int
+	// WaitTimeout is the maximum time an API call waits for the rate limiter before it fails.
+	WaitTimeout time.Duration
+}
+
+// DefaultAPIRateLimiterOptions are the options of the client-side rate limiting of all clients created by NewClient.
+// The rate limiters are shared by all clients for the same AWS account and region.
+var DefaultAPIRateLimiterOptions = APIRateLimiterOptions{}
+
+var (
+	// rateLimitedServices are the IDs of the services whose API calls are rate limited.
+	rateLimitedServices = sets.New(ec2.ServiceID, elb.ServiceID, elbv2.ServiceID, iam.ServiceID)
+
+	apiRateLimiters      = cache.NewExpiring()
+	apiRateLimitersMutex sync.Mutex
+
+	accountIDs = cache.NewExpiring()
+
+	describeCalls singleflight.Group
+)
+
+// APIRateLimiterWaitError is an error to be reported if waiting for the rate limiter of EC2, ELB and IAM API calls
+// fails. This can only happen if the wait time would exceed the configured wait timeout.
+type APIRateLimiterWaitError struct {
+	Cause error
+}
+
+func (e *APIRateLimiterWaitError) Error() string {
+	return fmt.Sprintf("could not wait for client-side AWS API rate limiter: %+v", e.Cause)
+}
+
+// AdaptiveRateLimiter is a token bucket rate limiter which halves its rate whenever AWS throttles an API call, and
+// gradually recovers the configured rate with every successful API call.
+type AdaptiveRateLimiter struct {
+	limiter  *rate.Limiter
+	maxLimit rate.Limit
+	minLimit rate.Limit
+	mutex    sync.Mutex
+}
+
+// NewAdaptiveRateLimiter creates a new AdaptiveRateLimiter with the given rate and burst.
+func NewAdaptiveRateLimiter(limit rate.Limit, burst int) *AdaptiveRateLimiter {
+	return &AdaptiveRateLimiter{
+		limiter:  rate.NewLimiter(limit, burst),
+		maxLimit: limit,
+		minLimit: limit / minLimitDivisor,
+	}
+}
+
+// Wait blocks until an API call may be made. It fails if the wait time would exceed the deadline of the context.
+func (l *AdaptiveRateLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
+
+// Limit returns the current rate.
+func (l *AdaptiveRateLimiter) Limit() rate.Limit {
+	return l.limiter.Limit()
+}
+
+// Throttled halves the rate after AWS throttled an API call, but not below a tenth of the configured rate.
+func (l *AdaptiveRateLimiter) Throttled() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.limiter.SetLimit(max(l.limiter.Limit()/2, l.minLimit))
+}
+
+// Succeeded increases the rate after a successful API call, but not above the configured rate.
+func (l *AdaptiveRateLimiter) Succeeded() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if current := l.limiter.Limit(); current < l.maxLimit {
+		l.limiter.SetLimit(min(current+(l.maxLimit-l.minLimit)/recoverySteps, l.maxLimit))
+	}
+}
+
+// identityKey returns a key identifying the AWS identity of the given auth config.
+func identityKey(authConfig AuthConfig) string {
+	if len(authConfig.AssumeRoleChain) > 0 {
+		// the identity authenticated with AWS is the last role of the chain
+		return authConfig.AssumeRoleChain[len(authConfig.AssumeRoleChain)-1].RoleARN
+	}
+	if authConfig.AccessKey != nil {
+		return authConfig.AccessKey.ID
+	}
+	// In practice single AWS Role (the same roleARN) can be assumed by multiple Workload Identities.
+	// A side effect of rate limiter using the roleARN as key is that all Workload Identities assuming the same
+	// RoleARN will be throttled at the same time.
+	// However, most probably on the server side(AWS STS) they would be throttled also on the roleARN
+	// as this is the identity authenticated with AWS.
+	return authConfig.WorkloadIdentity.RoleARN
+}
+
+// callerIdentityAPI is the part of the STS API needed to determine the account of an access key.
+type callerIdentityAPI interface {
+	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// accountKey returns a key identifying the AWS account of the given auth config. It is the account ID of the role the
+// identity is authenticated as. For static credentials, the account ID is determined with sts:GetCallerIdentity once
+// per access key, which does not require any permissions. The access key ID is used if it cannot be determined.
+func accountKey(ctx context.Context, stsClient callerIdentityAPI, authConfig AuthConfig) string {
+	key := identityKey(authConfig)
+	if parsed, err := arn.Parse(key); err == nil {
+		return parsed.AccountID
+	}
+	if v, ok := accountIDs.Get(key); ok {
+		return v.(string)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, accountIDTimeout)
+	defer cancel()
+	output, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil || output.Account == nil {
+		accountIDs.Set(key, key, accountIDFailureCacheTTL)
+		return key
+	}
+	accountIDs.Set(key, *output.Account, accountIDCacheTTL)
+	return *output.Account
+}
+
+func getAPIRateLimiter(key string, opts APIRateLimiterOptions) *AdaptiveRateLimiter {
+	// see route53Factory.getRateLimiter for why the mutex is needed
+	apiRateLimitersMutex.Lock()
+	defer apiRateLimitersMutex.Unlock()
+
+	var rateLimiter *AdaptiveRateLimiter
+	if v, ok := apiRateLimiters.Get(key); ok {
+		rateLimiter = v.(*AdaptiveRateLimiter)
+	} else {
+		rateLimiter = NewAdaptiveRateLimiter(opts.Limit, opts.Burst)
+	}
+	apiRateLimiters.Set(key, rateLimiter, apiRateLimiterCacheTTL)
+	return rateLimiter
+}
+
+// apiRateLimiterMiddleware returns a function adding a middleware to a stack which waits for the given rate limiter
+// before every attempt of EC2, ELB and IAM API calls, and adapts the rate of the rate limiter to throttling. It is
+// added after the retry middleware, so that retries are rate limited as well.
+func apiRateLimiterMiddleware(rateLimiter *AdaptiveRateLimiter, waitTimeout time.Duration) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(
+			"gardenerAPIRateLimiter",
+			func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				if !rateLimitedServices.Has(awsmiddleware.GetServiceID(ctx)) {
+					return next.HandleFinalize(ctx, input)
+				}
+
+				waitCtx, cancel := context.WithTimeout(ctx, waitTimeout)
+				defer cancel()
+				if err := rateLimiter.Wait(waitCtx); err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, &APIRateLimiterWaitError{Cause: err}
+				}
+
+				output, metadata, err := next.HandleFinalize(ctx, input)
+				switch {
+				case err == nil:
+					rateLimiter.Succeeded()
+				case isThrottle.IsErrorThrottle(err).Bool():
+					rateLimiter.Throttled()
+				}
+				return output, metadata, err
+			},
+		), "Retry", middleware.After)
+	}
+}
+
+type describeResult struct {
+	output   middleware.InitializeOutput
+	metadata middleware.Metadata
+}
+
+// coalesceDescribeCallsMiddleware returns a function adding a middleware to a stack which lets identical concurrent
+// EC2 Describe* calls of all clients with the given key share a single API call. It is added before the
+// instrumentation middleware, so that only the shared API call is recorded. The shared API call is detached from the
+// context of the caller which started it, so that it is not cancelled for the other callers, and every caller gets its
+// own copy of the output, which it may modify.
+func coalesceDescribeCallsMiddleware(key string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+			"gardenerCoalesceDescribeCalls",
+			func(ctx context.Context, input middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				operation := awsmiddleware.GetOperationName(ctx)
+				if awsmiddleware.GetServiceID(ctx) != ec2.ServiceID || !strings.HasPrefix(operation, "Describe") {
+					return next.HandleInitialize(ctx, input)
+				}
+				params, err := json.Marshal(input.Parameters)
+				if err != nil {
+					return next.HandleInitialize(ctx, input)
+				}
+
+				results := describeCalls.DoChan(key+"/"+operation+"/"+string(params), func() (any, error) {
+					callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), describeCallTimeout)
+					defer cancel()
+					output, metadata, err := next.HandleInitialize(callCtx, input)
+					return describeResult{output: output, metadata: metadata}, err
+				})
+				select {
+				case <-ctx.Done():
+					return middleware.InitializeOutput{}, middleware.Metadata{}, ctx.Err()
+				case result := <-results:
+					shared := result.Val.(describeResult)
+					output := shared.output
+					if output.Result != nil {
+						output.Result = deepCopy(reflect.ValueOf(output.Result)).Interface()
+					}
+					return output, shared.metadata.Clone(), result.Err
+				}
+			},
+		), middleware.After)
+	}
+}
+
+// deepCopy returns a deep copy of the given value. Unexported fields are copied shallowly, which is sufficient for the
+// outputs of the AWS SDK, whose unexported fields are not modified by the callers.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Elem().Type())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range v.NumField() {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const describeVpcsResponse = `<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>request-1</requestId>
+  <vpcSet><item><vpcId>vpc-1</vpcId><cidrBlock>10.0.0.0/16</cidrBlock></item></vpcSet>
+</DescribeVpcsResponse>`
+
+var _ = Describe("Rate limiting", func() {
+	Describe("#AdaptiveRateLimiter", func() {
+		It("should halve the rate on throttling down to a tenth of the configured rate", func() {
+			limiter := NewAdaptiveRateLimiter(100, 10)
+
+			limiter.Throttled()
+			Expect(limiter.Limit()).To(Equal(rate.Limit(50)))
+			for range 10 {
+				limiter.Throttled()
+			}
+			Expect(limiter.Limit()).To(Equal(rate.Limit(10)))
+		})
+
+		It("should recover the configured rate with successful calls", func() {
+			limiter := NewAdaptiveRateLimiter(100, 10)
+			for range 10 {
+				limiter.Throttled()
+			}
+
+			limiter.Succeeded()
+			Expect(limiter.Limit()).To(BeNumerically("~", 11.8, 0.001))
+			for range 50 {
+				limiter.Succeeded()
+			}
+			Expect(limiter.Limit()).To(Equal(rate.Limit(100)))
+		})
+	})
+
+	Describe("#NewClient", func() {
+		var (
+			requests atomic.Int32
+			server   *httptest.Server
+		)
+
+		BeforeEach(func() {
+			requests.Store(0)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.ParseForm()).To(Succeed())
+				if r.PostForm.Get("Action") == "GetCallerIdentity" {
+					_, _ = w.Write([]byte(getCallerIdentityResponse))
+					return
+				}
+				requests.Add(1)
+				time.Sleep(100 * time.Millisecond)
+				_, _ = w.Write([]byte(describeVpcsResponse))
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		newClientInRegion := func(accessKeyID, region string) *Client {
+			c, err := NewClient(AuthConfig{
+				Region:    region,
+				Endpoint:  server.URL,
+				AccessKey: &AccessKey{ID: accessKeyID, Secret: "secret"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return c
+		}
+		newClient := func(accessKeyID string) *Client {
+			return newClientInRegion(accessKeyID, "eu-west-1")
+		}
+
+		It("should coalesce identical concurrent describe calls of clients with the same identity", func() {
+			clients := []*Client{newClient("coalesce"), newClient("coalesce")}
+
+			var wg sync.WaitGroup
+			for i := range 6 {
+				wg.Go(func() {
+					defer GinkgoRecover()
+					output, err := clients[i%2].EC2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(output.Vpcs).To(HaveLen(1))
+				})
+			}
+			wg.Wait()
+
+			Expect(requests.Load()).To(Equal(int32(1)))
+		})
+
+		It("should give every caller its own copy of the output", func() {
+			c := newClient("copy")
+
+			var (
+				wg      sync.WaitGroup
+				outputs = make([]*ec2.DescribeVpcsOutput, 2)
+			)
+			for i := range outputs {
+				wg.Go(func() {
+					defer GinkgoRecover()
+					var err error
+					outputs[i], err = c.EC2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}})
+					Expect(err).NotTo(HaveOccurred())
+				})
+			}
+			wg.Wait()
+
+			Expect(requests.Load()).To(Equal(int32(1)))
+			outputs[0].Vpcs[0].VpcId = ptr.To("modified")
+			Expect(outputs[1].Vpcs[0].VpcId).To(Equal(ptr.To("vpc-1")))
+		})
+
+		It("should not cancel the shared call for the other callers", func() {
+			c := newClient("cancel")
+
+			cancelledCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			var wg sync.WaitGroup
+			wg.Go(func() {
+				defer GinkgoRecover()
+				_, err := c.EC2.DescribeVpcs(cancelledCtx, &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}})
+				Expect(err).To(MatchError(context.DeadlineExceeded))
+			})
+			time.Sleep(5 * time.Millisecond)
+			wg.Go(func() {
+				defer GinkgoRecover()
+				_, err := c.EC2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}})
+				Expect(err).NotTo(HaveOccurred())
+			})
+			wg.Wait()
+
+			Expect(requests.Load()).To(Equal(int32(1)))
+		})
+
+		It("should not coalesce describe calls of clients with different identities", func() {
+			clients := []*Client{newClient("identity-1"), newClient("identity-2")}
+
+			var wg sync.WaitGroup
+			for _, c := range clients {
+				wg.Go(func() {
+					defer GinkgoRecover()
+					_, err := c.EC2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}})
+					Expect(err).NotTo(HaveOccurred())
+				})
+			}
+			wg.Wait()
+
+			Expect(requests.Load()).To(Equal(int32(2)))
+		})
+
+		It("should share the rate limit of all access keys of an account", func() {
+			oldOptions := DefaultAPIRateLimiterOptions
+			DefaultAPIRateLimiterOptions = APIRateLimiterOptions{Limit: 1, Burst: 1, WaitTimeout: 10 * time.Millisecond}
+			DeferCleanup(func() { DefaultAPIRateLimiterOptions = oldOptions })
+
+			_, err := newClientInRegion("account-key-1", "eu-central-1").EC2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = newClientInRegion("account-key-2", "eu-central-1").EC2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-2"}})
+			var waitErr *APIRateLimiterWaitError
+			Expect(errors.As(err, &waitErr)).To(BeTrue())
+			Expect(requests.Load()).To(Equal(int32(1)))
+		})
+	})
+})
@@ -6,10 +6,13 @@ package client
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 )
 
 // Tags is map of string key to string values. Duplicate keys are not supported in AWS.
@@ -77,6 +80,31 @@ func (tags Tags) ContainEfsTags(efsTags []efstypes.Tag) bool {
 	return true
 }
 
+// ToFSxTags exports the tags map as a FSx Tag array.
+func (tags Tags) ToFSxTags() []fsxtypes.Tag {
+	var cp []fsxtypes.Tag
+	for k, v := range tags {
+		cp = append(cp, fsxtypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return cp
+}
+
+// ContainFSxTags checks if the tags map contains all the key-value pairs from the given FSx tags.
+func (tags Tags) ContainFSxTags(fsxTags []fsxtypes.Tag) bool {
+	fsxTagMap := make(map[string]string)
+	for _, tag := range fsxTags {
+		fsxTagMap[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	for k, v := range tags {
+		if fsxTagMap[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 // AddManagedTag adds the "managed-by-gardener" tag to the tags map if it does not already exist.
 func (tags Tags) AddManagedTag() Tags {
 	if tags == nil {
@@ -93,9 +121,10 @@ func (tags Tags) ToFilters() []ec2types.Filter {
 	if tags == nil {
 		return nil
 	}
+	// the filters are sorted, so that identical concurrent calls can be coalesced
 	var filters []ec2types.Filter
-	for k, v := range tags {
-		filters = append(filters, ec2types.Filter{Name: aws.String(fmt.Sprintf("tag:%s", k)), Values: []string{v}})
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		filters = append(filters, ec2types.Filter{Name: aws.String(fmt.Sprintf("tag:%s", k)), Values: []string{tags[k]}})
 	}
 	return filters
 }
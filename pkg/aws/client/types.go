@@ -15,6 +15,8 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/smithy-go"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -52,11 +54,14 @@ const (
 // Interface is an interface which must be implemented by AWS clients.
 type Interface interface {
 	GetAccountID(ctx context.Context) (string, error)
+	GetPartition() string
 	GetVPCInternetGateway(ctx context.Context, vpcID string) (string, error)
 	GetVPCAttribute(ctx context.Context, vpcID string, attribute ec2types.VpcAttributeName) (bool, error)
 	GetDHCPOptions(ctx context.Context, vpcID string) (map[string]string, error)
 	GetElasticIPsAssociationIDForAllocationIDs(ctx context.Context, allocationIDs []string) (map[string]*string, error)
 	GetNATGatewayAddressAllocations(ctx context.Context, shootNamespace string) (sets.Set[string], error)
+	GetPublicIPv4PoolFreeAddressCount(ctx context.Context, poolID string) (*int, error)
+	GetIPAMPoolFreeAddressCount(ctx context.Context, poolID string) (*int, error)
 
 	// S3 wrappers
 	CreateBucket(ctx context.Context, bucket, region string, objectLockEnabled bool) error
@@ -80,6 +85,12 @@ type Interface interface {
 	DeleteELB(ctx context.Context, name string) error
 	DeleteELBV2(ctx context.Context, arn string) error
 
+	// Load balancers
+	FindKubernetesELBByDNSName(ctx context.Context, dnsName, clusterName string) (string, error)
+	FindKubernetesELBV2ByDNSName(ctx context.Context, dnsName, clusterName string) (string, error)
+	DrainELB(ctx context.Context, name string) (bool, error)
+	DrainELBV2(ctx context.Context, arn string) (bool, error)
+
 	// VPCs
 	CreateVpcDhcpOptions(ctx context.Context, options *DhcpOptions) (*DhcpOptions, error)
 	GetVpcDhcpOptions(ctx context.Context, id string) (*DhcpOptions, error)
@@ -95,15 +106,35 @@ type Interface interface {
 	GetVpc(ctx context.Context, id string) (*VPC, error)
 	FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error)
 
+	// VPC flow logs
+	CreateFlowLog(ctx context.Context, flowLog *FlowLog) (*FlowLog, error)
+	GetFlowLog(ctx context.Context, id string) (*FlowLog, error)
+	FindFlowLogsByTags(ctx context.Context, tags Tags) ([]*FlowLog, error)
+	FindFlowLogsByResourceID(ctx context.Context, resourceID string) ([]*FlowLog, error)
+	DeleteFlowLog(ctx context.Context, id string) error
+
 	// Security groups
 	CreateSecurityGroup(ctx context.Context, sg *SecurityGroup) (*SecurityGroup, error)
 	GetSecurityGroup(ctx context.Context, id string) (*SecurityGroup, error)
 	FindSecurityGroupsByTags(ctx context.Context, tags Tags) ([]*SecurityGroup, error)
+	FindSecurityGroups(ctx context.Context, filters []ec2types.Filter) ([]*SecurityGroup, error)
 	FindDefaultSecurityGroupByVpcId(ctx context.Context, vpcId string) (*SecurityGroup, error)
 	AuthorizeSecurityGroupRules(ctx context.Context, id string, rules []*SecurityGroupRule) error
 	RevokeSecurityGroupRules(ctx context.Context, id string, rules []*SecurityGroupRule) error
 	DeleteSecurityGroup(ctx context.Context, id string) error
 
+	// Network ACLs
+	CreateNetworkAcl(ctx context.Context, acl *NetworkAcl) (*NetworkAcl, error)
+	GetNetworkAcl(ctx context.Context, id string) (*NetworkAcl, error)
+	FindNetworkAclsByTags(ctx context.Context, tags Tags) ([]*NetworkAcl, error)
+	FindDefaultNetworkAclByVpcId(ctx context.Context, vpcId string) (*NetworkAcl, error)
+	FindNetworkAclBySubnetId(ctx context.Context, subnetId string) (*NetworkAcl, error)
+	CreateNetworkAclEntry(ctx context.Context, id string, entry *NetworkAclEntry) error
+	ReplaceNetworkAclEntry(ctx context.Context, id string, entry *NetworkAclEntry) error
+	DeleteNetworkAclEntry(ctx context.Context, id string, entry *NetworkAclEntry) error
+	ReplaceNetworkAclAssociation(ctx context.Context, associationId, networkAclId string) (string, error)
+	DeleteNetworkAcl(ctx context.Context, id string) error
+
 	// Internet gateways
 	CreateInternetGateway(ctx context.Context, gateway *InternetGateway) (*InternetGateway, error)
 	GetInternetGateway(ctx context.Context, id string) (*InternetGateway, error)
@@ -136,6 +167,7 @@ type Interface interface {
 	CreateSubnet(ctx context.Context, subnet *Subnet, maxWaitDur time.Duration) (*Subnet, error)
 	GetSubnets(ctx context.Context, ids []string) ([]*Subnet, error)
 	FindSubnets(ctx context.Context, filters []ec2types.Filter) ([]*Subnet, error)
+	FindNetworkInterfaces(ctx context.Context, filters []ec2types.Filter) ([]*NetworkInterface, error)
 	UpdateSubnetAttributes(ctx context.Context, desired, current *Subnet) (modified bool, err error)
 	DeleteSubnet(ctx context.Context, id string) error
 
@@ -161,6 +193,8 @@ type Interface interface {
 	FindNATGatewaysByTags(ctx context.Context, tags Tags) ([]*NATGateway, error)
 	FindNATGateways(ctx context.Context, filters []ec2types.Filter) ([]*NATGateway, error)
 	DeleteNATGateway(ctx context.Context, id string) error
+	AssociateNATGatewayAddresses(ctx context.Context, id string, allocationIDs []string) error
+	DisassociateNATGatewayAddresses(ctx context.Context, id string, addresses []*NATGatewayAddress) error
 
 	// Egress only internet gateway
 	CreateEgressOnlyInternetGateway(ctx context.Context, gateway *EgressOnlyInternetGateway) (*EgressOnlyInternetGateway, error)
@@ -192,6 +226,21 @@ type Interface interface {
 	GetIAMRolePolicy(ctx context.Context, policyName, roleName string) (*IAMRolePolicy, error)
 	DeleteIAMRolePolicy(ctx context.Context, policyName, roleName string) error
 
+	// IAM Access Keys
+	GetAccessKeyMetadata(ctx context.Context, accessKeyID string) (*AccessKeyMetadata, error)
+
+	// IAM Policy Simulation
+	GetCallerPrincipalARN(ctx context.Context) (string, error)
+	SimulatePrincipalPolicy(ctx context.Context, principalARN string, actions []string, simulationContext PolicySimulationContext) ([]string, error)
+
+	// Service Quotas
+	GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (*float64, error)
+	CountVpcs(ctx context.Context) (int, error)
+	CountElasticIPs(ctx context.Context) (int, error)
+	CountNATGatewaysByZone(ctx context.Context) (map[string]int, error)
+	GetInstanceTypeVCPUs(ctx context.Context, instanceTypes []string) (map[string]int, error)
+	GetRunningOnDemandVCPUs(ctx context.Context, tags Tags) (map[string]int, error)
+
 	// EC2 tags
 	CreateEC2Tags(ctx context.Context, resources []string, tags Tags) error
 	DeleteEC2Tags(ctx context.Context, resources []string, tags Tags) error
@@ -201,9 +250,25 @@ type Interface interface {
 	FindFileSystemsByTags(ctx context.Context, tags Tags) ([]*efstypes.FileSystemDescription, error)
 	CreateFileSystem(ctx context.Context, input *efs.CreateFileSystemInput) (*efstypes.FileSystemDescription, error)
 	DeleteFileSystem(ctx context.Context, input *efs.DeleteFileSystemInput) error
+	UpdateFileSystem(ctx context.Context, input *efs.UpdateFileSystemInput) error
+	DescribeLifecycleConfiguration(ctx context.Context, input *efs.DescribeLifecycleConfigurationInput) ([]efstypes.LifecyclePolicy, error)
+	PutLifecycleConfiguration(ctx context.Context, input *efs.PutLifecycleConfigurationInput) error
+	DescribeBackupPolicy(ctx context.Context, input *efs.DescribeBackupPolicyInput) (*efstypes.BackupPolicy, error)
+	PutBackupPolicy(ctx context.Context, input *efs.PutBackupPolicyInput) error
+	DescribeAccessPoints(ctx context.Context, input *efs.DescribeAccessPointsInput) ([]efstypes.AccessPointDescription, error)
+	CreateAccessPoint(ctx context.Context, input *efs.CreateAccessPointInput) (*efstypes.AccessPointDescription, error)
+	DeleteAccessPoint(ctx context.Context, input *efs.DeleteAccessPointInput) error
 	DescribeMountTargetsEfs(ctx context.Context, input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error)
 	CreateMountTargetEfs(ctx context.Context, input *efs.CreateMountTargetInput) (*efs.CreateMountTargetOutput, error)
 	DeleteMountTargetEfs(ctx context.Context, input *efs.DeleteMountTargetInput) error
+	TagResourceEfs(ctx context.Context, input *efs.TagResourceInput) error
+	UntagResourceEfs(ctx context.Context, input *efs.UntagResourceInput) error
+
+	// FSx
+	GetFSxFileSystem(ctx context.Context, fileSystemID string) (*fsxtypes.FileSystem, error)
+	FindFSxFileSystemsByTags(ctx context.Context, tags Tags) ([]*fsxtypes.FileSystem, error)
+	CreateFSxFileSystem(ctx context.Context, input *fsx.CreateFileSystemInput) (*fsxtypes.FileSystem, error)
+	DeleteFSxFileSystem(ctx context.Context, fileSystemID string) error
 }
 
 // Factory creates instances of Interface.
@@ -251,6 +316,8 @@ type SecurityGroup struct {
 	VpcId       *string
 	Description *string
 	Rules       []*SecurityGroupRule
+	// OwnerId is the ID of the account which owns the security group. It is only set by describe calls.
+	OwnerId string
 }
 
 // Clone creates a copy.
@@ -445,6 +512,112 @@ func (sgr *SecurityGroupRule) LessThan(other *SecurityGroupRule) bool {
 	return false
 }
 
+// FlowLog contains the relevant fields for an EC2 flow log resource.
+// For the destination type `cloud-watch-logs`, LogGroupName is used instead of LogDestination on creation.
+type FlowLog struct {
+	Tags
+	FlowLogId                string
+	ResourceId               string
+	TrafficType              string
+	LogDestinationType       string
+	LogDestination           *string
+	LogGroupName             *string
+	DeliverLogsPermissionArn *string
+	LogFormat                *string
+	MaxAggregationInterval   *int32
+}
+
+// DefaultNetworkAclRuleNumber is the number of the rule every network ACL ends with, which denies all traffic. It cannot
+// be modified.
+const DefaultNetworkAclRuleNumber int32 = 32767
+
+// NetworkAcl contains the relevant fields for an EC2 network ACL resource.
+// Entries and Associations are filled for returned values, but ignored on creation.
+type NetworkAcl struct {
+	Tags
+	NetworkAclId string
+	VpcId        *string
+	IsDefault    bool
+	Entries      []*NetworkAclEntry
+	Associations []*NetworkAclAssociation
+}
+
+// NetworkAclEntry contains the relevant fields for an entry of an EC2 network ACL resource.
+// Protocol is the IP protocol number or `-1` for all protocols. The port range is only used for TCP and UDP.
+type NetworkAclEntry struct {
+	RuleNumber    int32
+	Egress        bool
+	Protocol      string
+	RuleAction    string
+	CidrBlock     *string
+	Ipv6CidrBlock *string
+	FromPort      *int32
+	ToPort        *int32
+}
+
+// HasPortRange returns true if the protocol of the entry supports port ranges, i.e. TCP or UDP.
+func (e *NetworkAclEntry) HasPortRange() bool {
+	return e.Protocol == "6" || e.Protocol == "17"
+}
+
+// IsICMP returns true if the protocol of the entry is ICMP or ICMPv6.
+func (e *NetworkAclEntry) IsICMP() bool {
+	return e.Protocol == "1" || e.Protocol == "58"
+}
+
+// EquivalentTo returns true if the entry matches the same traffic with the same action as another entry.
+func (e *NetworkAclEntry) EquivalentTo(other *NetworkAclEntry) bool {
+	if e.RuleNumber != other.RuleNumber || e.Egress != other.Egress || e.Protocol != other.Protocol ||
+		e.RuleAction != other.RuleAction || ptr.Deref(e.CidrBlock, "") != ptr.Deref(other.CidrBlock, "") ||
+		ptr.Deref(e.Ipv6CidrBlock, "") != ptr.Deref(other.Ipv6CidrBlock, "") {
+		return false
+	}
+	if e.HasPortRange() {
+		return ptr.Deref(e.FromPort, 0) == ptr.Deref(other.FromPort, 0) && ptr.Deref(e.ToPort, 0) == ptr.Deref(other.ToPort, 0)
+	}
+	return true
+}
+
+// DiffEntries calculates the entries which have to be created, replaced or deleted to get from the entries of another
+// network ACL to the entries of this one. Entries are identified by direction and rule number and the default entries
+// are ignored.
+func (acl *NetworkAcl) DiffEntries(other *NetworkAcl) (created, replaced, deleted []*NetworkAclEntry) {
+	type key struct {
+		egress     bool
+		ruleNumber int32
+	}
+	current := map[key]*NetworkAclEntry{}
+	for _, entry := range other.Entries {
+		if entry.RuleNumber != DefaultNetworkAclRuleNumber {
+			current[key{entry.Egress, entry.RuleNumber}] = entry
+		}
+	}
+	for _, entry := range acl.Entries {
+		if entry.RuleNumber == DefaultNetworkAclRuleNumber {
+			continue
+		}
+		k := key{entry.Egress, entry.RuleNumber}
+		if currentEntry, ok := current[k]; !ok {
+			created = append(created, entry)
+		} else if !entry.EquivalentTo(currentEntry) {
+			replaced = append(replaced, entry)
+		}
+		delete(current, k)
+	}
+	for _, entry := range other.Entries {
+		if _, ok := current[key{entry.Egress, entry.RuleNumber}]; ok {
+			deleted = append(deleted, entry)
+		}
+	}
+	return
+}
+
+// NetworkAclAssociation contains the relevant fields for a subnet association of an EC2 network ACL resource.
+type NetworkAclAssociation struct {
+	NetworkAclAssociationId string
+	SubnetId                string
+}
+
 // InternetGateway contains the relevant fields for an EC2 internet gateway resource.
 type InternetGateway struct {
 	Tags
@@ -508,6 +681,25 @@ type RouteTableAssociation struct {
 	SubnetId                *string
 }
 
+// NetworkInterface contains the relevant fields for an EC2 network interface resource.
+type NetworkInterface struct {
+	Tags
+	NetworkInterfaceId string
+	SubnetId           string
+	VpcId              string
+	// InterfaceType is the type of the network interface, e.g. "interface", "lambda" or "nat_gateway".
+	InterfaceType    string
+	Description      string
+	Status           string
+	OwnerId          string
+	SecurityGroupIds []string
+	// RequesterId is the alias or account ID of the principal or service which created the network interface on behalf
+	// of the owner, e.g. "amazon-rds".
+	RequesterId *string
+	// InstanceId is the ID of the instance the network interface is attached to.
+	InstanceId *string
+}
+
 // Subnet contains the relevant fields for an EC2 subnet resource.
 type Subnet struct {
 	Tags
@@ -543,6 +735,11 @@ type ElasticIP struct {
 	PublicIp      string
 	Vpc           bool
 	AssociationID *string
+	// PublicIPv4Pool is the ID of the public IPv4 pool the address is allocated from.
+	PublicIPv4Pool *string
+	// IPAMPoolID is the ID of the IPAM pool the address is allocated from. It is only used for creation, as it is not
+	// returned by the EC2 API.
+	IPAMPoolID *string
 }
 
 // NATGateway contains the relevant fields for an EC2 NAT gateway resource.
@@ -554,6 +751,17 @@ type NATGateway struct {
 	SubnetId        string
 	State           string
 	VpcId           *string
+	// SecondaryAddresses are the addresses of the NAT gateway besides the primary one.
+	SecondaryAddresses []*NATGatewayAddress
+}
+
+// NATGatewayAddress contains the relevant fields for a secondary address of an EC2 NAT gateway.
+type NATGatewayAddress struct {
+	AllocationId  string
+	AssociationId string
+	PublicIP      string
+	PrivateIP     string
+	Status        string
 }
 
 // KeyPairInfo contains the relevant fields for an EC2 key pair.
@@ -587,6 +795,31 @@ type IAMRolePolicy struct {
 	PolicyDocument string
 }
 
+// PolicySimulationContext is the context of the requests simulated by SimulatePrincipalPolicy, so that policies with
+// conditions on the region or tags evaluate like for the actual requests.
+type PolicySimulationContext struct {
+	// Region is the region of the requests. It is passed as aws:RequestedRegion and ec2:Region, and the resources of
+	// regional services are restricted to it.
+	Region string
+	// Tags are the tags of the created resources and of the resources acted on. They are passed as aws:RequestTag,
+	// aws:ResourceTag, ec2:ResourceTag and aws:TagKeys.
+	Tags Tags
+}
+
+// AccessKeyMetadata contains the relevant fields for the metadata of an IAM access key.
+type AccessKeyMetadata struct {
+	AccessKeyID  string
+	UserName     string
+	Status       string
+	CreateDate   time.Time
+	LastUsedDate *time.Time
+}
+
+// Age returns the age of the access key at the given time.
+func (m *AccessKeyMetadata) Age(now time.Time) time.Duration {
+	return now.Sub(m.CreateDate)
+}
+
 // GetAWSAPIErrorCode return error code of AWS api error.
 func GetAWSAPIErrorCode(err error) string {
 	var apiErr smithy.APIError
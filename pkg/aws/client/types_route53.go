@@ -54,19 +54,7 @@ func (f *route53Factory) NewClient(authConfig AuthConfig) (Interface, error) {
 		return nil, err
 	}
 
-	var rateLimiterKey string
-	if authConfig.AccessKey != nil {
-		rateLimiterKey = authConfig.AccessKey.ID
-	} else {
-		// In practice single AWS Role (the same roleARN) can be assumed by multiple Workload Identities.
-		// A side effect of rate limiter using the roleARN as key is that all Workload Identities assuming the same
-		// RoleARN will be throttled at the same time.
-		// However, most probably on the server side(AWS STS) they would be throttled also on the roleARN
-		// as this is the identity authenticated with AWS.
-		rateLimiterKey = authConfig.WorkloadIdentity.RoleARN
-	}
-
-	c.Route53RateLimiter = f.getRateLimiter(rateLimiterKey)
+	c.Route53RateLimiter = f.getRateLimiter(identityKey(authConfig))
 	c.Route53RateLimiterWaitTimeout = f.waitTimeout
 	return c, nil
 }
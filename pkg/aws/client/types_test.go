@@ -129,3 +129,85 @@ var _ = Describe("SecurityGroup", func() {
 		Entry("sg3-sg1", sg3, sg1, 0, 4),
 	)
 })
+
+var _ = Describe("NetworkAcl", func() {
+	var (
+		allowHTTPS = &NetworkAclEntry{
+			RuleNumber: 100,
+			Protocol:   "6",
+			RuleAction: "allow",
+			CidrBlock:  ptr.To("0.0.0.0/0"),
+			FromPort:   ptr.To[int32](443),
+			ToPort:     ptr.To[int32](443),
+		}
+		allowHTTPSIgnoredPorts = &NetworkAclEntry{
+			RuleNumber: 100,
+			Protocol:   "6",
+			RuleAction: "allow",
+			CidrBlock:  ptr.To("0.0.0.0/0"),
+			FromPort:   ptr.To[int32](443),
+			ToPort:     ptr.To[int32](443),
+		}
+		denyHTTPS = &NetworkAclEntry{
+			RuleNumber: 100,
+			Protocol:   "6",
+			RuleAction: "deny",
+			CidrBlock:  ptr.To("0.0.0.0/0"),
+			FromPort:   ptr.To[int32](443),
+			ToPort:     ptr.To[int32](443),
+		}
+		allowAllEgress = &NetworkAclEntry{
+			RuleNumber: 100,
+			Egress:     true,
+			Protocol:   "-1",
+			RuleAction: "allow",
+			CidrBlock:  ptr.To("0.0.0.0/0"),
+			FromPort:   ptr.To[int32](1),
+		}
+		allowAllEgressWithoutPorts = &NetworkAclEntry{
+			RuleNumber: 100,
+			Egress:     true,
+			Protocol:   "-1",
+			RuleAction: "allow",
+			CidrBlock:  ptr.To("0.0.0.0/0"),
+		}
+		denyAllIngress = &NetworkAclEntry{
+			RuleNumber: DefaultNetworkAclRuleNumber,
+			Protocol:   "-1",
+			RuleAction: "deny",
+			CidrBlock:  ptr.To("0.0.0.0/0"),
+		}
+		acl1 = &NetworkAcl{Entries: []*NetworkAclEntry{allowHTTPS, allowAllEgress}}
+		acl2 = &NetworkAcl{Entries: []*NetworkAclEntry{allowHTTPSIgnoredPorts, allowAllEgressWithoutPorts, denyAllIngress}}
+		acl3 = &NetworkAcl{Entries: []*NetworkAclEntry{denyHTTPS, denyAllIngress}}
+		acl4 = &NetworkAcl{Entries: []*NetworkAclEntry{denyAllIngress}}
+	)
+
+	DescribeTable("#EquivalentTo",
+		func(a, b *NetworkAclEntry, expectedEqual bool) {
+			Expect(a.EquivalentTo(b)).To(Equal(expectedEqual))
+		},
+
+		Entry("same entry", allowHTTPS, allowHTTPS, true),
+		Entry("same values", allowHTTPS, allowHTTPSIgnoredPorts, true),
+		Entry("ports ignored for protocol all", allowAllEgress, allowAllEgressWithoutPorts, true),
+		Entry("different action", allowHTTPS, denyHTTPS, false),
+		Entry("different direction", allowHTTPS, allowAllEgress, false),
+	)
+
+	DescribeTable("#DiffEntries",
+		func(a, b *NetworkAcl, expectedCreatedCount, expectedReplacedCount, expectedDeletedCount int) {
+			created, replaced, deleted := a.DiffEntries(b)
+			Expect(created).To(HaveLen(expectedCreatedCount))
+			Expect(replaced).To(HaveLen(expectedReplacedCount))
+			Expect(deleted).To(HaveLen(expectedDeletedCount))
+		},
+
+		Entry("acl1-acl1", acl1, acl1, 0, 0, 0),
+		Entry("acl1-acl2", acl1, acl2, 0, 0, 0),
+		Entry("acl1-acl3", acl1, acl3, 1, 1, 0),
+		Entry("acl3-acl1", acl3, acl1, 0, 1, 1),
+		Entry("acl1-acl4", acl1, acl4, 2, 0, 0),
+		Entry("acl4-acl1", acl4, acl1, 0, 0, 2),
+	)
+})
@@ -23,6 +23,7 @@ import (
 type Updater interface {
 	UpdateVpc(ctx context.Context, desired, current *VPC) (modified bool, err error)
 	UpdateSecurityGroup(ctx context.Context, desired, current *SecurityGroup) (modified bool, err error)
+	UpdateNetworkAcl(ctx context.Context, desired, current *NetworkAcl) (modified bool, err error)
 	UpdateRouteTable(ctx context.Context, log logr.Logger, desired, current *RouteTable) (modified bool, err error)
 	UpdateSubnet(ctx context.Context, desired, current *Subnet) (modified bool, err error)
 	UpdateIAMInstanceProfile(ctx context.Context, desired, current *IAMInstanceProfile) (modified bool, err error)
@@ -103,6 +104,31 @@ func (u *updater) UpdateSecurityGroup(ctx context.Context, desired, current *Sec
 	return true, nil
 }
 
+func (u *updater) UpdateNetworkAcl(ctx context.Context, desired, current *NetworkAcl) (modified bool, err error) {
+	created, replaced, deleted := desired.DiffEntries(current)
+	for _, entry := range deleted {
+		if err = u.client.DeleteNetworkAclEntry(ctx, current.NetworkAclId, entry); err != nil {
+			return
+		}
+	}
+	for _, entry := range replaced {
+		if err = u.client.ReplaceNetworkAclEntry(ctx, current.NetworkAclId, entry); err != nil {
+			return
+		}
+	}
+	for _, entry := range created {
+		if err = u.client.CreateNetworkAclEntry(ctx, current.NetworkAclId, entry); err != nil {
+			return
+		}
+	}
+	modified = len(created) > 0 || len(replaced) > 0 || len(deleted) > 0
+	tagsModified, err := u.UpdateEC2Tags(ctx, current.NetworkAclId, desired.Tags, current.Tags)
+	if err != nil {
+		return
+	}
+	return modified || tagsModified, nil
+}
+
 // TODO: Consider IPv4 xor IPv6 xor destination prefix lists as destination.
 func (u *updater) UpdateRouteTable(ctx context.Context, log logr.Logger, desired, current *RouteTable) (bool, error) {
 	var (
@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// Feature is a part of the infrastructure which needs a distinct set of permissions.
+type Feature string
+
+const (
+	// FeatureCommon are the resources every infrastructure consists of, e.g. subnets, route tables and the IAM role of
+	// the nodes.
+	FeatureCommon Feature = "common"
+	// FeatureManagedVPC is a VPC created by the extension.
+	FeatureManagedVPC Feature = "managed VPC"
+	// FeatureExistingVPC is a VPC configured with `networks.vpc.id`.
+	FeatureExistingVPC Feature = "existing VPC"
+	// FeatureNATGateways are the NAT gateways and their elastic IPs, which are created unless the egress mode is `None`.
+	FeatureNATGateways Feature = "NAT gateways"
+	// FeatureGatewayEndpoints are the VPC endpoints configured with `networks.vpc.gatewayEndpoints`.
+	FeatureGatewayEndpoints Feature = "gateway endpoints"
+	// FeatureSSHKeyPair is the EC2 key pair of the SSH public key of the infrastructure.
+	FeatureSSHKeyPair Feature = "SSH key pair"
+	// FeatureIPv6 are the IPv6 CIDRs of dual-stack and IPv6 shoots.
+	FeatureIPv6 Feature = "IPv6"
+	// FeatureManagedVPCIPv6 is the egress-only internet gateway of IPv6 shoots in a managed VPC.
+	FeatureManagedVPCIPv6 Feature = "IPv6 with managed VPC"
+	// FeatureFlowLogs are the flow logs of a managed VPC configured with `flowLogs`.
+	FeatureFlowLogs Feature = "flow logs"
+	// FeatureFlowLogsS3 is the S3 destination of the flow logs configured with `flowLogs.s3BucketARN`.
+	FeatureFlowLogsS3 Feature = "flow logs to S3"
+	// FeatureFlowLogsCloudWatch is the CloudWatch Logs destination of the flow logs configured with
+	// `flowLogs.cloudWatchLogGroup` and the IAM role which allows the flow logs service to publish to it.
+	FeatureFlowLogsCloudWatch Feature = "flow logs to CloudWatch Logs"
+	// FeatureEFS is the EFS file system configured with `elasticFileSystem`.
+	FeatureEFS Feature = "EFS"
+	// FeatureEFSKMSKey is the customer managed KMS key of the EFS file system configured with
+	// `elasticFileSystem.kmsKeyID`.
+	FeatureEFSKMSKey Feature = "EFS KMS key"
+	// FeatureFSx is the FSx file system configured with `fsx`.
+	FeatureFSx Feature = "FSx"
+	// FeatureECR is the access of the nodes to ECR. IAM only allows granting it to the nodes with the role policy.
+	FeatureECR Feature = "ECR"
+	// FeatureSecondaryElasticIPs are the secondary elastic IPs of NAT gateways configured with
+	// `networks.zones[].secondaryElasticIPs`.
+	FeatureSecondaryElasticIPs Feature = "secondary elastic IPs"
+	// FeaturePublicIPv4Pool is the BYOIP pool configured with `networks.elasticIPPool.publicIPv4PoolID`.
+	FeaturePublicIPv4Pool Feature = "public IPv4 pool"
+	// FeatureIPAMPool is the IPAM pool configured with `networks.elasticIPPool.ipamPoolID`.
+	FeatureIPAMPool Feature = "IPAM pool"
+	// FeatureNetworkACLs are the network ACLs of the subnets configured with `networks.networkACLs`.
+	FeatureNetworkACLs Feature = "network ACLs"
+)
+
+// RequiredActions contains the IAM actions the infrastructure reconciliation performs per feature. It must be extended
+// whenever the reconciliation starts using a new action.
+var RequiredActions = map[Feature][]string{
+	FeatureCommon: {
+		"ec2:AssociateRouteTable",
+		"ec2:AuthorizeSecurityGroupEgress",
+		"ec2:AuthorizeSecurityGroupIngress",
+		"ec2:CreateRoute",
+		"ec2:CreateRouteTable",
+		"ec2:CreateSecurityGroup",
+		"ec2:CreateSubnet",
+		"ec2:CreateTags",
+		"ec2:DeleteKeyPair",
+		"ec2:DeleteRoute",
+		"ec2:DeleteRouteTable",
+		"ec2:DeleteSecurityGroup",
+		"ec2:DeleteSubnet",
+		"ec2:DeleteTags",
+		"ec2:DescribeKeyPairs",
+		"ec2:DescribeRouteTables",
+		"ec2:DescribeSecurityGroups",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeVpcs",
+		"ec2:DisassociateRouteTable",
+		"ec2:ModifySubnetAttribute",
+		"ec2:RevokeSecurityGroupEgress",
+		"ec2:RevokeSecurityGroupIngress",
+		"iam:AddRoleToInstanceProfile",
+		"iam:CreateInstanceProfile",
+		"iam:CreateRole",
+		"iam:DeleteInstanceProfile",
+		"iam:DeleteRole",
+		"iam:DeleteRolePolicy",
+		"iam:GetInstanceProfile",
+		"iam:GetRole",
+		"iam:GetRolePolicy",
+		"iam:PassRole",
+		"iam:PutRolePolicy",
+		"iam:RemoveRoleFromInstanceProfile",
+		"iam:UpdateAssumeRolePolicy",
+	},
+	FeatureManagedVPC: {
+		"ec2:AssociateDhcpOptions",
+		"ec2:AttachInternetGateway",
+		"ec2:CreateDhcpOptions",
+		"ec2:CreateInternetGateway",
+		"ec2:CreateVpc",
+		"ec2:DeleteDhcpOptions",
+		"ec2:DeleteInternetGateway",
+		"ec2:DeleteVpc",
+		"ec2:DescribeDhcpOptions",
+		"ec2:DescribeInternetGateways",
+		"ec2:DescribeVpcAttribute",
+		"ec2:DetachInternetGateway",
+		"ec2:ModifyVpcAttribute",
+	},
+	FeatureExistingVPC: {
+		"ec2:DescribeDhcpOptions",
+		"ec2:DescribeInternetGateways",
+		"ec2:DescribeNetworkInterfaces",
+		"ec2:DescribeVpcAttribute",
+	},
+	FeatureNATGateways: {
+		"ec2:AllocateAddress",
+		"ec2:CreateNatGateway",
+		"ec2:DeleteNatGateway",
+		"ec2:DescribeAddresses",
+		"ec2:DescribeNatGateways",
+		"ec2:ReleaseAddress",
+	},
+	FeatureGatewayEndpoints: {
+		"ec2:CreateVpcEndpoint",
+		"ec2:DeleteVpcEndpoints",
+		"ec2:DescribeVpcEndpoints",
+		"ec2:ModifyVpcEndpoint",
+	},
+	FeatureSSHKeyPair: {
+		"ec2:ImportKeyPair",
+	},
+	FeatureIPv6: {
+		"ec2:AssociateSubnetCidrBlock",
+		"ec2:AssociateVpcCidrBlock",
+		"ec2:CreateSubnetCidrReservation",
+		"ec2:DescribeEgressOnlyInternetGateways",
+		"ec2:GetSubnetCidrReservations",
+	},
+	FeatureManagedVPCIPv6: {
+		"ec2:CreateEgressOnlyInternetGateway",
+		"ec2:DeleteEgressOnlyInternetGateway",
+	},
+	FeatureFlowLogs: {
+		"ec2:CreateFlowLogs",
+		"ec2:DeleteFlowLogs",
+		"ec2:DescribeFlowLogs",
+	},
+	FeatureFlowLogsS3: {
+		"logs:CreateLogDelivery",
+		"logs:DeleteLogDelivery",
+	},
+	FeatureFlowLogsCloudWatch: {
+		"iam:PassRole",
+	},
+	FeatureEFS: {
+		"ec2:CreateNetworkInterface",
+		"ec2:DescribeNetworkInterfaces",
+		"elasticfilesystem:CreateAccessPoint",
+		"elasticfilesystem:CreateFileSystem",
+		"elasticfilesystem:CreateMountTarget",
+		"elasticfilesystem:DeleteAccessPoint",
+		"elasticfilesystem:DeleteFileSystem",
+		"elasticfilesystem:DeleteMountTarget",
+		"elasticfilesystem:DescribeAccessPoints",
+		"elasticfilesystem:DescribeBackupPolicy",
+		"elasticfilesystem:DescribeFileSystems",
+		"elasticfilesystem:DescribeLifecycleConfiguration",
+		"elasticfilesystem:DescribeMountTargets",
+		"elasticfilesystem:ListTagsForResource",
+		"elasticfilesystem:PutBackupPolicy",
+		"elasticfilesystem:PutLifecycleConfiguration",
+		"elasticfilesystem:TagResource",
+		"elasticfilesystem:UntagResource",
+		"elasticfilesystem:UpdateFileSystem",
+	},
+	FeatureEFSKMSKey: {
+		"kms:CreateGrant",
+		"kms:DescribeKey",
+	},
+	FeatureFSx: {
+		"ec2:CreateNetworkInterface",
+		"ec2:DescribeNetworkInterfaces",
+		"fsx:CreateFileSystem",
+		"fsx:DeleteFileSystem",
+		"fsx:DescribeFileSystems",
+		"fsx:TagResource",
+		"iam:CreateServiceLinkedRole",
+	},
+	FeatureECR: {
+		"iam:PutRolePolicy",
+	},
+	FeatureSecondaryElasticIPs: {
+		"ec2:AssociateNatGatewayAddress",
+		"ec2:DisassociateNatGatewayAddress",
+		"ec2:UnassignPrivateNatGatewayAddress",
+	},
+	FeaturePublicIPv4Pool: {
+		"ec2:DescribePublicIpv4Pools",
+	},
+	FeatureIPAMPool: {
+		"ec2:GetIpamPoolAllocations",
+		"ec2:GetIpamPoolCidrs",
+	},
+	FeatureNetworkACLs: {
+		"ec2:CreateNetworkAcl",
+		"ec2:CreateNetworkAclEntry",
+		"ec2:DeleteNetworkAcl",
+		"ec2:DeleteNetworkAclEntry",
+		"ec2:DescribeNetworkAcls",
+		"ec2:ReplaceNetworkAclAssociation",
+		"ec2:ReplaceNetworkAclEntry",
+	},
+}
+
+// InfrastructureFeatures returns the features used by an infrastructure with the given configuration, IP families and
+// SSH public key.
+func InfrastructureFeatures(config *apisaws.InfrastructureConfig, ipFamilies []gardencorev1beta1.IPFamily, sshPublicKey []byte) []Feature {
+	features := []Feature{FeatureCommon}
+
+	managedVPC := config.Networks.VPC.ID == nil
+	if managedVPC {
+		features = append(features, FeatureManagedVPC)
+	} else {
+		features = append(features, FeatureExistingVPC)
+	}
+	if slices.ContainsFunc(config.Networks.Zones, func(zone apisaws.Zone) bool { return helper.HasNATGateway(config, zone.Name) }) {
+		features = append(features, FeatureNATGateways)
+	}
+	if len(config.Networks.VPC.GatewayEndpoints) > 0 {
+		features = append(features, FeatureGatewayEndpoints)
+	}
+	if len(sshPublicKey) > 0 {
+		features = append(features, FeatureSSHKeyPair)
+	}
+
+	if (config.DualStack != nil && config.DualStack.Enabled) || slices.Contains(ipFamilies, gardencorev1beta1.IPFamilyIPv6) {
+		features = append(features, FeatureIPv6)
+		if managedVPC {
+			features = append(features, FeatureManagedVPCIPv6)
+		}
+	}
+	if config.FlowLogs != nil {
+		features = append(features, FeatureFlowLogs)
+		if config.FlowLogs.S3BucketARN != nil {
+			features = append(features, FeatureFlowLogsS3)
+		} else {
+			features = append(features, FeatureFlowLogsCloudWatch)
+		}
+	}
+	if config.ElasticFileSystem != nil && config.ElasticFileSystem.Enabled {
+		features = append(features, FeatureEFS)
+		if config.ElasticFileSystem.KMSKeyID != nil {
+			features = append(features, FeatureEFSKMSKey)
+		}
+	}
+	if config.FSx != nil {
+		features = append(features, FeatureFSx)
+	}
+	if config.EnableECRAccess == nil || *config.EnableECRAccess {
+		features = append(features, FeatureECR)
+	}
+	if slices.ContainsFunc(config.Networks.Zones, func(zone apisaws.Zone) bool { return zone.SecondaryElasticIPs != nil }) {
+		features = append(features, FeatureSecondaryElasticIPs)
+	}
+	if pool := config.Networks.ElasticIPPool; pool != nil {
+		if pool.PublicIPv4PoolID != nil {
+			features = append(features, FeaturePublicIPv4Pool)
+		}
+		if pool.IPAMPoolID != nil {
+			features = append(features, FeatureIPAMPool)
+		}
+	}
+	if config.Networks.NetworkACLs != nil {
+		features = append(features, FeatureNetworkACLs)
+	}
+	return features
+}
+
+// MissingPermissionsError is returned by CheckPermissions if the credentials are not permitted to perform some of the
+// required actions.
+type MissingPermissionsError struct {
+	// PrincipalARN is the ARN of the IAM principal behind the credentials.
+	PrincipalARN string
+	// MissingActions contains the sorted actions the principal is not permitted to perform per feature.
+	MissingActions map[Feature][]string
+}
+
+// Error implements error.
+func (e *MissingPermissionsError) Error() string {
+	features := make([]Feature, 0, len(e.MissingActions))
+	for feature := range e.MissingActions {
+		features = append(features, feature)
+	}
+	slices.Sort(features)
+
+	details := make([]string, 0, len(features))
+	for _, feature := range features {
+		details = append(details, fmt.Sprintf("%s: %s", feature, strings.Join(e.MissingActions[feature], ", ")))
+	}
+	return fmt.Sprintf("UnauthorizedOperation: %s is not permitted to perform the following actions (%s)", e.PrincipalARN, strings.Join(details, "; "))
+}
+
+// CheckPermissions simulates the IAM policies of the principal behind the credentials of the given client for the
+// actions required by the given features in the given context. It returns a MissingPermissionsError listing every
+// denied action. The check is skipped if the credentials are not permitted to simulate their own policies or if the
+// principal cannot be simulated, e.g. for federated users.
+func CheckPermissions(ctx context.Context, awsClient awsclient.Interface, simulationContext awsclient.PolicySimulationContext, features ...Feature) error {
+	actions := sets.New[string]()
+	for _, feature := range features {
+		actions.Insert(RequiredActions[feature]...)
+	}
+	if actions.Len() == 0 {
+		return nil
+	}
+
+	principalARN, err := awsClient.GetCallerPrincipalARN(ctx)
+	if err != nil {
+		if isSimulationUnavailable(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to determine the principal of the credentials: %w", err)
+	}
+
+	denied, err := awsClient.SimulatePrincipalPolicy(ctx, principalARN, sets.List(actions), simulationContext)
+	if err != nil {
+		if isSimulationUnavailable(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to simulate the policies of %s: %w", principalARN, err)
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+
+	deniedActions := sets.New(denied...)
+	missing := map[Feature][]string{}
+	for _, feature := range features {
+		var featureActions []string
+		for _, action := range RequiredActions[feature] {
+			if deniedActions.Has(action) {
+				featureActions = append(featureActions, action)
+			}
+		}
+		if len(featureActions) > 0 {
+			slices.Sort(featureActions)
+			missing[feature] = featureActions
+		}
+	}
+	return &MissingPermissionsError{PrincipalARN: principalARN, MissingActions: missing}
+}
+
+// CheckCredentialsPermissions creates a client for the given auth config and checks the permissions of the credentials
+// for the given features in any region, see CheckPermissions.
+func CheckCredentialsPermissions(ctx context.Context, factory awsclient.Factory, authConfig awsclient.AuthConfig, features ...Feature) error {
+	authConfig.Region = cmp.Or(authConfig.Region, globalServiceRegion)
+	awsClient, err := factory.NewClient(authConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create AWS client: %w", err)
+	}
+	return CheckPermissions(ctx, awsClient, awsclient.PolicySimulationContext{}, features...)
+}
+
+func isSimulationUnavailable(err error) bool {
+	switch awsclient.GetAWSAPIErrorCode(err) {
+	case "AccessDenied", "InvalidInput", "NoSuchEntity":
+		return true
+	}
+	return false
+}
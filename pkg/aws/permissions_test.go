@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/smithy-go"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/fake"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("Permissions", func() {
+	var ctx = context.Background()
+
+	Describe("#InfrastructureFeatures", func() {
+		It("should return the features of a managed IPv4 VPC", func() {
+			config := &apisaws.InfrastructureConfig{}
+
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureECR}))
+		})
+
+		It("should return the features of an existing dual-stack VPC with EFS and without ECR", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess:   ptr.To(false),
+				ElasticFileSystem: &apisaws.ElasticFileSystemConfig{Enabled: true},
+				Networks:          apisaws.Networks{VPC: apisaws.VPC{ID: ptr.To("vpc-1234")}},
+			}
+
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}, nil)).To(Equal([]Feature{FeatureCommon, FeatureExistingVPC, FeatureIPv6, FeatureEFS}))
+		})
+
+		It("should return the features of an EFS with a customer managed KMS key", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess:   ptr.To(false),
+				ElasticFileSystem: &apisaws.ElasticFileSystemConfig{Enabled: true, KMSKeyID: ptr.To("alias/efs")},
+			}
+
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureEFS, FeatureEFSKMSKey}))
+		})
+
+		It("should return the features of an FSx file system", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess: ptr.To(false),
+				FSx:             &apisaws.FSxConfig{Type: apisaws.FSxFileSystemTypeLustre, StorageCapacityGiB: 1200},
+			}
+
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureFSx}))
+		})
+
+		It("should return the features of flow logs", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess: ptr.To(false),
+				FlowLogs:        &apisaws.FlowLogs{CloudWatchLogGroup: ptr.To("/gardener/flow-logs")},
+			}
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureFlowLogs, FeatureFlowLogsCloudWatch}))
+
+			config.FlowLogs = &apisaws.FlowLogs{S3BucketARN: ptr.To("arn:aws:s3:::my-flow-logs")}
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureFlowLogs, FeatureFlowLogsS3}))
+		})
+
+		It("should return the features of a managed IPv6 VPC", func() {
+			config := &apisaws.InfrastructureConfig{EnableECRAccess: ptr.To(false)}
+
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureIPv6, FeatureManagedVPCIPv6}))
+		})
+
+		It("should return the features of secondary elastic IPs and the configured elastic IP pool", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess: ptr.To(false),
+				Networks: apisaws.Networks{
+					Zones:         []apisaws.Zone{{Name: "eu-west-1a", SecondaryElasticIPs: &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](1)}}},
+					ElasticIPPool: &apisaws.ElasticIPPool{IPAMPoolID: ptr.To("ipam-pool-1234")},
+				},
+			}
+
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureNATGateways, FeatureSecondaryElasticIPs, FeatureIPAMPool}))
+		})
+
+		It("should return the features of NAT gateways, gateway endpoints and the SSH key pair", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess: ptr.To(false),
+				Networks: apisaws.Networks{
+					VPC:   apisaws.VPC{GatewayEndpoints: []string{"s3"}},
+					Zones: []apisaws.Zone{{Name: "eu-west-1a"}, {Name: "eu-west-1b"}},
+				},
+			}
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, []byte("ssh-rsa AAAA"))).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureNATGateways, FeatureGatewayEndpoints, FeatureSSHKeyPair}))
+
+			config.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeSingleNATGateway}
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC, FeatureNATGateways, FeatureGatewayEndpoints}))
+		})
+
+		It("should only return the common features of a least-privilege shoot", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess: ptr.To(false),
+				Networks: apisaws.Networks{
+					Zones:  []apisaws.Zone{{Name: "eu-west-1a"}, {Name: "eu-west-1b"}},
+					Egress: &apisaws.Egress{Mode: apisaws.EgressModeNone},
+				},
+			}
+			features := InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)
+			Expect(features).To(Equal([]Feature{FeatureCommon, FeatureManagedVPC}))
+
+			awsClient := fake.New()
+			awsClient.DenyActions(RequiredActions[FeatureNATGateways]...)
+			awsClient.DenyActions(RequiredActions[FeatureGatewayEndpoints]...)
+			awsClient.DenyActions(RequiredActions[FeatureSSHKeyPair]...)
+			Expect(CheckPermissions(ctx, awsClient, awsclient.PolicySimulationContext{}, features...)).To(Succeed())
+		})
+
+		It("should return the features of network ACLs", func() {
+			config := &apisaws.InfrastructureConfig{
+				EnableECRAccess: ptr.To(false),
+				Networks: apisaws.Networks{
+					VPC:         apisaws.VPC{ID: ptr.To("vpc-1234")},
+					NetworkACLs: &apisaws.NetworkACLs{Workers: &apisaws.NetworkACL{}},
+				},
+			}
+
+			Expect(InfrastructureFeatures(config, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}, nil)).To(Equal([]Feature{FeatureCommon, FeatureExistingVPC, FeatureNetworkACLs}))
+		})
+	})
+
+	Describe("#CheckPermissions", func() {
+		It("should succeed if all actions are permitted", func() {
+			Expect(CheckPermissions(ctx, fake.New(), awsclient.PolicySimulationContext{}, FeatureCommon, FeatureManagedVPC, FeatureEFS)).To(Succeed())
+		})
+
+		It("should report all missing actions of the requested features", func() {
+			awsClient := fake.New()
+			awsClient.DenyActions("ec2:CreateVpc", "ec2:CreateSubnet", "elasticfilesystem:CreateFileSystem", "ec2:CreateEgressOnlyInternetGateway")
+
+			err := CheckPermissions(ctx, awsClient, awsclient.PolicySimulationContext{}, FeatureCommon, FeatureManagedVPC, FeatureEFS)
+
+			missing := &MissingPermissionsError{}
+			Expect(errors.As(err, &missing)).To(BeTrue())
+			Expect(missing.PrincipalARN).To(Equal("arn:aws:iam::123456789012:user/gardener"))
+			Expect(missing.MissingActions).To(Equal(map[Feature][]string{
+				FeatureCommon:     {"ec2:CreateSubnet"},
+				FeatureManagedVPC: {"ec2:CreateVpc"},
+				FeatureEFS:        {"elasticfilesystem:CreateFileSystem"},
+			}))
+			Expect(err).To(MatchError("UnauthorizedOperation: arn:aws:iam::123456789012:user/gardener is not permitted to perform the following actions " +
+				"(EFS: elasticfilesystem:CreateFileSystem; common: ec2:CreateSubnet; managed VPC: ec2:CreateVpc)"))
+		})
+
+		It("should skip the check if the credentials are not permitted to simulate their policies", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsClient := mockawsclient.NewMockInterface(ctrl)
+			awsClient.EXPECT().GetCallerPrincipalARN(ctx).Return("arn:aws:iam::123456789012:user/gardener", nil)
+			awsClient.EXPECT().SimulatePrincipalPolicy(ctx, "arn:aws:iam::123456789012:user/gardener", gomock.Any(), awsclient.PolicySimulationContext{}).Return(nil, &smithy.GenericAPIError{Code: "AccessDenied"})
+
+			Expect(CheckPermissions(ctx, awsClient, awsclient.PolicySimulationContext{}, FeatureCommon)).To(Succeed())
+		})
+
+		It("should return other errors of the simulation", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsClient := mockawsclient.NewMockInterface(ctrl)
+			awsClient.EXPECT().GetCallerPrincipalARN(ctx).Return("arn:aws:iam::123456789012:user/gardener", nil)
+			awsClient.EXPECT().SimulatePrincipalPolicy(ctx, "arn:aws:iam::123456789012:user/gardener", gomock.Any(), awsclient.PolicySimulationContext{}).Return(nil, &smithy.GenericAPIError{Code: "Throttling"})
+
+			Expect(CheckPermissions(ctx, awsClient, awsclient.PolicySimulationContext{}, FeatureCommon)).To(MatchError(ContainSubstring("failed to simulate the policies of arn:aws:iam::123456789012:user/gardener")))
+		})
+	})
+})
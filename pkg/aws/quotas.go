@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// Quota is an AWS service quota.
+type Quota struct {
+	// ServiceCode is the code of the service the quota belongs to.
+	ServiceCode string
+	// QuotaCode is the code of the quota.
+	QuotaCode string
+	// Name is the name of the quota.
+	Name string
+}
+
+var (
+	// QuotaVPCs is the quota of VPCs per region.
+	QuotaVPCs = Quota{ServiceCode: "vpc", QuotaCode: "L-F678F1CE", Name: "VPCs per Region"}
+	// QuotaElasticIPs is the quota of elastic IP addresses per region.
+	QuotaElasticIPs = Quota{ServiceCode: "ec2", QuotaCode: "L-0263D0A3", Name: "EC2-VPC Elastic IPs"}
+	// QuotaNATGateways is the quota of NAT gateways per availability zone.
+	QuotaNATGateways = Quota{ServiceCode: "vpc", QuotaCode: "L-FE5A380F", Name: "NAT gateways per Availability Zone"}
+)
+
+// onDemandVCPUQuotas are the quotas of vCPUs of running on-demand instances per region by the prefix of the instance
+// family.
+var onDemandVCPUQuotas = map[string]Quota{}
+
+func init() {
+	for quota, prefixes := range map[Quota][]string{
+		{ServiceCode: "ec2", QuotaCode: "L-1216C47A", Name: "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances"}: {"a", "c", "d", "h", "i", "im", "is", "m", "r", "t", "z"},
+		{ServiceCode: "ec2", QuotaCode: "L-74FC7D96", Name: "Running On-Demand F instances"}:                                    {"f"},
+		{ServiceCode: "ec2", QuotaCode: "L-DB2E81BA", Name: "Running On-Demand G and VT instances"}:                             {"g", "vt"},
+		{ServiceCode: "ec2", QuotaCode: "L-1945791B", Name: "Running On-Demand Inf instances"}:                                  {"inf"},
+		{ServiceCode: "ec2", QuotaCode: "L-417A185B", Name: "Running On-Demand P instances"}:                                    {"p"},
+		{ServiceCode: "ec2", QuotaCode: "L-7295265B", Name: "Running On-Demand X instances"}:                                    {"x"},
+		{ServiceCode: "ec2", QuotaCode: "L-6E869C2A", Name: "Running On-Demand DL instances"}:                                   {"dl"},
+		{ServiceCode: "ec2", QuotaCode: "L-2C3B7624", Name: "Running On-Demand Trn instances"}:                                  {"trn"},
+		{ServiceCode: "ec2", QuotaCode: "L-43DA4232", Name: "Running On-Demand High Memory instances"}:                          {"u"},
+		{ServiceCode: "ec2", QuotaCode: "L-F7808C92", Name: "Running On-Demand HPC instances"}:                                  {"hpc"},
+	} {
+		for _, prefix := range prefixes {
+			onDemandVCPUQuotas[prefix] = quota
+		}
+	}
+}
+
+// OnDemandVCPUQuota returns the quota of vCPUs of running on-demand instances the given instance type counts against.
+// It returns false for instance types without such a quota, e.g. mac instances.
+func OnDemandVCPUQuota(instanceType string) (Quota, bool) {
+	family, _, _ := strings.Cut(instanceType, ".")
+	prefix := strings.ToLower(family)
+	if i := strings.IndexFunc(prefix, func(r rune) bool { return r < 'a' || r > 'z' }); i >= 0 {
+		prefix = prefix[:i]
+	}
+	quota, ok := onDemandVCPUQuotas[prefix]
+	return quota, ok
+}
+
+// QuotaRequest is an amount of a quota a reconciliation is going to consume in addition to the current usage.
+type QuotaRequest struct {
+	// Quota is the requested quota.
+	Quota Quota
+	// Zone is the availability zone for quotas which apply per zone. It is empty for regional quotas.
+	Zone string
+	// Amount is the requested amount.
+	Amount int
+}
+
+// ExceededQuota is a quota which is too small for a QuotaRequest.
+type ExceededQuota struct {
+	QuotaRequest
+	// Limit is the value of the quota.
+	Limit int
+	// Usage is the current usage of the quota.
+	Usage int
+}
+
+// String returns a description of the exceeded quota.
+func (q ExceededQuota) String() string {
+	scope := ""
+	if q.Zone != "" {
+		scope = " in " + q.Zone
+	}
+	return fmt.Sprintf("%s (%s/%s)%s: %d of %d used, %d more needed", q.Quota.Name, q.Quota.ServiceCode, q.Quota.QuotaCode, scope, q.Usage, q.Limit, q.Amount)
+}
+
+// QuotaExceededError is returned by CheckQuotas if quotas are too small for the requested amounts.
+type QuotaExceededError struct {
+	// Exceeded are the exceeded quotas.
+	Exceeded []ExceededQuota
+}
+
+// Error implements error.
+func (e *QuotaExceededError) Error() string {
+	details := make([]string, 0, len(e.Exceeded))
+	for _, q := range e.Exceeded {
+		details = append(details, q.String())
+	}
+	return "service quota exceeded: " + strings.Join(details, "; ")
+}
+
+// CheckQuotas compares the given requests with the values and current usage of the quotas. It returns a
+// QuotaExceededError listing every quota which is too small. Quotas whose value cannot be determined are not checked.
+func CheckQuotas(ctx context.Context, awsClient awsclient.Interface, requests []QuotaRequest) error {
+	type key struct {
+		quota Quota
+		zone  string
+	}
+	amounts := map[key]int{}
+	for _, request := range requests {
+		if request.Amount > 0 {
+			amounts[key{request.Quota, request.Zone}] += request.Amount
+		}
+	}
+	keys := slices.SortedFunc(maps.Keys(amounts), func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.quota.Name, b.quota.Name), cmp.Compare(a.zone, b.zone))
+	})
+
+	var (
+		limits = map[Quota]*float64{}
+		usages = map[Quota]map[string]int{}
+		vcpus  map[string]int
+
+		exceeded []ExceededQuota
+	)
+	for _, k := range keys {
+		limit, ok := limits[k.quota]
+		if !ok {
+			var err error
+			if limit, err = awsClient.GetServiceQuota(ctx, k.quota.ServiceCode, k.quota.QuotaCode); err != nil {
+				if awsclient.GetAWSAPIErrorCode(err) != "AccessDeniedException" {
+					return fmt.Errorf("failed to get service quota %s: %w", k.quota.Name, err)
+				}
+				// credentials without access to service quotas are not checked
+				limit = nil
+			}
+			limits[k.quota] = limit
+		}
+		if limit == nil {
+			continue
+		}
+
+		usage, ok := usages[k.quota]
+		if !ok {
+			var err error
+			switch k.quota {
+			case QuotaVPCs:
+				usage, err = regionalUsage(awsClient.CountVpcs(ctx))
+			case QuotaElasticIPs:
+				usage, err = regionalUsage(awsClient.CountElasticIPs(ctx))
+			case QuotaNATGateways:
+				usage, err = awsClient.CountNATGatewaysByZone(ctx)
+			default:
+				if vcpus == nil {
+					vcpus, err = awsClient.GetRunningOnDemandVCPUs(ctx, nil)
+				}
+				usage = map[string]int{"": VCPUsByQuota(vcpus)[k.quota]}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to determine usage of service quota %s: %w", k.quota.Name, err)
+			}
+			usages[k.quota] = usage
+		}
+
+		if usage[k.zone]+amounts[k] > int(*limit) {
+			exceeded = append(exceeded, ExceededQuota{
+				QuotaRequest: QuotaRequest{Quota: k.quota, Zone: k.zone, Amount: amounts[k]},
+				Limit:        int(*limit),
+				Usage:        usage[k.zone],
+			})
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return &QuotaExceededError{Exceeded: exceeded}
+	}
+	return nil
+}
+
+// VCPUsByQuota sums up the given vCPUs per instance type by their on-demand vCPU quota.
+func VCPUsByQuota(vcpus map[string]int) map[Quota]int {
+	out := map[Quota]int{}
+	for instanceType, v := range vcpus {
+		if quota, ok := OnDemandVCPUQuota(instanceType); ok {
+			out[quota] += v
+		}
+	}
+	return out
+}
+
+// OnDemandVCPURequests returns the requests of on-demand vCPU quotas for running the given number of instances per
+// instance type. The vCPUs of the running instances with the given tags, i.e. the instances of the same shoot, are
+// subtracted, as they already count against the quotas.
+func OnDemandVCPURequests(ctx context.Context, awsClient awsclient.Interface, instances map[string]int, tags awsclient.Tags) ([]QuotaRequest, error) {
+	vcpus, err := awsClient.GetInstanceTypeVCPUs(ctx, slices.Sorted(maps.Keys(instances)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vCPUs of instance types: %w", err)
+	}
+	desired := map[string]int{}
+	for instanceType, count := range instances {
+		desired[instanceType] = count * vcpus[instanceType]
+	}
+
+	running, err := awsClient.GetRunningOnDemandVCPUs(ctx, tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vCPUs of running instances: %w", err)
+	}
+	current := VCPUsByQuota(running)
+
+	var requests []QuotaRequest
+	for quota, v := range VCPUsByQuota(desired) {
+		if amount := v - current[quota]; amount > 0 {
+			requests = append(requests, QuotaRequest{Quota: quota, Amount: amount})
+		}
+	}
+	return requests, nil
+}
+
+func regionalUsage(count int, err error) (map[string]int, error) {
+	return map[string]int{"": count}, err
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/fake"
+)
+
+var _ = Describe("Quotas", func() {
+	var (
+		ctx       = context.Background()
+		awsClient *fake.Client
+	)
+
+	BeforeEach(func() {
+		awsClient = fake.New()
+	})
+
+	Describe("#OnDemandVCPUQuota", func() {
+		DescribeTable("should return the quota of the instance family",
+			func(instanceType, quotaCode string) {
+				quota, ok := OnDemandVCPUQuota(instanceType)
+				Expect(ok).To(Equal(quotaCode != ""))
+				Expect(quota.QuotaCode).To(Equal(quotaCode))
+			},
+			Entry("standard", "m5.large", "L-1216C47A"),
+			Entry("standard with multiple letters", "im4gn.xlarge", "L-1216C47A"),
+			Entry("G", "g5.xlarge", "L-DB2E81BA"),
+			Entry("Inf", "inf2.xlarge", "L-1945791B"),
+			Entry("X", "x2iedn.xlarge", "L-7295265B"),
+			Entry("high memory", "u-6tb1.metal", "L-43DA4232"),
+			Entry("mac", "mac1.metal", ""),
+		)
+	})
+
+	Describe("#CheckQuotas", func() {
+		It("should succeed if the quotas suffice", func() {
+			awsClient.SetServiceQuota("vpc", "L-F678F1CE", 5)
+
+			Expect(CheckQuotas(ctx, awsClient, []QuotaRequest{{Quota: QuotaVPCs, Amount: 1}})).To(Succeed())
+		})
+
+		It("should skip quotas which do not exist", func() {
+			Expect(CheckQuotas(ctx, awsClient, []QuotaRequest{{Quota: QuotaVPCs, Amount: 100}})).To(Succeed())
+		})
+
+		It("should report all exceeded quotas", func() {
+			awsClient.SetServiceQuota("vpc", "L-F678F1CE", 1)
+			awsClient.SetServiceQuota("ec2", "L-0263D0A3", 5)
+			_, err := awsClient.CreateVpc(ctx, &awsclient.VPC{CidrBlock: "10.0.0.0/16"})
+			Expect(err).NotTo(HaveOccurred())
+			for range 4 {
+				_, err := awsClient.CreateElasticIP(ctx, &awsclient.ElasticIP{Vpc: true})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err = CheckQuotas(ctx, awsClient, []QuotaRequest{
+				{Quota: QuotaVPCs, Amount: 1},
+				{Quota: QuotaElasticIPs, Amount: 1},
+				{Quota: QuotaElasticIPs, Amount: 1},
+			})
+
+			exceeded := &QuotaExceededError{}
+			Expect(errors.As(err, &exceeded)).To(BeTrue())
+			Expect(err).To(MatchError("service quota exceeded: EC2-VPC Elastic IPs (ec2/L-0263D0A3): 4 of 5 used, 2 more needed; " +
+				"VPCs per Region (vpc/L-F678F1CE): 1 of 1 used, 1 more needed"))
+		})
+
+		It("should check the vCPUs of on-demand instances", func() {
+			awsClient.SetServiceQuota("ec2", "L-1216C47A", 8)
+			awsClient.AddInstanceType("m5.large", 2)
+			shootTags := awsclient.Tags{"kubernetes.io/cluster/shoot--foo--bar": "1"}
+			awsClient.AddInstance("m5.large", shootTags)
+			awsClient.AddInstance("m5.large", nil)
+			awsClient.AddInstance("m5.large", nil)
+
+			standard, _ := OnDemandVCPUQuota("m5.large")
+
+			requests, err := OnDemandVCPURequests(ctx, awsClient, map[string]int{"m5.large": 2}, shootTags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests).To(ConsistOf(QuotaRequest{Quota: standard, Amount: 2}))
+			Expect(CheckQuotas(ctx, awsClient, requests)).To(Succeed())
+
+			requests, err = OnDemandVCPURequests(ctx, awsClient, map[string]int{"m5.large": 3}, shootTags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(CheckQuotas(ctx, awsClient, requests)).To(MatchError(ContainSubstring("Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances (ec2/L-1216C47A): 6 of 8 used, 4 more needed")))
+		})
+	})
+})
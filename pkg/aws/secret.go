@@ -8,14 +8,18 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	securityv1alpha1constants "github.com/gardener/gardener/pkg/apis/security/v1alpha1/constants"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
@@ -67,6 +71,14 @@ func ReadCredentialsSecret(secret *corev1.Secret, allowDNSKeys bool, region stri
 			ID:     string(accessKeyID),
 			Secret: string(secretAccessKey),
 		}
+
+		if data, ok := secret.Data[AssumeRoleChain]; ok {
+			chain, err := helper.AssumeRoleChainFromBytes(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q field in secret: %w", AssumeRoleChain, err)
+			}
+			authConfig.AssumeRoleChain = toAssumeRoleChain(chain)
+		}
 	} else {
 		// If access key data does not exist then we require that the secret contains
 		// information for workload identity authentication
@@ -82,11 +94,61 @@ func ReadCredentialsSecret(secret *corev1.Secret, allowDNSKeys bool, region stri
 			TokenRetriever: &staticTokenRetriever{token: secret.Data[securityv1alpha1constants.DataKeyToken]},
 			RoleARN:        roleARN,
 		}
+
+		if data, ok := secret.Data[securityv1alpha1constants.DataKeyConfig]; ok {
+			workloadIdentityConfig, err := helper.WorkloadIdentityConfigFromBytes(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse WorkloadIdentityConfig: %w", err)
+			}
+			authConfig.AssumeRoleChain = toAssumeRoleChain(workloadIdentityConfig.AssumeRoleChain)
+		}
 	}
 
 	regionFromSecret, _ := getSecretDataValue(secret, Region, altRegionKey, false)
 	authConfig.Region = cmp.Or(region, string(regionFromSecret))
 
+	authConfig.Partition = string(secret.Data[Partition])
+	authConfig.Endpoint = string(secret.Data[Endpoint])
+	if data, ok := secret.Data[UseFIPSEndpoints]; ok {
+		useFIPSEndpoints, err := strconv.ParseBool(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q field in secret: %w", UseFIPSEndpoints, err)
+		}
+		authConfig.UseFIPSEndpoints = useFIPSEndpoints
+	}
+
+	return authConfig, nil
+}
+
+// ApplyAPIConfig sets the API settings of the given config in the given auth config, unless the auth config already
+// sets the partition resp. an endpoint from the credentials secret.
+func ApplyAPIConfig(authConfig *awsclient.AuthConfig, config *apisaws.APIConfig) {
+	if config == nil {
+		return
+	}
+	if authConfig.Partition == "" {
+		authConfig.Partition = ptr.Deref(config.Partition, "")
+	}
+	if authConfig.Endpoint == "" && !authConfig.UseFIPSEndpoints {
+		authConfig.Endpoint = ptr.Deref(config.Endpoint, "")
+		authConfig.UseFIPSEndpoints = ptr.Deref(config.UseFIPSEndpoints, false)
+	}
+}
+
+// GetCredentialsForCluster reads the credentials like GetCredentialsFromSecretRef and completes them with the API
+// settings of the cloud profile of the given cluster.
+func GetCredentialsForCluster(ctx context.Context, client client.Client, secretRef corev1.SecretReference, region string, cluster *extensionscontroller.Cluster) (*awsclient.AuthConfig, error) {
+	authConfig, err := GetCredentialsFromSecretRef(ctx, client, secretRef, false, region)
+	if err != nil {
+		return nil, err
+	}
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if cloudProfileConfig != nil {
+		ApplyAPIConfig(authConfig, cloudProfileConfig.API)
+	}
 	return authConfig, nil
 }
 
@@ -101,6 +163,27 @@ func NewClientFromSecretRef(ctx context.Context, client client.Client, secretRef
 	return awsclient.NewClient(*authConfig)
 }
 
+// GetCredentialsForNamespace reads the credentials like GetCredentialsForCluster with the Cluster resource of the given
+// shoot namespace. The credentials are not completed if the namespace has no Cluster resource.
+func GetCredentialsForNamespace(ctx context.Context, client client.Client, secretRef corev1.SecretReference, region, namespace string) (*awsclient.AuthConfig, error) {
+	cluster, err := extensionscontroller.GetCluster(ctx, client, namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	return GetCredentialsForCluster(ctx, client, secretRef, region, cluster)
+}
+
+// NewClientForCluster creates a new Client like NewClientFromSecretRef, with the API settings of the cloud profile of
+// the given cluster.
+func NewClientForCluster(ctx context.Context, client client.Client, secretRef corev1.SecretReference, region string, cluster *extensionscontroller.Cluster) (awsclient.Interface, error) {
+	authConfig, err := GetCredentialsForCluster(ctx, client, secretRef, region, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return awsclient.NewClient(*authConfig)
+}
+
 func getSecretDataValue(secret *corev1.Secret, key string, altKey *string, required bool) ([]byte, error) {
 	if value, ok := secret.Data[key]; ok {
 		return value, nil
@@ -136,3 +219,17 @@ func getRoleARN(secret *corev1.Secret) (string, error) {
 
 	return workloadIdentityConfig.RoleARN, nil
 }
+
+func toAssumeRoleChain(chain []apisaws.AssumeRole) []awsclient.AssumeRole {
+	var result []awsclient.AssumeRole
+	for _, role := range chain {
+		result = append(result, awsclient.AssumeRole{
+			RoleARN:     role.RoleARN,
+			ExternalID:  role.ExternalID,
+			SessionName: ptr.Deref(role.SessionName, ""),
+			Duration:    ptr.Deref(role.Duration, metav1.Duration{}).Duration,
+			Policy:      role.SessionPolicy,
+		})
+	}
+	return result
+}
@@ -7,14 +7,17 @@ package aws_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
@@ -67,6 +70,44 @@ var _ = Describe("Secret", func() {
 			Expect(err).To(Equal(fakeErr))
 		})
 
+		It("should return the role chain of static credentials", func() {
+			secret.Data = map[string][]byte{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+				AssumeRoleChain: []byte(`[{"roleARN":"arn:aws:iam::222222222222:role/shoot","externalID":"external-id","sessionName":"gardener","duration":"1h","sessionPolicy":"{}"}]`),
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false, "sample")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.AssumeRoleChain).To(Equal([]awsclient.AssumeRole{{
+				RoleARN:     "arn:aws:iam::222222222222:role/shoot",
+				ExternalID:  ptr.To("external-id"),
+				SessionName: "gardener",
+				Duration:    time.Hour,
+				Policy:      ptr.To("{}"),
+			}}))
+		})
+
+		It("should return the role chain of the workload identity config", func() {
+			secret.Data = map[string][]byte{
+				"token":   []byte("foo"),
+				"roleARN": []byte("arn"),
+				"config": []byte(`apiVersion: aws.provider.extensions.gardener.cloud/v1alpha1
+kind: WorkloadIdentityConfig
+roleARN: arn
+assumeRoleChain:
+- roleARN: arn:aws:iam::222222222222:role/shoot
+`),
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false, "sample")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.WorkloadIdentity.RoleARN).To(Equal("arn"))
+			Expect(credentials.AssumeRoleChain).To(Equal([]awsclient.AssumeRole{{RoleARN: "arn:aws:iam::222222222222:role/shoot"}}))
+		})
+
 		Context("DNS keys are not allowed", func() {
 			It("should return the correct credentials object if non-DNS keys are used", func() {
 				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
@@ -334,5 +375,69 @@ roleARN:`),
 				Expect(token).To(Equal([]byte("foo")))
 			})
 		})
+
+		It("should return the API settings", func() {
+			secret.Data = map[string][]byte{
+				AccessKeyID:      accessKeyID,
+				SecretAccessKey:  secretAccessKey,
+				Partition:        []byte("aws-us-gov"),
+				UseFIPSEndpoints: []byte("true"),
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false, "us-gov-west-1")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.Partition).To(Equal("aws-us-gov"))
+			Expect(credentials.Endpoint).To(BeEmpty())
+			Expect(credentials.UseFIPSEndpoints).To(BeTrue())
+		})
+
+		It("should fail if the FIPS setting is not a boolean", func() {
+			secret.Data = map[string][]byte{
+				AccessKeyID:      accessKeyID,
+				SecretAccessKey:  secretAccessKey,
+				UseFIPSEndpoints: []byte("maybe"),
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false, "")
+
+			Expect(credentials).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring(UseFIPSEndpoints)))
+		})
+	})
+
+	Describe("#ApplyAPIConfig", func() {
+		var config *apisaws.APIConfig
+
+		BeforeEach(func() {
+			config = &apisaws.APIConfig{
+				Partition: ptr.To("aws-cn"),
+				Endpoint:  ptr.To("https://aws.example.com"),
+			}
+		})
+
+		It("should set the settings of the cloud profile", func() {
+			authConfig := &awsclient.AuthConfig{}
+
+			ApplyAPIConfig(authConfig, config)
+
+			Expect(authConfig).To(Equal(&awsclient.AuthConfig{Partition: "aws-cn", Endpoint: "https://aws.example.com"}))
+		})
+
+		It("should keep the settings of the secret", func() {
+			authConfig := &awsclient.AuthConfig{Partition: "aws-us-gov", UseFIPSEndpoints: true}
+
+			ApplyAPIConfig(authConfig, config)
+
+			Expect(authConfig).To(Equal(&awsclient.AuthConfig{Partition: "aws-us-gov", UseFIPSEndpoints: true}))
+		})
+
+		It("should do nothing without settings", func() {
+			authConfig := &awsclient.AuthConfig{Region: "eu-west-1"}
+
+			ApplyAPIConfig(authConfig, nil)
+
+			Expect(authConfig).To(Equal(&awsclient.AuthConfig{Region: "eu-west-1"}))
+		})
 	})
 })
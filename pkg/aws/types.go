@@ -132,6 +132,22 @@ const (
 
 	// AnnotationEnableVolumeAttributesClass is the annotation to use on shoots to enable VolumeAttributesClasses
 	AnnotationEnableVolumeAttributesClass = "aws.provider.extensions.gardener.cloud/enable-volume-attributes-class"
+	// AnnotationAdoptInfrastructure is the annotation to use on shoots to adopt existing AWS resources into a new
+	// infrastructure. Its value is the name of a resource in `.spec.resources` of the shoot which references a ConfigMap
+	// or Secret with the inventory of the resources.
+	AnnotationAdoptInfrastructure = "aws.provider.extensions.gardener.cloud/adopt-infrastructure"
+	// InventoryDataKey is the key of the InfrastructureInventory in the data of the ConfigMap or Secret referenced by the
+	// AnnotationAdoptInfrastructure annotation.
+	InventoryDataKey = "inventory.yaml"
+	// TerraformStateDataKey is the key of the optional Terraform state in the data of the ConfigMap or Secret referenced
+	// by the AnnotationAdoptInfrastructure annotation.
+	TerraformStateDataKey = "terraform.tfstate"
+	// AnnotationConfirmStatefulResourceDeletion is the annotation to use on shoots to confirm the deletion of the EFS and
+	// FSx file systems if the deletion policy of the infrastructure requires a confirmation.
+	AnnotationConfirmStatefulResourceDeletion = "aws.provider.extensions.gardener.cloud/confirm-stateful-resource-deletion"
+	// AnnotationEnforcePermissionCheck is the annotation to use on shoots to fail the infrastructure reconciliation if the
+	// credentials are missing permissions, instead of only reporting them in a condition of the infrastructure.
+	AnnotationEnforcePermissionCheck = "aws.provider.extensions.gardener.cloud/enforce-permission-check"
 
 	// WorkloadIdentityMountPath is the path where the workload identity token is usually mounted.
 	WorkloadIdentityMountPath = "/var/run/secrets/gardener.cloud/workload-identity"
@@ -139,6 +155,18 @@ const (
 	WorkloadIdentityTokenFileKey = "workloadIdentityTokenFile"
 	// RoleARN is a constant for the key in a cloud provider secret that points to the role which should be assumed.
 	RoleARN = "roleARN"
+	// AssumeRoleChain is a constant for the key in a cloud provider secret with static credentials that holds the list
+	// of roles which are assumed one after another with the credentials of the previous one.
+	AssumeRoleChain = "assumeRoleChain"
+	// Partition is a constant for the key in a cloud provider secret that holds the ARN partition of the region, e.g.
+	// "aws-cn".
+	Partition = "partition"
+	// Endpoint is a constant for the key in a cloud provider secret that holds the URL of the endpoint used for all AWS
+	// services.
+	Endpoint = "endpoint"
+	// UseFIPSEndpoints is a constant for the key in a cloud provider secret that selects the FIPS endpoints of the AWS
+	// services.
+	UseFIPSEndpoints = "useFIPSEndpoints"
 
 	// CSIEfsNodeName is the constant for the name of the efs csi node deployment.
 	CSIEfsNodeName = "csi-driver-efs-node"
@@ -146,6 +174,10 @@ const (
 	CSIEfsControllerName = "csi-driver-efs-controller"
 	// CSIDriverEfsImageName is the constant for the name of the efs csi driver image.
 	CSIDriverEfsImageName = "csi-driver-efs"
+	// CSIFSxNodeName is the constant for the name of the fsx csi node deployment.
+	CSIFSxNodeName = "csi-driver-fsx-node"
+	// CSIDriverFSxImageName is the constant for the name of the fsx csi driver image.
+	CSIDriverFSxImageName = "csi-driver-fsx"
 )
 
 var (
@@ -9,9 +9,13 @@ import (
 
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config/loader"
+	configvalidation "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config/validation"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
@@ -32,7 +36,14 @@ func (c *ConfigOptions) buildConfig() (*config.ControllerConfiguration, error) {
 	if len(c.ConfigFilePath) == 0 {
 		return nil, fmt.Errorf("config file path not set")
 	}
-	return configloader.LoadFromFile(c.ConfigFilePath)
+	config, err := configloader.LoadFromFile(c.ConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if errs := configvalidation.ValidateControllerConfiguration(config); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid controller configuration: %w", errs.ToAggregate())
+	}
+	return config, nil
 }
 
 // Complete implements RESTCompleter.Complete.
@@ -84,3 +95,23 @@ func (c *Config) ApplyHealthCheckConfig(config *apisconfigv1alpha1.HealthCheckCo
 		*config = *c.Config.HealthCheckConfig
 	}
 }
+
+// ApplyAccessKeyRotation sets the given access key rotation configuration to that of this Config.
+func (c *Config) ApplyAccessKeyRotation(accessKeyRotation **config.AccessKeyRotation) {
+	*accessKeyRotation = c.Config.AccessKeyRotation
+}
+
+// ApplyAPIRateLimiting sets the given AWS API rate limiter options to the API rate limiting configuration of this Config.
+// The options are left unchanged if no API rate limiting is configured.
+func (c *Config) ApplyAPIRateLimiting(opts *awsclient.APIRateLimiterOptions) {
+	apiRateLimiting := c.Config.APIRateLimiting
+	if apiRateLimiting == nil {
+		return
+	}
+
+	opts.Limit = rate.Limit(apiRateLimiting.QPS)
+	opts.Burst = int(ptr.Deref(apiRateLimiting.Burst, apiRateLimiting.QPS))
+	if apiRateLimiting.WaitTimeout != nil {
+		opts.WaitTimeout = apiRateLimiting.WaitTimeout.Duration
+	}
+}
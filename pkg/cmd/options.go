@@ -31,6 +31,7 @@ import (
 	dnsrecordcontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/dnsrecord"
 	healthcheckcontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/healthcheck"
 	infrastructurecontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure"
+	loadbalancercleanupcontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/loadbalancercleanup"
 	workercontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/worker"
 	cloudproviderwebhook "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/cloudprovider"
 	controlplanewebhook "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/controlplane"
@@ -47,6 +48,9 @@ const (
 	ProviderClientBurstFlag = "provider-client-burst"
 	// ProviderClientWaitTimeoutFlag is the name of the command line flag to specify the client wait timeout for provider operations.
 	ProviderClientWaitTimeoutFlag = "provider-client-wait-timeout"
+	// DrainPeriodFlag is the name of the command line flag to specify the period a superseded load balancer must be
+	// unreferenced before it is deleted.
+	DrainPeriodFlag = "drain-period"
 )
 
 // ControllerSwitchOptions are the controllercmd.SwitchOptions for the provider controllers.
@@ -61,6 +65,7 @@ func ControllerSwitchOptions() *controllercmd.SwitchOptions {
 		controllercmd.Switch(extensionsworkercontroller.ControllerName, workercontroller.AddToManager),
 		controllercmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheckcontroller.AddToManager),
 		controllercmd.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
+		controllercmd.Switch(loadbalancercleanupcontroller.ControllerName, loadbalancercleanupcontroller.AddToManager),
 	)
 }
 
@@ -147,3 +152,46 @@ func (c *DNSRecordControllerConfig) RateLimiterOptions() dnsrecordcontroller.Rat
 	c.ApplyRateLimiter(&opts)
 	return opts
 }
+
+// LoadBalancerCleanupControllerOptions are command line options that can be set for loadbalancercleanupcontroller.AddOptions.
+type LoadBalancerCleanupControllerOptions struct {
+	controllercmd.ControllerOptions
+	DrainPeriod time.Duration
+
+	config *LoadBalancerCleanupControllerConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *LoadBalancerCleanupControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	c.ControllerOptions.AddFlags(fs)
+	fs.DurationVar(&c.DrainPeriod, DrainPeriodFlag, c.DrainPeriod, "The period a superseded load balancer must be unreferenced before it is deleted.")
+}
+
+// Complete implements Completer.Complete.
+func (c *LoadBalancerCleanupControllerOptions) Complete() error {
+	if err := c.ControllerOptions.Complete(); err != nil {
+		return err
+	}
+	c.config = &LoadBalancerCleanupControllerConfig{
+		ControllerConfig: *c.ControllerOptions.Completed(),
+		DrainPeriod:      c.DrainPeriod,
+	}
+	return nil
+}
+
+// Completed returns the completed LoadBalancerCleanupControllerConfig. Only call this if `Complete` was successful.
+func (c *LoadBalancerCleanupControllerOptions) Completed() *LoadBalancerCleanupControllerConfig {
+	return c.config
+}
+
+// LoadBalancerCleanupControllerConfig is a completed load balancer cleanup controller configuration.
+type LoadBalancerCleanupControllerConfig struct {
+	controllercmd.ControllerConfig
+	DrainPeriod time.Duration
+}
+
+// Apply sets the values of this LoadBalancerCleanupControllerConfig in the given loadbalancercleanupcontroller.AddOptions.
+func (c *LoadBalancerCleanupControllerConfig) Apply(opts *loadbalancercleanupcontroller.AddOptions) {
+	c.ControllerConfig.Apply(&opts.Controller)
+	opts.DrainPeriod = c.DrainPeriod
+}
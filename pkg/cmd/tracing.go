@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+// tracingShutdownTimeout is the time given to the exporter to flush the remaining spans on shutdown.
+const tracingShutdownTimeout = 10 * time.Second
+
+// SetupTracing exports the spans of AWS API calls to the OpenTelemetry collector of the tracing configuration of this
+// Config. It does nothing if tracing is not configured. The tracer provider is shut down when the manager stops.
+func (c *Config) SetupTracing(ctx context.Context, mgr manager.Manager) error {
+	tracing := c.Config.Tracing
+	if tracing == nil {
+		return nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(tracing.Endpoint)}
+	if tracing.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("could not create trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(float64(ptr.Deref(tracing.SamplingPercentage, 100))/100))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "gardener-extension-"+aws.Name))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		return provider.Shutdown(shutdownCtx)
+	}))
+}
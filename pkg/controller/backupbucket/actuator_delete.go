@@ -14,9 +14,12 @@ import (
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
+	ctx = awsclient.WithCaller(ctx, "backupbucket", "")
+
 	authConfig, err := aws.GetCredentialsFromSecretRef(ctx, a.client, bb.Spec.SecretRef, false, bb.Spec.Region)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("could not get AWS credentials: %w", err), helper.KnownCodes)
@@ -34,6 +34,7 @@ import (
 //     otherwise do nothing.
 func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
 	logger.Info("Starting reconciliation of BackupBucket...")
+	ctx = awsclient.WithCaller(ctx, "backupbucket", "")
 
 	authConfig, err := aws.GetCredentialsFromSecretRef(ctx, a.client, bb.Spec.SecretRef, false, bb.Spec.Region)
 	if err != nil {
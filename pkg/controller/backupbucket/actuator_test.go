@@ -78,7 +78,7 @@ var _ = Describe("Actuator", func() {
 		c.EXPECT().Status().Return(sw).AnyTimes()
 		sw.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
-		ctx = context.Background()
+		ctx = awsclient.WithCaller(context.Background(), "backupbucket", "")
 		logger = log.Log.WithName("test")
 
 		secret = &corev1.Secret{
@@ -9,8 +9,8 @@ import (
 	"fmt"
 
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
-	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
@@ -45,7 +46,7 @@ func newActuator(mgr manager.Manager) bastion.Actuator {
 	}
 }
 
-func (a *actuator) getAWSClient(ctx context.Context, bastion *extensionsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*awsclient.Client, error) {
+func (a *actuator) getAWSClient(ctx context.Context, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) (*awsclient.Client, error) {
 	secret := &corev1.Secret{}
 	key := client.ObjectKey{Namespace: bastion.Namespace, Name: v1beta1constants.SecretNameCloudProvider}
 
@@ -53,11 +54,19 @@ func (a *actuator) getAWSClient(ctx context.Context, bastion *extensionsv1alpha1
 		return nil, fmt.Errorf("failed to find %q Secret: %w", v1beta1constants.SecretNameCloudProvider, err)
 	}
 
-	authConfig, err := aws.ReadCredentialsSecret(secret, false, shoot.Spec.Region)
+	authConfig, err := aws.ReadCredentialsSecret(secret, false, cluster.Shoot.Spec.Region)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read credentials Secret: %w", err)
 	}
 
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if cloudProfileConfig != nil {
+		aws.ApplyAPIConfig(authConfig, cloudProfileConfig.API)
+	}
+
 	return awsclient.NewClient(*authConfig)
 }
 
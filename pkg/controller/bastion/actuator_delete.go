@@ -26,8 +26,9 @@ import (
 
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster) error {
 	ctx = logf.IntoContext(ctx, log)
+	ctx = awsclient.WithCaller(ctx, "bastion", bastion.Namespace)
 
-	awsClient, err := a.getAWSClient(ctx, bastion, cluster.Shoot)
+	awsClient, err := a.getAWSClient(ctx, bastion, cluster)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("failed to create AWS client: %w", err), helper.KnownCodes)
 	}
@@ -39,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -302,6 +303,22 @@ var (
 					{Type: &rbacv1.RoleBinding{}, Name: "efs-csi-provisioner-binding"},
 				},
 			},
+			{
+				Name: aws.CSIFSxNodeName,
+				Images: []string{
+					aws.CSIDriverFSxImageName,
+					aws.CSINodeDriverRegistrarImageName,
+					aws.CSILivenessProbeImageName,
+				},
+				Objects: []*chart.Object{
+					// csi-driver-fsx-node
+					{Type: &appsv1.DaemonSet{}, Name: aws.CSIFSxNodeName},
+					{Type: &storagev1.CSIDriver{}, Name: "fsx.csi.aws.com"},
+					{Type: &corev1.ServiceAccount{}, Name: "fsx-csi-node-sa"},
+					{Type: &rbacv1.ClusterRole{}, Name: "fsx-csi-node-role"},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: "fsx-csi-node-binding"},
+				},
+			},
 			{
 				Name: "calico-mutating-admission-policy",
 				Objects: []*chart.Object{
@@ -758,6 +775,16 @@ func getALBChartValues(
 		return nil, fmt.Errorf("secret %q not found", caNameControlPlane)
 	}
 
+	defaultTags := map[string]interface{}{}
+	if cpConfig.LoadBalancerController != nil {
+		for key, value := range cpConfig.LoadBalancerController.DefaultTags {
+			defaultTags[key] = value
+		}
+	}
+	// the cluster tags are set last so that they cannot be overwritten by the user
+	defaultTags["KubernetesCluster"] = cp.Namespace
+	defaultTags["kubernetes.io/cluster/"+cp.Namespace] = "owned"
+
 	// ALB chart is always enabled and deployment is controlled by the replicaCount
 	// to avoid similar issue like https://github.com/gardener/gardener-extension-provider-aws/issues/628
 	values := map[string]interface{}{
@@ -770,14 +797,30 @@ func getALBChartValues(
 		"webhookTLS": map[string]interface{}{
 			"caCert": string(caSecret.Data[secretutils.DataKeyCertificateBundle]),
 		},
-		"defaultTags": map[string]interface{}{
-			"KubernetesCluster":                     cp.Namespace,
-			"kubernetes.io/cluster/" + cp.Namespace: "owned",
-		},
+		"defaultTags":         defaultTags,
 		"useWorkloadIdentity": useWorkloadIdentity,
 	}
-	if cpConfig.LoadBalancerController != nil && cpConfig.LoadBalancerController.IngressClassName != nil {
-		values["ingressClass"] = *cpConfig.LoadBalancerController.IngressClassName
+	if lbcConfig := cpConfig.LoadBalancerController; lbcConfig != nil {
+		if lbcConfig.IngressClassName != nil {
+			values["ingressClass"] = *lbcConfig.IngressClassName
+		}
+		if lbcConfig.DefaultTargetType != nil {
+			values["defaultTargetType"] = *lbcConfig.DefaultTargetType
+		}
+		if lbcConfig.EnableWAFv2 != nil {
+			values["enableWafv2"] = *lbcConfig.EnableWAFv2
+		}
+		if lbcConfig.EnableShield != nil {
+			values["enableShield"] = *lbcConfig.EnableShield
+		}
+		if lbcConfig.DefaultSSLPolicy != nil {
+			values["defaultSSLPolicy"] = *lbcConfig.DefaultSSLPolicy
+		}
+		// Only new Services are assigned to the controller by its service mutating webhook. Existing Services keep
+		// their (immutable) load balancer class, so their load balancers stay with the component that created them.
+		if lbcConfig.ServiceLoadBalancerOwner != nil && *lbcConfig.ServiceLoadBalancerOwner == apisaws.ServiceLoadBalancerOwnerLoadBalancerController {
+			values["enableServiceMutatorWebhook"] = true
+		}
 	}
 
 	if len(checksums) > 0 {
@@ -905,6 +948,7 @@ func getControlPlaneShootChartValues(
 		aws.AWSLoadBalancerControllerName:  albValues,
 		aws.CSINodeName:                    csiDriverNodeValues,
 		aws.CSIEfsNodeName:                 getControlPlaneShootChartCSIEfsValues(infraConfig, infraStatus),
+		aws.CSIFSxNodeName:                 getControlPlaneShootChartCSIFSxValues(infraConfig, infraStatus),
 		"calico-mutating-admission-policy": map[string]interface{}{"enabled": mutatingAdmissionPolicyEnabled},
 	}, nil
 }
@@ -933,6 +977,48 @@ func getControlPlaneShootChartCSIEfsValues(
 
 	if csiEfsEnabled {
 		values["fileSystemID"] = infraStatus.ElasticFileSystem.ID
+
+		// the access points are provisioned dynamically for every claim below the path of the configured access point
+		accessPoints := make([]map[string]interface{}, 0, len(infraConfig.ElasticFileSystem.AccessPoints))
+		for _, accessPoint := range infraConfig.ElasticFileSystem.AccessPoints {
+			accessPointValues := map[string]interface{}{
+				"name":        accessPoint.Name,
+				"path":        accessPoint.Path,
+				"permissions": ptr.Deref(accessPoint.Permissions, "0755"),
+			}
+			if posixUser := accessPoint.PosixUser; posixUser != nil {
+				accessPointValues["posixUser"] = map[string]interface{}{
+					"uid": posixUser.UID,
+					"gid": posixUser.GID,
+				}
+			}
+			accessPoints = append(accessPoints, accessPointValues)
+		}
+		values["accessPoints"] = accessPoints
+	}
+
+	return values
+}
+
+func isCSIFSxEnabled(infraConfig *apisaws.InfrastructureConfig) bool {
+	return infraConfig != nil && infraConfig.FSx != nil
+}
+
+func getControlPlaneShootChartCSIFSxValues(
+	infraConfig *apisaws.InfrastructureConfig,
+	infraStatus *apisaws.InfrastructureStatus,
+) map[string]interface{} {
+	csiFSxEnabled := isCSIFSxEnabled(infraConfig)
+	values := map[string]interface{}{
+		"enabled": csiFSxEnabled,
+	}
+
+	if csiFSxEnabled && infraStatus.FSx != nil {
+		values["type"] = string(infraStatus.FSx.Type)
+		values["fileSystemID"] = infraStatus.FSx.ID
+		values["dnsName"] = infraStatus.FSx.DNSName
+		values["mountName"] = infraStatus.FSx.MountName
+		values["storageCapacityGiB"] = infraConfig.FSx.StorageCapacityGiB
 	}
 
 	return values
@@ -531,6 +531,7 @@ var _ = Describe("ValuesProvider", func() {
 						},
 					}),
 					aws.CSIEfsNodeName:                 enabledFalse,
+					aws.CSIFSxNodeName:                 enabledFalse,
 					"calico-mutating-admission-policy": enabledFalse,
 				}))
 			})
@@ -553,6 +554,7 @@ var _ = Describe("ValuesProvider", func() {
 						},
 					}),
 					aws.CSIEfsNodeName:                 enabledFalse,
+					aws.CSIFSxNodeName:                 enabledFalse,
 					"calico-mutating-admission-policy": enabledFalse,
 				}))
 			})
@@ -575,11 +577,81 @@ var _ = Describe("ValuesProvider", func() {
 						},
 					}),
 					aws.CSIEfsNodeName:                 enabledFalse,
+					aws.CSIFSxNodeName:                 enabledFalse,
 					"calico-mutating-admission-policy": enabledFalse,
 				}))
 			})
 		})
 
+		Context("shoot control plane chart values and EFS enabled", func() {
+			It("should pass the file system and access points to the shoot chart", func() {
+				cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: encode(&apisawsv1alpha1.InfrastructureConfig{
+						ElasticFileSystem: &apisawsv1alpha1.ElasticFileSystemConfig{
+							Enabled: true,
+							AccessPoints: []apisawsv1alpha1.EFSAccessPoint{
+								{Name: "data", Path: "/data"},
+								{Name: "shared", Path: "/shared", PosixUser: &apisawsv1alpha1.EFSPosixUser{UID: 1000, GID: 2000}, Permissions: ptr.To("0750")},
+							},
+						},
+					}),
+				}
+				cp.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisawsv1alpha1.InfrastructureStatus{
+						ElasticFileSystem: apisawsv1alpha1.ElasticFileSystemStatus{
+							ID:           "fs-1234",
+							AccessPoints: []apisawsv1alpha1.EFSAccessPointStatus{{Name: "data", ID: "fsap-1234"}, {Name: "shared", ID: "fsap-5678"}},
+						},
+					}),
+				}
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CSIEfsNodeName, map[string]interface{}{
+					"enabled":      true,
+					"fileSystemID": "fs-1234",
+					"accessPoints": []map[string]interface{}{
+						{"name": "data", "path": "/data", "permissions": "0755"},
+						{"name": "shared", "path": "/shared", "permissions": "0750", "posixUser": map[string]interface{}{"uid": int64(1000), "gid": int64(2000)}},
+					},
+				}))
+			})
+		})
+
+		Context("shoot control plane chart values and FSx enabled", func() {
+			It("should pass the file system to the shoot chart", func() {
+				cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: encode(&apisawsv1alpha1.InfrastructureConfig{
+						FSx: &apisawsv1alpha1.FSxConfig{
+							Type:               apisawsv1alpha1.FSxFileSystemTypeLustre,
+							StorageCapacityGiB: 1200,
+						},
+					}),
+				}
+				cp.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisawsv1alpha1.InfrastructureStatus{
+						FSx: &apisawsv1alpha1.FSxStatus{
+							ID:        "fs-1234",
+							Type:      apisawsv1alpha1.FSxFileSystemTypeLustre,
+							DNSName:   "fs-1234.fsx.eu-west-1.amazonaws.com",
+							MountName: "abcdefgh",
+						},
+					}),
+				}
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CSIFSxNodeName, map[string]interface{}{
+					"enabled":            true,
+					"type":               "Lustre",
+					"fileSystemID":       "fs-1234",
+					"dnsName":            "fs-1234.fsx.eu-west-1.amazonaws.com",
+					"mountName":          "abcdefgh",
+					"storageCapacityGiB": int32(1200),
+				}))
+			})
+		})
+
 		Context("shoot control plane chart values and ALB enabled", func() {
 			It("should return correct shoot control plane chart when ca is secret found", func() {
 				setLoadBalancerControllerEnabled(cp, nil)
@@ -613,9 +685,53 @@ var _ = Describe("ValuesProvider", func() {
 						},
 					}),
 					aws.CSIEfsNodeName:                 enabledFalse,
+					aws.CSIFSxNodeName:                 enabledFalse,
 					"calico-mutating-admission-policy": enabledFalse,
 				}))
 			})
+
+			It("should pass the controller settings to the shoot chart", func() {
+				cp.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+						LoadBalancerController: &apisawsv1alpha1.LoadBalancerControllerConfig{
+							Enabled:           true,
+							DefaultTargetType: ptr.To("ip"),
+							EnableWAFv2:       ptr.To(false),
+							EnableShield:      ptr.To(false),
+							DefaultSSLPolicy:  ptr.To("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+							DefaultTags: map[string]string{
+								"team": "platform",
+							},
+							ServiceLoadBalancerOwner: ptr.To(apisawsv1alpha1.ServiceLoadBalancerOwnerLoadBalancerController),
+						},
+					}),
+				}
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.AWSLoadBalancerControllerName, map[string]interface{}{
+					"region":                "eu-west-1",
+					"enabled":               true,
+					"clusterName":           "test",
+					"webhookCertSecretName": awsLoadBalancerControllerWebhook,
+					"webhookTLS": map[string]interface{}{
+						"caCert": "",
+					},
+					"webhookURL":   fmt.Sprintf("https://%s.%s:443", awsLoadBalancerControllerWebhook, namespace),
+					"replicaCount": 1,
+					"defaultTags": map[string]interface{}{
+						"team":                       "platform",
+						"KubernetesCluster":          "test",
+						"kubernetes.io/cluster/test": "owned",
+					},
+					"useWorkloadIdentity":         false,
+					"defaultTargetType":           "ip",
+					"enableWafv2":                 false,
+					"enableShield":                false,
+					"defaultSSLPolicy":            "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					"enableServiceMutatorWebhook": true,
+				}))
+			})
 		})
 	})
 
@@ -50,6 +50,8 @@ func NewActuator(mgr manager.Manager, awsClientFactory awsclient.Factory) dnsrec
 
 // Reconcile reconciles the DNSRecord.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+	ctx = awsclient.WithCaller(ctx, "dnsrecord", dns.Namespace)
+
 	// Create AWS client
 	authConfig, err := aws.GetCredentialsFromSecretRef(ctx, a.client, dns.Spec.SecretRef, true, "")
 	if err != nil {
@@ -84,6 +86,8 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 
 // Delete deletes the DNSRecord.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+	ctx = awsclient.WithCaller(ctx, "dnsrecord", dns.Namespace)
+
 	// Create AWS client
 	authConfig, err := aws.GetCredentialsFromSecretRef(ctx, a.client, dns.Spec.SecretRef, true, "")
 	if err != nil {
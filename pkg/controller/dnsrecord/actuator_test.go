@@ -74,7 +74,7 @@ var _ = Describe("Actuator", func() {
 
 		c.EXPECT().Status().Return(sw).AnyTimes()
 
-		ctx = context.TODO()
+		ctx = awsclient.WithCaller(context.TODO(), "dnsrecord", namespace)
 		logger = log.Log.WithName("test")
 
 		a = NewActuator(mgr, awsClientFactory)
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// AccessKeyAgeHealthCheck is a health check that reports static access keys of shoots which are close to or older than
+// the configured maximum age.
+type AccessKeyAgeHealthCheck struct {
+	SeedClient       client.Client
+	Logger           logr.Logger
+	awsClientFactory awsclient.Factory
+	rotation         config.AccessKeyRotation
+	clock            clock.PassiveClock
+}
+
+var _ healthcheck.HealthCheck = &AccessKeyAgeHealthCheck{}
+
+// NewAccessKeyAgeHealthCheck creates a new instance of AccessKeyAgeHealthCheck for the given rotation configuration.
+func NewAccessKeyAgeHealthCheck(awsClientFactory awsclient.Factory, rotation config.AccessKeyRotation, clock clock.PassiveClock) *AccessKeyAgeHealthCheck {
+	return &AccessKeyAgeHealthCheck{
+		awsClientFactory: awsClientFactory,
+		rotation:         rotation,
+		clock:            clock,
+	}
+}
+
+// Check performs the health check. Access keys within the warning period are reported as progressing until they
+// expire, expired access keys as unhealthy.
+func (hc *AccessKeyAgeHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	ctx = awsclient.WithCaller(ctx, "healthcheck", request.Namespace)
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := hc.SeedClient.Get(ctx, request, infra); err != nil {
+		return nil, fmt.Errorf("failed to get infrastructure %s: %w", request, err)
+	}
+	if infra.DeletionTimestamp != nil {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	authConfig, err := aws.GetCredentialsForNamespace(ctx, hc.SeedClient, infra.Spec.SecretRef, infra.Spec.Region, infra.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credentials: %w", err)
+	}
+
+	age, err := aws.GetAccessKeyAge(ctx, hc.awsClientFactory, *authConfig, hc.rotation.MaxAge.Duration, hc.clock.Now())
+	if err != nil {
+		hc.Logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	if age == nil || !age.ExpiresWithin(ptr.Deref(hc.rotation.WarningPeriod, metav1.Duration{}).Duration) {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	if age.Expired() {
+		detail := fmt.Sprintf("%s and is older than the maximum age of %s, it must be rotated", age, hc.rotation.MaxAge.Duration)
+		hc.Logger.Error(errors.New(detail), "Health check failed")
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: detail,
+			Codes:  []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem},
+		}, nil
+	}
+
+	detail := fmt.Sprintf("%s and reaches the maximum age of %s in %s, it should be rotated", age, hc.rotation.MaxAge.Duration, age.Remaining.Round(time.Hour))
+	hc.Logger.Info("Access key expires soon", "detail", detail)
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionProgressing,
+		Detail: detail,
+		// the condition turns false when the access key expires
+		ProgressingThreshold: &age.Remaining,
+	}, nil
+}
+
+// SetLoggerSuffix sets the logger suffix for the health check.
+func (hc *AccessKeyAgeHealthCheck) SetLoggerSuffix(provider, _ string) {
+	hc.Logger = log.Log.WithName(fmt.Sprintf("%s-healthcheck-access-key-age", provider))
+}
+
+// InjectSourceClient injects the seed client into the health check.
+func (hc *AccessKeyAgeHealthCheck) InjectSourceClient(seedClient client.Client) {
+	hc.SeedClient = seedClient
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/fake"
+	chealthcheck "github.com/gardener/gardener-extension-provider-aws/pkg/controller/healthcheck"
+)
+
+var _ = Describe("AccessKeyAgeHealthCheck", func() {
+	var (
+		ctx       = context.Background()
+		now       = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+		awsClient *fake.Client
+
+		seedClient  client.Client
+		secret      *corev1.Secret
+		healthCheck *chealthcheck.AccessKeyAgeHealthCheck
+		request     = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "infra"}
+	)
+
+	BeforeEach(func() {
+		awsClient = fake.New()
+
+		infra := &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: request.Namespace, Name: request.Name},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+				SecretRef:   corev1.SecretReference{Namespace: request.Namespace, Name: "cloudprovider"},
+				Region:      "eu-west-1",
+			},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: request.Namespace, Name: "cloudprovider"},
+			Data: map[string][]byte{
+				"accessKeyID":     []byte("access-key-id"),
+				"secretAccessKey": []byte("secret-access-key"),
+			},
+		}
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra, secret).Build()
+
+		healthCheck = chealthcheck.NewAccessKeyAgeHealthCheck(
+			awsclient.FactoryFunc(func(awsclient.AuthConfig) (awsclient.Interface, error) {
+				return awsClient, nil
+			}),
+			config.AccessKeyRotation{
+				MaxAge:        metav1.Duration{Duration: 90 * 24 * time.Hour},
+				WarningPeriod: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+			},
+			testclock.NewFakePassiveClock(now),
+		)
+		healthCheck.SetLoggerSuffix("aws", "infrastructure")
+		healthCheck.InjectSourceClient(seedClient)
+	})
+
+	addAccessKey := func(age time.Duration) {
+		awsClient.AddAccessKey(awsclient.AccessKeyMetadata{
+			AccessKeyID:  "access-key-id",
+			UserName:     "gardener",
+			Status:       "Active",
+			CreateDate:   now.Add(-age),
+			LastUsedDate: &now,
+		})
+	}
+
+	It("should be healthy if the access key is not close to the maximum age", func() {
+		addAccessKey(30 * 24 * time.Hour)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should be progressing until the access key expires if it is within the warning period", func() {
+		addAccessKey(80 * 24 * time.Hour)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+		Expect(result.Detail).To(Equal(`access key access-key-id of user "gardener" was created 80 days ago (last used 2025-06-01T00:00:00Z) and reaches the maximum age of 2160h0m0s in 240h0m0s, it should be rotated`))
+		Expect(*result.ProgressingThreshold).To(Equal(10 * 24 * time.Hour))
+	})
+
+	It("should be unhealthy if the access key is older than the maximum age", func() {
+		addAccessKey(100 * 24 * time.Hour)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(ContainSubstring("was created 100 days ago"))
+		Expect(result.Codes).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+	})
+
+	It("should be healthy if the shoot uses workload identity", func() {
+		secret.Data = map[string][]byte{
+			"token":   []byte("token"),
+			"roleARN": []byte("arn:aws:iam::123456789012:role/gardener"),
+		}
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+})
@@ -17,39 +17,54 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 var (
 	defaultSyncPeriod = time.Second * 30
-	// DefaultAddOptions are the default DefaultAddArgs for AddToManager.
-	DefaultAddOptions = healthcheck.DefaultAddArgs{
-		HealthCheckConfig: healthcheckconfig.HealthCheckConfig{
-			SyncPeriod: metav1.Duration{Duration: defaultSyncPeriod},
-			ShootRESTOptions: &healthcheckconfig.RESTOptions{
-				QPS:   ptr.To[float32](100),
-				Burst: ptr.To(130),
+	// infrastructureDriftSyncPeriod is the minimum period of the infrastructure drift health check. It is longer than the
+	// default sync period as each check issues several calls against the AWS API.
+	infrastructureDriftSyncPeriod = 5 * time.Minute
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		DefaultAddArgs: healthcheck.DefaultAddArgs{
+			HealthCheckConfig: healthcheckconfig.HealthCheckConfig{
+				SyncPeriod: metav1.Duration{Duration: defaultSyncPeriod},
+				ShootRESTOptions: &healthcheckconfig.RESTOptions{
+					QPS:   ptr.To[float32](100),
+					Burst: ptr.To(130),
+				},
 			},
 		},
 	}
 )
 
+// AddOptions are the options to apply when registering the health checks.
+type AddOptions struct {
+	healthcheck.DefaultAddArgs
+	// AccessKeyRotation is the configuration of the access key age health check. The health check is disabled if nil.
+	AccessKeyRotation *config.AccessKeyRotation
+}
+
 // RegisterHealthChecks registers health checks for each extension resource
 // HealthChecks are grouped by extension (e.g worker), extension.type (e.g aws) and  Health Check Type (e.g SystemComponentsHealthy)
-func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthcheck.DefaultAddArgs) error {
+func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts AddOptions) error {
 	if err := healthcheck.DefaultRegistration(
 		aws.Type,
 		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.ControlPlaneResource),
 		func() client.ObjectList { return &extensionsv1alpha1.ControlPlaneList{} },
 		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.ControlPlane{} },
 		mgr,
-		opts,
+		opts.DefaultAddArgs,
 		[]predicate.Predicate{},
 		[]healthcheck.ConditionTypeToHealthCheck{
 			{
@@ -75,13 +90,50 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 		return err
 	}
 
+	infrastructureOpts := opts.DefaultAddArgs
+	if infrastructureOpts.HealthCheckConfig.SyncPeriod.Duration < infrastructureDriftSyncPeriod {
+		infrastructureOpts.HealthCheckConfig.SyncPeriod = metav1.Duration{Duration: infrastructureDriftSyncPeriod}
+	}
+	infrastructureHealthChecks := []healthcheck.ConditionTypeToHealthCheck{{
+		ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+		HealthCheck:   NewInfrastructureDriftHealthCheck(awsclient.FactoryFunc(awsclient.NewInterface)),
+		ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
+			return util.DetermineErrorCodes(err, helper.KnownCodes)
+		},
+	}, {
+		ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+		HealthCheck:   NewExistingVPCFlowLogsHealthCheck(),
+	}}
+	if opts.AccessKeyRotation != nil {
+		infrastructureHealthChecks = append(infrastructureHealthChecks, healthcheck.ConditionTypeToHealthCheck{
+			ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+			HealthCheck:   NewAccessKeyAgeHealthCheck(awsclient.FactoryFunc(awsclient.NewInterface), *opts.AccessKeyRotation, clock.RealClock{}),
+			ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
+				return util.DetermineErrorCodes(err, helper.KnownCodes)
+			},
+		})
+	}
+	if err := healthcheck.DefaultRegistration(
+		aws.Type,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.InfrastructureResource),
+		func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} },
+		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Infrastructure{} },
+		mgr,
+		infrastructureOpts,
+		nil,
+		infrastructureHealthChecks,
+		sets.New[gardencorev1beta1.ConditionType](),
+	); err != nil {
+		return err
+	}
+
 	return healthcheck.DefaultRegistration(
 		aws.Type,
 		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.WorkerResource),
 		func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} },
 		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Worker{} },
 		mgr,
-		opts,
+		opts.DefaultAddArgs,
 		nil,
 		[]healthcheck.ConditionTypeToHealthCheck{{
 			ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
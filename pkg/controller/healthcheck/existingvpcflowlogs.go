@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+)
+
+// ExistingVPCFlowLogsHealthCheck is a health check that reports existing VPCs without flow logs. It reads the condition
+// ExistingVPCFlowLogs of the Infrastructure, which is not propagated to the shoot on its own.
+type ExistingVPCFlowLogsHealthCheck struct {
+	SeedClient client.Client
+	Logger     logr.Logger
+}
+
+var _ healthcheck.HealthCheck = &ExistingVPCFlowLogsHealthCheck{}
+
+// NewExistingVPCFlowLogsHealthCheck creates a new instance of ExistingVPCFlowLogsHealthCheck.
+func NewExistingVPCFlowLogsHealthCheck() *ExistingVPCFlowLogsHealthCheck {
+	return &ExistingVPCFlowLogsHealthCheck{}
+}
+
+// Check performs the health check. Existing VPCs without flow logs are reported as unhealthy, while a failed check of
+// the flow logs is only reported in the condition of the Infrastructure.
+func (hc *ExistingVPCFlowLogsHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := hc.SeedClient.Get(ctx, request, infra); err != nil {
+		return nil, fmt.Errorf("failed to get infrastructure %s: %w", request, err)
+	}
+
+	condition := v1beta1helper.GetCondition(infra.Status.Conditions, infraflow.ConditionTypeExistingVPCFlowLogs)
+	if infra.DeletionTimestamp != nil || condition == nil || condition.Status != gardencorev1beta1.ConditionFalse {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	detail := fmt.Sprintf("%s They are not managed for an existing VPC and have to be created by its owner.", condition.Message)
+	hc.Logger.Error(errors.New(detail), "Health check failed")
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionFalse,
+		Detail: detail,
+		Codes:  []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem},
+	}, nil
+}
+
+// SetLoggerSuffix sets the logger suffix for the health check.
+func (hc *ExistingVPCFlowLogsHealthCheck) SetLoggerSuffix(provider, _ string) {
+	hc.Logger = log.Log.WithName(fmt.Sprintf("%s-healthcheck-existing-vpc-flow-logs", provider))
+}
+
+// InjectSourceClient injects the seed client into the health check.
+func (hc *ExistingVPCFlowLogsHealthCheck) InjectSourceClient(seedClient client.Client) {
+	hc.SeedClient = seedClient
+}
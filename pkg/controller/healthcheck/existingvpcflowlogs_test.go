@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	chealthcheck "github.com/gardener/gardener-extension-provider-aws/pkg/controller/healthcheck"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("ExistingVPCFlowLogsHealthCheck", func() {
+	var (
+		ctx     = context.Background()
+		request = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "infra"}
+	)
+
+	check := func(conditions ...gardencorev1beta1.Condition) *chealthcheck.ExistingVPCFlowLogsHealthCheck {
+		infra := &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: request.Namespace, Name: request.Name},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"}},
+			Status:     extensionsv1alpha1.InfrastructureStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{Conditions: conditions}},
+		}
+		healthCheck := chealthcheck.NewExistingVPCFlowLogsHealthCheck()
+		healthCheck.SetLoggerSuffix("aws", "infrastructure")
+		healthCheck.InjectSourceClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).Build())
+		return healthCheck
+	}
+
+	It("should be healthy without the condition", func() {
+		result, err := check().Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should be healthy if the existing VPC has flow logs", func() {
+		result, err := check(gardencorev1beta1.Condition{
+			Type:    infraflow.ConditionTypeExistingVPCFlowLogs,
+			Status:  gardencorev1beta1.ConditionTrue,
+			Reason:  "FlowLogsFound",
+			Message: "VPC vpc-1 has 1 flow logs.",
+		}).Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should be healthy if the flow logs could not be read", func() {
+		result, err := check(gardencorev1beta1.Condition{
+			Type:    infraflow.ConditionTypeExistingVPCFlowLogs,
+			Status:  gardencorev1beta1.ConditionUnknown,
+			Reason:  "FlowLogsCheckFailed",
+			Message: "Flow logs of VPC vpc-1 could not be read: access denied",
+		}).Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should be unhealthy if the existing VPC has no flow logs", func() {
+		result, err := check(gardencorev1beta1.Condition{
+			Type:    infraflow.ConditionTypeExistingVPCFlowLogs,
+			Status:  gardencorev1beta1.ConditionFalse,
+			Reason:  "FlowLogsMissing",
+			Message: "VPC vpc-1 has no flow logs.",
+		}).Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(HavePrefix("VPC vpc-1 has no flow logs."))
+		Expect(result.Codes).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+	})
+})
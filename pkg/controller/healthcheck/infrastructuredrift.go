@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// InfrastructureDriftHealthCheck is a health check that reads the AWS resources recorded in the flow state of an
+// Infrastructure and reports those which were deleted or modified out-of-band.
+type InfrastructureDriftHealthCheck struct {
+	SeedClient       client.Client
+	Logger           logr.Logger
+	awsClientFactory awsclient.Factory
+}
+
+var _ healthcheck.HealthCheck = &InfrastructureDriftHealthCheck{}
+
+// NewInfrastructureDriftHealthCheck creates a new instance of InfrastructureDriftHealthCheck using the given factory to
+// create AWS clients.
+func NewInfrastructureDriftHealthCheck(awsClientFactory awsclient.Factory) *InfrastructureDriftHealthCheck {
+	return &InfrastructureDriftHealthCheck{awsClientFactory: awsClientFactory}
+}
+
+// Check performs the health check by comparing the resources recorded in the flow state with the actual resources.
+func (hc *InfrastructureDriftHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	ctx = awsclient.WithCaller(ctx, "healthcheck", request.Namespace)
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := hc.SeedClient.Get(ctx, request, infra); err != nil {
+		return nil, fmt.Errorf("failed to get infrastructure %s: %w", request, err)
+	}
+
+	// infrastructures which are reconciled by Terraform or were not reconciled yet have no flow state to compare with
+	infraState, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode infrastructure state: %w", err)
+	}
+	if infra.DeletionTimestamp != nil || infraState == nil || len(infraState.Data) == 0 {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode infrastructure config: %w", err)
+	}
+	whiteboard := shared.NewWhiteboard()
+	whiteboard.ImportFromFlatMap(infraState.Data)
+
+	authConfig, err := aws.GetCredentialsForNamespace(ctx, hc.SeedClient, infra.Spec.SecretRef, infra.Spec.Region, infra.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credentials: %w", err)
+	}
+	awsClient, err := hc.awsClientFactory.NewClient(*authConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS client: %w", err)
+	}
+
+	drifts, err := infraflow.DetectDrift(ctx, awsClient, config, whiteboard)
+	if err != nil {
+		err := fmt.Errorf("failed to detect infrastructure drift: %w", err)
+		hc.Logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	if len(drifts) == 0 {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	var (
+		details []string
+		codes   []gardencorev1beta1.ErrorCode
+	)
+	for _, drift := range drifts {
+		details = append(details, drift.String())
+		if code := errorCodeForDrift(drift); !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	detail := fmt.Sprintf("infrastructure has drifted, it will be repaired with the next reconciliation: %s", strings.Join(details, ", "))
+	hc.Logger.Error(errors.New(detail), "Health check failed")
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionFalse,
+		Detail: detail,
+		Codes:  codes,
+	}, nil
+}
+
+// errorCodeForDrift returns ERR_INFRA_DEPENDENCIES for deleted resources as the shoot cannot work without them, and
+// ERR_CONFIGURATION_PROBLEM for resources which were modified by the owner of the account.
+func errorCodeForDrift(drift infraflow.Drift) gardencorev1beta1.ErrorCode {
+	if drift.Type == infraflow.DriftTypeMissing {
+		return gardencorev1beta1.ErrorInfraDependencies
+	}
+	return gardencorev1beta1.ErrorConfigurationProblem
+}
+
+// SetLoggerSuffix sets the logger suffix for the health check.
+func (hc *InfrastructureDriftHealthCheck) SetLoggerSuffix(provider, _ string) {
+	hc.Logger = log.Log.WithName(fmt.Sprintf("%s-healthcheck-infrastructure-drift", provider))
+}
+
+// InjectSourceClient injects the seed client into the health check.
+func (hc *InfrastructureDriftHealthCheck) InjectSourceClient(seedClient client.Client) {
+	hc.SeedClient = seedClient
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	chealthcheck "github.com/gardener/gardener-extension-provider-aws/pkg/controller/healthcheck"
+)
+
+var _ = Describe("InfrastructureDriftHealthCheck", func() {
+	var (
+		ctx       = context.Background()
+		ctrl      *gomock.Controller
+		awsClient *mockawsclient.MockInterface
+
+		seedClient  client.Client
+		infra       *extensionsv1alpha1.Infrastructure
+		healthCheck *chealthcheck.InfrastructureDriftHealthCheck
+		request     = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "infra"}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: request.Namespace, Name: request.Name},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "aws",
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"zones":[{"name":"eu-west-1a"}]}}`)},
+				},
+				SecretRef: corev1.SecretReference{Namespace: request.Namespace, Name: "cloudprovider"},
+				Region:    "eu-west-1",
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					State: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureState","data":{"VPC":"vpc-1","Zones/eu-west-1a/NATGateway":"nat-1"}}`)},
+				},
+			},
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: request.Namespace, Name: "cloudprovider"},
+			Data: map[string][]byte{
+				"accessKeyID":     []byte("access-key-id"),
+				"secretAccessKey": []byte("secret-access-key"),
+			},
+		}
+		seedClient = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra, secret).Build()
+
+		healthCheck = chealthcheck.NewInfrastructureDriftHealthCheck(awsclient.FactoryFunc(func(awsclient.AuthConfig) (awsclient.Interface, error) {
+			return awsClient, nil
+		}))
+		healthCheck.SetLoggerSuffix("aws", "infrastructure")
+		healthCheck.InjectSourceClient(seedClient)
+	})
+
+	It("should be healthy if the resources match the flow state", func() {
+		awsClient.EXPECT().GetVpc(gomock.Any(), "vpc-1").Return(&awsclient.VPC{VpcId: "vpc-1"}, nil)
+		awsClient.EXPECT().GetNATGateway(gomock.Any(), "nat-1").Return(&awsclient.NATGateway{NATGatewayId: "nat-1", State: "available"}, nil)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should report missing and modified resources with error codes", func() {
+		awsClient.EXPECT().GetVpc(gomock.Any(), "vpc-1").Return(nil, nil)
+		awsClient.EXPECT().GetNATGateway(gomock.Any(), "nat-1").Return(&awsclient.NATGateway{NATGatewayId: "nat-1", State: "deleting"}, nil)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(ContainSubstring("VPC vpc-1 is missing, NAT gateway nat-1 in zone eu-west-1a was modified: state is deleting"))
+		Expect(result.Codes).To(ConsistOf(gardencorev1beta1.ErrorInfraDependencies, gardencorev1beta1.ErrorConfigurationProblem))
+	})
+
+	It("should skip infrastructures without flow state", func() {
+		infra.Status.State = nil
+		Expect(seedClient.Update(ctx, infra)).To(Succeed())
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+})
@@ -15,10 +15,12 @@ import (
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
 )
 
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	ctx = awsclient.WithCaller(ctx, "infrastructure", infra.Namespace)
 	return util.DetermineError(a.delete(ctx, log, infra, cluster), helper.KnownCodes)
 }
 
@@ -28,7 +30,7 @@ func (a *actuator) ForceDelete(_ context.Context, _ logr.Logger, _ *extensionsv1
 
 // Delete deletes the infrastructure resource using the flow reconciler.
 func (a *actuator) delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, c *extensionscontroller.Cluster) error {
-	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, infra.Spec.SecretRef, infra.Spec.Region)
+	awsClient, err := aws.NewClientForCluster(ctx, a.client, infra.Spec.SecretRef, infra.Spec.Region, c)
 	if err != nil {
 		return fmt.Errorf("failed to create new AWS client: %w", err)
 	}
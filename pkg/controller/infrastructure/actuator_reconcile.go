@@ -6,12 +6,14 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
@@ -22,12 +24,14 @@ import (
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
 
 // Reconcile the Infrastructure config.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
+	ctx = awsclient.WithCaller(ctx, "infrastructure", infra.Namespace)
 	return util.DetermineError(a.reconcile(ctx, log, infra, cluster), helper.KnownCodes)
 }
 
@@ -56,11 +60,30 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extens
 			return err
 		}
 	}
-	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, infra.Spec.SecretRef, infra.Spec.Region)
+	authConfig, err := aws.GetCredentialsForCluster(ctx, a.client, infra.Spec.SecretRef, infra.Spec.Region, cluster)
+	if err != nil {
+		return err
+	}
+	awsClient, err := awsclient.NewClient(*authConfig)
 	if err != nil {
 		return fmt.Errorf("failed to create new AWS client: %w", err)
 	}
 
+	if err := CheckPermissions(ctx, log, a.client, infra, cluster, authConfig, awsClient); err != nil {
+		return err
+	}
+
+	// a new infrastructure may adopt existing resources instead of creating them.
+	if !fsOk && len(infraState.Data) == 0 {
+		adoptedState, err := a.adoptInfrastructure(ctx, log, infra, cluster, awsClient)
+		if err != nil {
+			return err
+		}
+		if adoptedState != nil {
+			infraState = adoptedState
+		}
+	}
+
 	fctx, err := infraflow.NewFlowContext(infraflow.Opts{
 		Log:            log,
 		Infrastructure: infra,
@@ -73,6 +96,14 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extens
 		return fmt.Errorf("failed to create flow context: %w", err)
 	}
 
+	// fail early instead of leaving a partially created infrastructure behind
+	var exceeded *aws.QuotaExceededError
+	if err := aws.CheckQuotas(ctx, awsClient, fctx.QuotaRequests()); errors.As(err, &exceeded) {
+		return v1beta1helper.NewErrorWithCodes(err, v1beta1.ErrorInfraQuotaExceeded)
+	} else if err != nil {
+		return err
+	}
+
 	return fctx.Reconcile(ctx)
 }
 
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// adoptInfrastructure creates the state of an infrastructure which adopts the existing resources of the inventory
+// referenced by the AnnotationAdoptInfrastructure annotation of the shoot. It returns nil if the shoot is not annotated.
+func (a *actuator) adoptInfrastructure(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster, awsClient awsclient.Interface) (*awsapi.InfrastructureState, error) {
+	name, ok := cluster.Shoot.Annotations[aws.AnnotationAdoptInfrastructure]
+	if !ok {
+		return nil, nil
+	}
+	log.Info("starting adoption of existing infrastructure resources", "resource", name)
+
+	data, err := a.getInventoryData(ctx, infra.Namespace, cluster.Shoot.Spec.Resources, name)
+	if err != nil {
+		return nil, err
+	}
+	inventory, err := helper.InfrastructureInventoryFromRaw(data[aws.InventoryDataKey])
+	if err != nil {
+		return nil, v1beta1helper.NewErrorWithCodes(err, v1beta1.ErrorConfigurationProblem)
+	}
+	var tfState *shared.TerraformState
+	if raw, ok := data[aws.TerraformStateDataKey]; ok {
+		if tfState, err = shared.UnmarshalTerraformState(raw); err != nil {
+			return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("could not decode Terraform state: %w", err), v1beta1.ErrorConfigurationProblem)
+		}
+	}
+
+	infrastructureConfig, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+	state, err := infraflow.NewStateFromInventory(inventory, tfState, infrastructureConfig)
+	if err != nil {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("invalid infrastructure inventory: %w", err), v1beta1.ErrorConfigurationProblem)
+	}
+	if err := infraflow.ValidateAdoptedState(ctx, awsClient, state, infrastructureConfig); err != nil {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("infrastructure inventory does not match the AWS resources: %w", err), v1beta1.ErrorConfigurationProblem)
+	}
+
+	whiteboard := shared.NewWhiteboard()
+	whiteboard.ImportFromFlatMap(state.Data)
+	infrastructureStatus := infraflow.BuildInfrastructureStatus(whiteboard, infrastructureConfig)
+
+	if err := infraflow.PatchProviderStatusAndState(ctx, a.client, infra, cluster.Shoot.Spec.Networking, infrastructureStatus, &runtime.RawExtension{Object: state}, nil, nil, nil); err != nil {
+		return nil, fmt.Errorf("updating status state failed: %w", err)
+	}
+
+	return state, nil
+}
+
+// getInventoryData returns the data of the ConfigMap or Secret referenced by the resource with the given name.
+func (a *actuator) getInventoryData(ctx context.Context, namespace string, resources []v1beta1.NamedResourceReference, name string) (map[string][]byte, error) {
+	ref := v1beta1helper.GetResourceByName(resources, name)
+	if ref == nil {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("resource %q of annotation %s not found in shoot resources", name, aws.AnnotationAdoptInfrastructure), v1beta1.ErrorConfigurationProblem)
+	}
+
+	var data map[string][]byte
+	switch ref.ResourceRef.Kind {
+	case "Secret":
+		secret := &corev1.Secret{}
+		if err := controller.GetObjectByReference(ctx, a.client, &ref.ResourceRef, namespace, secret); err != nil {
+			return nil, fmt.Errorf("could not get referenced secret %s: %w", ref.ResourceRef.Name, err)
+		}
+		data = secret.Data
+	case "ConfigMap":
+		configMap := &corev1.ConfigMap{}
+		if err := controller.GetObjectByReference(ctx, a.client, &ref.ResourceRef, namespace, configMap); err != nil {
+			return nil, fmt.Errorf("could not get referenced config map %s: %w", ref.ResourceRef.Name, err)
+		}
+		data = make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+		for key, value := range configMap.BinaryData {
+			data[key] = value
+		}
+		for key, value := range configMap.Data {
+			data[key] = []byte(value)
+		}
+	default:
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("resource %q of kind %s is not supported, only ConfigMaps and Secrets can hold an inventory", name, ref.ResourceRef.Kind), v1beta1.ErrorConfigurationProblem)
+	}
+
+	if _, ok := data[aws.InventoryDataKey]; !ok {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("resource %q does not contain the key %s", name, aws.InventoryDataKey), v1beta1.ErrorConfigurationProblem)
+	}
+	return data, nil
+}
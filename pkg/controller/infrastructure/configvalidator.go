@@ -15,6 +15,7 @@ import (
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -22,6 +23,8 @@ import (
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
 
 // configValidator implements ConfigValidator for aws infrastructure resources.
@@ -43,6 +46,7 @@ func NewConfigValidator(mgr manager.Manager, awsClientFactory awsclient.Factory,
 // Validate validates the provider config of the given infrastructure resource with the cloud provider.
 func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) field.ErrorList {
 	allErrs := field.ErrorList{}
+	ctx = awsclient.WithCaller(ctx, "infrastructure", infra.Namespace)
 
 	logger := c.logger.WithValues("infrastructure", client.ObjectKeyFromObject(infra))
 
@@ -54,7 +58,7 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 	}
 
 	// Create AWS client
-	authConfig, err := aws.GetCredentialsFromSecretRef(ctx, c.client, infra.Spec.SecretRef, false, infra.Spec.Region)
+	authConfig, err := aws.GetCredentialsForNamespace(ctx, c.client, infra.Spec.SecretRef, infra.Spec.Region, infra.Namespace)
 	if err != nil {
 		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not get AWS credentials: %+v", err)))
 		return allErrs
@@ -89,6 +93,24 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 		allErrs = append(allErrs, c.validateEIPS(ctx, awsClient, infra.Namespace, eips, eipToZone, field.NewPath("networks", "zones[]", "elasticIPAllocationID"))...)
 	}
 
+	var secondaryEIPs []string
+	for _, zone := range config.Networks.Zones {
+		if zone.SecondaryElasticIPs != nil {
+			for _, id := range zone.SecondaryElasticIPs.AllocationIDs {
+				secondaryEIPs = append(secondaryEIPs, id)
+				eipToZone[id] = zone.Name
+			}
+		}
+	}
+
+	if len(secondaryEIPs) > 0 {
+		allErrs = append(allErrs, c.validateEIPS(ctx, awsClient, infra.Namespace, secondaryEIPs, eipToZone, field.NewPath("networks", "zones[]", "secondaryElasticIPs", "allocationIDs"))...)
+	}
+
+	if config.Networks.ElasticIPPool != nil {
+		allErrs = append(allErrs, c.validateElasticIPPool(ctx, awsClient, infra, config, field.NewPath("networks", "elasticIPPool"))...)
+	}
+
 	return allErrs
 }
 
@@ -216,3 +238,71 @@ func (c *configValidator) validateEIPS(ctx context.Context, awsClient awsclient.
 
 	return allErrs
 }
+
+// validateElasticIPPool validates that the configured public IPv4 pool or IPAM pool exists and has enough free addresses
+// for the elastic IPs which still need to be allocated for the NAT gateways of the Shoot.
+func (c *configValidator) validateElasticIPPool(ctx context.Context, awsClient awsclient.Interface, infra *extensionsv1alpha1.Infrastructure, config *apiaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		poolID   string
+		poolPath *field.Path
+		getFree  func(context.Context, string) (*int, error)
+	)
+	if id := config.Networks.ElasticIPPool.PublicIPv4PoolID; id != nil {
+		poolID, poolPath, getFree = *id, fldPath.Child("publicIPv4PoolID"), awsClient.GetPublicIPv4PoolFreeAddressCount
+	} else if id := config.Networks.ElasticIPPool.IPAMPoolID; id != nil {
+		poolID, poolPath, getFree = *id, fldPath.Child("ipamPoolID"), awsClient.GetIPAMPoolFreeAddressCount
+	} else {
+		return allErrs
+	}
+
+	free, err := getFree(ctx, poolID)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(poolPath, fmt.Errorf("could not get free addresses of pool %s: %w", poolID, err)))
+		return allErrs
+	}
+	if free == nil {
+		allErrs = append(allErrs, field.NotFound(poolPath, poolID))
+		return allErrs
+	}
+
+	zones, err := flowStateZones(infra)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(poolPath, fmt.Errorf("could not read infrastructure state: %w", err)))
+		return allErrs
+	}
+	required := 0
+	for _, zone := range config.Networks.Zones {
+		if !helper.HasNATGateway(config, zone.Name) {
+			continue
+		}
+		child := zones.GetChild(zone.Name)
+		if zone.ElasticIPAllocationID == nil && child.Get(infraflow.IdentifierManagedZoneNATGWElasticIP) == nil {
+			required++
+		}
+		if zone.SecondaryElasticIPs != nil {
+			required += max(int(ptr.Deref(zone.SecondaryElasticIPs.Count, 0))-len(infraflow.ManagedSecondaryElasticIPs(child)), 0)
+		}
+	}
+	if *free < required {
+		allErrs = append(allErrs, field.Invalid(poolPath, poolID, fmt.Sprintf("pool has %d free addresses, but %d elastic IPs need to be allocated for the NAT gateways", *free, required)))
+	}
+
+	return allErrs
+}
+
+// flowStateZones returns the zones of the flow state of the infrastructure. The elastic IPs created by the extension
+// which are found in it are kept and do not need an address of the pool.
+func flowStateZones(infra *extensionsv1alpha1.Infrastructure) (shared.Whiteboard, error) {
+	whiteboard := shared.NewWhiteboard()
+	if ok, err := helper.HasFlowState(infra.Status); err != nil || !ok {
+		return whiteboard.GetChild(infraflow.ChildIdZones), err
+	}
+	state, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+	if err != nil {
+		return nil, err
+	}
+	whiteboard.ImportFromFlatMap(state.Data)
+	return whiteboard.GetChild(infraflow.ChildIdZones), nil
+}
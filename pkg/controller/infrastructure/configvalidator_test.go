@@ -23,6 +23,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -32,10 +33,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
 
 const (
@@ -66,7 +70,6 @@ var _ = Describe("ConfigValidator", func() {
 		c = mockclient.NewMockClient(ctrl)
 		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
 		awsClient = mockawsclient.NewMockInterface(ctrl)
-		ctx = context.TODO()
 		logger = log.Log.WithName("test")
 
 		mgr := mockmanager.NewMockManager(ctrl)
@@ -76,12 +79,15 @@ var _ = Describe("ConfigValidator", func() {
 		// shared infra + secret setup
 		infra = baseInfra(region, ptr.To(vpcID))
 		secret, authConfig = baseSecretAuth(accessKeyID, secretAccessKey, region)
+		ctx = awsclient.WithCaller(context.TODO(), "infrastructure", infra.Namespace)
 
 		c.EXPECT().Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: infra.Name}, gomock.AssignableToTypeOf(&corev1.Secret{})).
 			DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
 				*obj = *secret
 				return nil
 			})
+		c.EXPECT().Get(ctx, client.ObjectKey{Name: infra.Namespace}, gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).
+			Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("clusters"), infra.Namespace))
 		awsClientFactory.EXPECT().NewClient(authConfig).Return(awsClient, nil)
 	})
 
@@ -417,6 +423,127 @@ var _ = Describe("ConfigValidator", func() {
 			}))
 		})
 	})
+
+	Context("Secondary elastic IPs", func() {
+		It("should fail if a secondary elastic IP is associated with another resource", func() {
+			infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+				Networks: apisaws.Networks{
+					Zones: []apisaws.Zone{{Name: "eu-west-1a", SecondaryElasticIPs: &apisaws.SecondaryElasticIPs{AllocationIDs: []string{"eipalloc-1", "eipalloc-2"}}}},
+				},
+			})
+			awsClient.EXPECT().GetElasticIPsAssociationIDForAllocationIDs(ctx, []string{"eipalloc-1", "eipalloc-2"}).Return(map[string]*string{
+				"eipalloc-1": nil,
+				"eipalloc-2": ptr.To("eipassoc-2"),
+			}, nil)
+			awsClient.EXPECT().GetNATGatewayAddressAllocations(ctx, infra.Namespace).Return(sets.New[string](), nil)
+
+			Expect(cv.Validate(ctx, infra)).To(ConsistOfFields(Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("networks.zones[].secondaryElasticIPs.allocationIDs"),
+				"BadValue": Equal("eipalloc-2"),
+			}))
+		})
+	})
+
+	Context("Elastic IP pool", func() {
+		var config *apisaws.InfrastructureConfig
+
+		BeforeEach(func() {
+			config = &apisaws.InfrastructureConfig{
+				Networks: apisaws.Networks{
+					ElasticIPPool: &apisaws.ElasticIPPool{PublicIPv4PoolID: ptr.To("ipv4pool-ec2-1")},
+					Zones: []apisaws.Zone{
+						{Name: "eu-west-1a"},
+						{Name: "eu-west-1b"},
+						{Name: "eu-west-1c", ElasticIPAllocationID: ptr.To("eipalloc-1")},
+					},
+				},
+			}
+			infra.Spec.ProviderConfig.Raw = encode(config)
+			awsClient.EXPECT().GetElasticIPsAssociationIDForAllocationIDs(ctx, []string{"eipalloc-1"}).Return(map[string]*string{"eipalloc-1": nil}, nil)
+		})
+
+		It("should succeed if the pool has enough free addresses", func() {
+			awsClient.EXPECT().GetPublicIPv4PoolFreeAddressCount(ctx, "ipv4pool-ec2-1").Return(ptr.To(2), nil)
+
+			Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+		})
+
+		It("should fail if the pool does not exist", func() {
+			awsClient.EXPECT().GetPublicIPv4PoolFreeAddressCount(ctx, "ipv4pool-ec2-1").Return(nil, nil)
+
+			Expect(cv.Validate(ctx, infra)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("networks.elasticIPPool.publicIPv4PoolID"),
+			}))
+		})
+
+		It("should fail if the IPAM pool has not enough free addresses", func() {
+			config.Networks.ElasticIPPool = &apisaws.ElasticIPPool{IPAMPoolID: ptr.To("ipam-pool-1")}
+			infra.Spec.ProviderConfig.Raw = encode(config)
+			awsClient.EXPECT().GetIPAMPoolFreeAddressCount(ctx, "ipam-pool-1").Return(ptr.To(1), nil)
+
+			Expect(cv.Validate(ctx, infra)).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.elasticIPPool.ipamPoolID"),
+				"Detail": Equal("pool has 1 free addresses, but 2 elastic IPs need to be allocated for the NAT gateways"),
+			}))
+		})
+
+		It("should count the secondary elastic IPs which still need to be allocated", func() {
+			config.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](2)}
+			infra.Spec.ProviderConfig.Raw = encode(config)
+			awsClient.EXPECT().GetPublicIPv4PoolFreeAddressCount(ctx, "ipv4pool-ec2-1").Return(ptr.To(3), nil)
+
+			Expect(cv.Validate(ctx, infra)).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.elasticIPPool.publicIPv4PoolID"),
+				"Detail": Equal("pool has 3 free addresses, but 4 elastic IPs need to be allocated for the NAT gateways"),
+			}))
+		})
+
+		It("should only count the secondary elastic IPs which are not recorded in the state", func() {
+			config.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](2)}
+			config.Networks.Zones[1].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{AllocationIDs: []string{"eipalloc-3"}}
+			infra.Spec.ProviderConfig.Raw = encode(config)
+			infra.Status.State = &runtime.RawExtension{Raw: encode(&v1alpha1.InfrastructureState{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureState"},
+				Data: map[string]string{
+					infraflow.ChildIdZones + shared.Separator + "eu-west-1a" + shared.Separator + infraflow.IdentifierManagedZoneNATGWSecondaryElasticIPs: "eipalloc-2",
+				},
+			})}
+			awsClient.EXPECT().GetElasticIPsAssociationIDForAllocationIDs(ctx, []string{"eipalloc-3"}).Return(map[string]*string{"eipalloc-3": nil}, nil).AnyTimes()
+			awsClient.EXPECT().GetPublicIPv4PoolFreeAddressCount(ctx, "ipv4pool-ec2-1").Return(ptr.To(3), nil)
+
+			Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+		})
+
+		It("should not count the elastic IPs of zones without NAT gateway", func() {
+			config.Networks.Egress = &apisaws.Egress{Mode: apisaws.EgressModeSingleNATGateway}
+			config.Networks.Zones[0].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](2)}
+			config.Networks.Zones[1].SecondaryElasticIPs = &apisaws.SecondaryElasticIPs{Count: ptr.To[int32](2)}
+			infra.Spec.ProviderConfig.Raw = encode(config)
+			awsClient.EXPECT().GetPublicIPv4PoolFreeAddressCount(ctx, "ipv4pool-ec2-1").Return(ptr.To(2), nil)
+
+			Expect(cv.Validate(ctx, infra)).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.elasticIPPool.publicIPv4PoolID"),
+				"Detail": Equal("pool has 2 free addresses, but 3 elastic IPs need to be allocated for the NAT gateways"),
+			}))
+		})
+
+		It("should not count zones which already have a managed elastic IP", func() {
+			infra.Status.State = &runtime.RawExtension{Raw: encode(&v1alpha1.InfrastructureState{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureState"},
+				Data: map[string]string{
+					infraflow.ChildIdZones + shared.Separator + "eu-west-1a" + shared.Separator + infraflow.IdentifierManagedZoneNATGWElasticIP: "eipalloc-2",
+				},
+			})}
+			awsClient.EXPECT().GetPublicIPv4PoolFreeAddressCount(ctx, "ipv4pool-ec2-1").Return(ptr.To(1), nil)
+
+			Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+		})
+	})
 })
 
 // helpers
@@ -0,0 +1,338 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// NewStateFromInventory creates an infrastructure state which lets the next reconciliation adopt the resources of the
+// given inventory instead of creating new ones. The IDs of resources given by their Terraform addresses are read from
+// the given Terraform state.
+func NewStateFromInventory(inventory *awsapi.InfrastructureInventory, tfState *shared.TerraformState, config *awsapi.InfrastructureConfig) (*awsapi.InfrastructureState, error) {
+	resources, err := resolveTerraformAddresses(inventory.TerraformResourceAddresses, tfState)
+	if err != nil {
+		return nil, err
+	}
+	mergeInventoryResources(resources, inventory.Resources)
+
+	whiteboard := shared.NewWhiteboard()
+	whiteboard.SetPtr(IdentifierVPC, resources.VPC)
+	whiteboard.SetPtr(IdentifierDHCPOptions, resources.DHCPOptions)
+	whiteboard.SetPtr(IdentifierDefaultSecurityGroup, resources.DefaultSecurityGroup)
+	whiteboard.SetPtr(IdentifierInternetGateway, resources.InternetGateway)
+	whiteboard.SetPtr(IdentifierEgressOnlyInternetGateway, resources.EgressOnlyInternetGateway)
+	whiteboard.SetPtr(IdentifierMainRouteTable, resources.MainRouteTable)
+	whiteboard.SetPtr(IdentifierNodesSecurityGroup, resources.NodesSecurityGroup)
+	for name, id := range resources.VPCEndpoints {
+		whiteboard.GetChild(ChildIdVPCEndpoints).Set(name, id)
+	}
+
+	for _, zone := range resources.Zones {
+		if !hasZone(config, zone.Name) {
+			return nil, fmt.Errorf("zone %s of the inventory is not configured in the infrastructure config", zone.Name)
+		}
+		child := whiteboard.GetChild(ChildIdZones).GetChild(zone.Name)
+		child.SetPtr(IdentifierZoneSubnetWorkers, zone.WorkersSubnet)
+		child.SetPtr(IdentifierZoneSubnetPrivate, zone.InternalSubnet)
+		child.SetPtr(IdentifierZoneSubnetPublic, zone.PublicSubnet)
+		child.SetPtr(IdentifierZoneNATGateway, zone.NATGateway)
+		child.SetPtr(IdentifierManagedZoneNATGWElasticIP, zone.NATGatewayElasticIP)
+		child.SetPtr(IdentifierZoneRouteTable, zone.RouteTable)
+	}
+
+	return &awsapi.InfrastructureState{Data: whiteboard.ExportAsFlatMap()}, nil
+}
+
+// resolveTerraformAddresses returns the resources with the IDs of the instances with the given addresses in the given
+// Terraform state.
+func resolveTerraformAddresses(addresses *awsapi.InventoryResources, tfState *shared.TerraformState) (*awsapi.InventoryResources, error) {
+	resources := &awsapi.InventoryResources{}
+	if addresses == nil {
+		return resources, nil
+	}
+	if tfState == nil {
+		return nil, fmt.Errorf("the inventory refers to Terraform resource addresses, but no Terraform state is given")
+	}
+
+	var errs []error
+	resolve := func(address *string) *string {
+		if address == nil {
+			return nil
+		}
+		id, err := tfState.GetManagedResourceInstanceAttributeByAddress(*address, shared.AttributeKeyId)
+		if err != nil {
+			errs = append(errs, err)
+		} else if id == nil {
+			errs = append(errs, fmt.Errorf("resource %s not found in Terraform state", *address))
+		}
+		return id
+	}
+
+	resources.VPC = resolve(addresses.VPC)
+	resources.DHCPOptions = resolve(addresses.DHCPOptions)
+	resources.DefaultSecurityGroup = resolve(addresses.DefaultSecurityGroup)
+	resources.InternetGateway = resolve(addresses.InternetGateway)
+	resources.EgressOnlyInternetGateway = resolve(addresses.EgressOnlyInternetGateway)
+	resources.MainRouteTable = resolve(addresses.MainRouteTable)
+	resources.NodesSecurityGroup = resolve(addresses.NodesSecurityGroup)
+	if len(addresses.VPCEndpoints) > 0 {
+		resources.VPCEndpoints = map[string]string{}
+		for name, address := range addresses.VPCEndpoints {
+			if id := resolve(&address); id != nil {
+				resources.VPCEndpoints[name] = *id
+			}
+		}
+	}
+	for _, zone := range addresses.Zones {
+		resources.Zones = append(resources.Zones, awsapi.ZoneInventoryResources{
+			Name:                zone.Name,
+			WorkersSubnet:       resolve(zone.WorkersSubnet),
+			InternalSubnet:      resolve(zone.InternalSubnet),
+			PublicSubnet:        resolve(zone.PublicSubnet),
+			NATGateway:          resolve(zone.NATGateway),
+			NATGatewayElasticIP: resolve(zone.NATGatewayElasticIP),
+			RouteTable:          resolve(zone.RouteTable),
+		})
+	}
+	return resources, errors.Join(errs...)
+}
+
+// mergeInventoryResources sets the IDs of the given overrides in the given resources.
+func mergeInventoryResources(resources, overrides *awsapi.InventoryResources) {
+	if overrides == nil {
+		return
+	}
+
+	resources.VPC = cmp.Or(overrides.VPC, resources.VPC)
+	resources.DHCPOptions = cmp.Or(overrides.DHCPOptions, resources.DHCPOptions)
+	resources.DefaultSecurityGroup = cmp.Or(overrides.DefaultSecurityGroup, resources.DefaultSecurityGroup)
+	resources.InternetGateway = cmp.Or(overrides.InternetGateway, resources.InternetGateway)
+	resources.EgressOnlyInternetGateway = cmp.Or(overrides.EgressOnlyInternetGateway, resources.EgressOnlyInternetGateway)
+	resources.MainRouteTable = cmp.Or(overrides.MainRouteTable, resources.MainRouteTable)
+	resources.NodesSecurityGroup = cmp.Or(overrides.NodesSecurityGroup, resources.NodesSecurityGroup)
+	for name, id := range overrides.VPCEndpoints {
+		if resources.VPCEndpoints == nil {
+			resources.VPCEndpoints = map[string]string{}
+		}
+		resources.VPCEndpoints[name] = id
+	}
+
+	for _, override := range overrides.Zones {
+		i := findZoneResources(resources.Zones, override.Name)
+		if i < 0 {
+			resources.Zones = append(resources.Zones, override)
+			continue
+		}
+		zone := &resources.Zones[i]
+		zone.WorkersSubnet = cmp.Or(override.WorkersSubnet, zone.WorkersSubnet)
+		zone.InternalSubnet = cmp.Or(override.InternalSubnet, zone.InternalSubnet)
+		zone.PublicSubnet = cmp.Or(override.PublicSubnet, zone.PublicSubnet)
+		zone.NATGateway = cmp.Or(override.NATGateway, zone.NATGateway)
+		zone.NATGatewayElasticIP = cmp.Or(override.NATGatewayElasticIP, zone.NATGatewayElasticIP)
+		zone.RouteTable = cmp.Or(override.RouteTable, zone.RouteTable)
+	}
+}
+
+func findZoneResources(zones []awsapi.ZoneInventoryResources, name string) int {
+	for i, zone := range zones {
+		if zone.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasZone(config *awsapi.InfrastructureConfig, name string) bool {
+	for _, zone := range config.Networks.Zones {
+		if zone.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAdoptedState checks that the resources of the given adopted state exist, belong to its VPC and match the
+// given infrastructure config, so that the reconciliation adopts them instead of replacing them. It completes the
+// state with the IDs of the route table associations of the subnets.
+func ValidateAdoptedState(ctx context.Context, client awsclient.Interface, state *awsapi.InfrastructureState, config *awsapi.InfrastructureConfig) error {
+	whiteboard := shared.NewWhiteboard()
+	whiteboard.ImportFromFlatMap(state.Data)
+
+	vpcID := whiteboard.Get(IdentifierVPC)
+	if configured := config.Networks.VPC.ID; configured != nil {
+		if vpcID != nil && *vpcID != *configured {
+			return fmt.Errorf("VPC %s of the inventory does not match the configured VPC %s", *vpcID, *configured)
+		}
+		vpcID = configured
+	}
+	if vpcID == nil {
+		return fmt.Errorf("the inventory does not contain a VPC")
+	}
+
+	vpc, err := client.GetVpc(ctx, *vpcID)
+	if err != nil {
+		return err
+	}
+	if vpc == nil {
+		return fmt.Errorf("VPC %s not found", *vpcID)
+	}
+	if cidr := config.Networks.VPC.CIDR; config.Networks.VPC.ID == nil && cidr != nil && *cidr != vpc.CidrBlock {
+		return fmt.Errorf("CIDR %s of VPC %s does not match the configured CIDR %s", vpc.CidrBlock, *vpcID, *cidr)
+	}
+
+	var errs []error
+	inVPC := func(kind, id string, resourceVPC *string, err error, found bool) {
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case !found:
+			errs = append(errs, fmt.Errorf("%s %s not found", kind, id))
+		case resourceVPC != nil && *resourceVPC != *vpcID:
+			errs = append(errs, fmt.Errorf("%s %s does not belong to VPC %s", kind, id, *vpcID))
+		}
+	}
+
+	if id := whiteboard.Get(IdentifierDHCPOptions); id != nil {
+		options, err := client.GetVpcDhcpOptions(ctx, *id)
+		inVPC("DHCP options", *id, nil, err, options != nil)
+		if options != nil && ptr.Deref(vpc.DhcpOptionsId, "") != *id {
+			errs = append(errs, fmt.Errorf("DHCP options %s are not associated with VPC %s", *id, *vpcID))
+		}
+	}
+	for _, key := range []string{IdentifierDefaultSecurityGroup, IdentifierNodesSecurityGroup} {
+		if id := whiteboard.Get(key); id != nil {
+			group, err := client.GetSecurityGroup(ctx, *id)
+			inVPC("security group", *id, ptrOrNil(group, func(g *awsclient.SecurityGroup) *string { return g.VpcId }), err, group != nil)
+		}
+	}
+	if id := whiteboard.Get(IdentifierInternetGateway); id != nil {
+		gateway, err := client.GetInternetGateway(ctx, *id)
+		inVPC("internet gateway", *id, ptrOrNil(gateway, func(g *awsclient.InternetGateway) *string { return g.VpcId }), err, gateway != nil)
+	}
+	if id := whiteboard.Get(IdentifierEgressOnlyInternetGateway); id != nil {
+		gateway, err := client.GetEgressOnlyInternetGateway(ctx, *id)
+		inVPC("egress-only internet gateway", *id, ptrOrNil(gateway, func(g *awsclient.EgressOnlyInternetGateway) *string { return g.VpcId }), err, gateway != nil)
+	}
+	if endpoints := whiteboard.GetChild(ChildIdVPCEndpoints).AsMap(); len(endpoints) > 0 {
+		ids := make([]string, 0, len(endpoints))
+		for _, id := range endpoints {
+			ids = append(ids, id)
+		}
+		found, err := client.GetVpcEndpoints(ctx, ids)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for name, id := range endpoints {
+			var endpoint *awsclient.VpcEndpoint
+			for _, item := range found {
+				if item.VpcEndpointId == id {
+					endpoint = item
+				}
+			}
+			inVPC("VPC endpoint "+name, id, ptrOrNil(endpoint, func(e *awsclient.VpcEndpoint) *string { return e.VpcId }), nil, endpoint != nil)
+		}
+	}
+
+	mainRouteTable, err := getAdoptedRouteTable(ctx, client, whiteboard.Get(IdentifierMainRouteTable), inVPC)
+	if err != nil {
+		return err
+	}
+	for _, zone := range config.Networks.Zones {
+		child := whiteboard.GetChild(ChildIdZones).GetChild(zone.Name)
+		zoneRouteTable, err := getAdoptedRouteTable(ctx, client, child.Get(IdentifierZoneRouteTable), inVPC)
+		if err != nil {
+			return err
+		}
+
+		for _, subnet := range []struct {
+			key, assocKey, cidr string
+			routeTable          *awsclient.RouteTable
+		}{
+			{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetWorkersRouteTableAssoc, zone.Workers, zoneRouteTable},
+			{IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPrivateRouteTableAssoc, zone.Internal, zoneRouteTable},
+			{IdentifierZoneSubnetPublic, IdentifierZoneSubnetPublicRouteTableAssoc, zone.Public, mainRouteTable},
+		} {
+			id := child.Get(subnet.key)
+			if id == nil {
+				continue
+			}
+			subnets, err := client.GetSubnets(ctx, []string{*id})
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			inVPC("subnet", *id, ptrOrNil(single(subnets), func(s *awsclient.Subnet) *string { return s.VpcId }), nil, len(subnets) == 1)
+			if len(subnets) != 1 {
+				continue
+			}
+			if subnets[0].CidrBlock != subnet.cidr || subnets[0].AvailabilityZone != zone.Name {
+				errs = append(errs, fmt.Errorf("subnet %s with CIDR %s in zone %s does not match the configured CIDR %s in zone %s",
+					*id, subnets[0].CidrBlock, subnets[0].AvailabilityZone, subnet.cidr, zone.Name))
+			}
+			if subnet.routeTable != nil {
+				for _, assoc := range subnet.routeTable.Associations {
+					if ptr.Deref(assoc.SubnetId, "") == *id {
+						child.Set(subnet.assocKey, assoc.RouteTableAssociationId)
+					}
+				}
+			}
+		}
+
+		if id := child.Get(IdentifierManagedZoneNATGWElasticIP); id != nil {
+			if zone.ElasticIPAllocationID != nil && *zone.ElasticIPAllocationID != *id {
+				errs = append(errs, fmt.Errorf("elastic IP %s of zone %s does not match the configured elastic IP %s", *id, zone.Name, *zone.ElasticIPAllocationID))
+			}
+			eip, err := client.GetElasticIP(ctx, *id)
+			inVPC("elastic IP", *id, nil, err, eip != nil)
+		}
+		if id := child.Get(IdentifierZoneNATGateway); id != nil {
+			gateway, err := client.GetNATGateway(ctx, *id)
+			inVPC("NAT gateway", *id, ptrOrNil(gateway, func(g *awsclient.NATGateway) *string { return g.VpcId }), err, gateway != nil)
+			if public := child.Get(IdentifierZoneSubnetPublic); gateway != nil && public != nil && gateway.SubnetId != *public {
+				errs = append(errs, fmt.Errorf("NAT gateway %s is not in the public subnet %s of zone %s", *id, *public, zone.Name))
+			}
+		}
+	}
+
+	state.Data = whiteboard.ExportAsFlatMap()
+	return errors.Join(errs...)
+}
+
+// getAdoptedRouteTable returns the route table with the given ID, if any, and reports it to the given check function.
+func getAdoptedRouteTable(ctx context.Context, client awsclient.Interface, id *string, check func(kind, id string, resourceVPC *string, err error, found bool)) (*awsclient.RouteTable, error) {
+	if id == nil {
+		return nil, nil
+	}
+	routeTable, err := client.GetRouteTable(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+	check("route table", *id, ptrOrNil(routeTable, func(t *awsclient.RouteTable) *string { return t.VpcId }), nil, routeTable != nil)
+	return routeTable, nil
+}
+
+func ptrOrNil[T any](item *T, get func(*T) *string) *string {
+	if item == nil {
+		return nil
+	}
+	return get(item)
+}
+
+func single[T any](items []*T) *T {
+	if len(items) != 1 {
+		return nil
+	}
+	return items[0]
+}
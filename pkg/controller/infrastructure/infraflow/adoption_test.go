@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("Adoption", func() {
+	const (
+		zone       = "eu-west-1a"
+		zonePrefix = ChildIdZones + shared.Separator + zone + shared.Separator
+	)
+
+	var (
+		ctx    = context.Background()
+		config *awsapi.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		config = &awsapi.InfrastructureConfig{
+			Networks: awsapi.Networks{
+				VPC: awsapi.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []awsapi.Zone{{
+					Name:     zone,
+					Workers:  "10.0.0.0/19",
+					Internal: "10.0.32.0/20",
+					Public:   "10.0.48.0/20",
+				}},
+			},
+		}
+	})
+
+	Describe("#NewStateFromInventory", func() {
+		var tfState *shared.TerraformState
+
+		BeforeEach(func() {
+			var err error
+			tfState, err = shared.UnmarshalTerraformState([]byte(`{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "aws_vpc", "name": "main", "instances": [{"attributes": {"id": "vpc-1"}}]},
+    {"mode": "managed", "type": "aws_subnet", "name": "nodes", "instances": [{"index_key": "eu-west-1a", "attributes": {"id": "subnet-1"}}]}
+  ]
+}`))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should seed the state with the IDs of the inventory and the Terraform state", func() {
+			state, err := NewStateFromInventory(&awsapi.InfrastructureInventory{
+				Resources: &awsapi.InventoryResources{
+					InternetGateway: ptr.To("igw-1"),
+					VPCEndpoints:    map[string]string{"s3": "vpce-1"},
+					Zones:           []awsapi.ZoneInventoryResources{{Name: zone, NATGateway: ptr.To("nat-1")}},
+				},
+				TerraformResourceAddresses: &awsapi.InventoryResources{
+					VPC:   ptr.To("aws_vpc.main"),
+					Zones: []awsapi.ZoneInventoryResources{{Name: zone, WorkersSubnet: ptr.To(`aws_subnet.nodes["eu-west-1a"]`)}},
+				},
+			}, tfState, config)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Data).To(Equal(map[string]string{
+				IdentifierVPC:             "vpc-1",
+				IdentifierInternetGateway: "igw-1",
+				ChildIdVPCEndpoints + shared.Separator + "s3": "vpce-1",
+				zonePrefix + IdentifierZoneSubnetWorkers:      "subnet-1",
+				zonePrefix + IdentifierZoneNATGateway:         "nat-1",
+			}))
+		})
+
+		It("should prefer the IDs of the inventory over the Terraform state", func() {
+			state, err := NewStateFromInventory(&awsapi.InfrastructureInventory{
+				Resources:                  &awsapi.InventoryResources{VPC: ptr.To("vpc-2")},
+				TerraformResourceAddresses: &awsapi.InventoryResources{VPC: ptr.To("aws_vpc.main")},
+			}, tfState, config)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Data).To(HaveKeyWithValue(IdentifierVPC, "vpc-2"))
+		})
+
+		It("should fail if an address is not found in the Terraform state", func() {
+			_, err := NewStateFromInventory(&awsapi.InfrastructureInventory{
+				TerraformResourceAddresses: &awsapi.InventoryResources{VPC: ptr.To("aws_vpc.other")},
+			}, tfState, config)
+
+			Expect(err).To(MatchError(ContainSubstring("aws_vpc.other not found")))
+		})
+
+		It("should fail if addresses are given without Terraform state", func() {
+			_, err := NewStateFromInventory(&awsapi.InfrastructureInventory{
+				TerraformResourceAddresses: &awsapi.InventoryResources{VPC: ptr.To("aws_vpc.main")},
+			}, nil, config)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if a zone is not configured", func() {
+			_, err := NewStateFromInventory(&awsapi.InfrastructureInventory{
+				Resources: &awsapi.InventoryResources{Zones: []awsapi.ZoneInventoryResources{{Name: "eu-west-1b"}}},
+			}, nil, config)
+
+			Expect(err).To(MatchError(ContainSubstring("eu-west-1b")))
+		})
+	})
+
+	Describe("#ValidateAdoptedState", func() {
+		var (
+			ctrl      *gomock.Controller
+			awsClient *mockawsclient.MockInterface
+			state     *awsapi.InfrastructureState
+			subnet    *awsclient.Subnet
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			awsClient = mockawsclient.NewMockInterface(ctrl)
+
+			state = &awsapi.InfrastructureState{Data: map[string]string{
+				IdentifierVPC:                            "vpc-1",
+				IdentifierInternetGateway:                "igw-1",
+				zonePrefix + IdentifierZoneSubnetWorkers: "subnet-1",
+				zonePrefix + IdentifierZoneRouteTable:    "rtb-1",
+			}}
+			subnet = &awsclient.Subnet{SubnetId: "subnet-1", VpcId: ptr.To("vpc-1"), CidrBlock: "10.0.0.0/19", AvailabilityZone: zone}
+
+			awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(&awsclient.VPC{VpcId: "vpc-1", CidrBlock: "10.0.0.0/16"}, nil).AnyTimes()
+			awsClient.EXPECT().GetInternetGateway(ctx, "igw-1").Return(&awsclient.InternetGateway{InternetGatewayId: "igw-1", VpcId: ptr.To("vpc-1")}, nil).AnyTimes()
+			awsClient.EXPECT().GetRouteTable(ctx, "rtb-1").Return(&awsclient.RouteTable{
+				RouteTableId: "rtb-1",
+				VpcId:        ptr.To("vpc-1"),
+				Associations: []*awsclient.RouteTableAssociation{{SubnetId: ptr.To("subnet-1"), RouteTableAssociationId: "rtbassoc-1"}},
+			}, nil).AnyTimes()
+			awsClient.EXPECT().GetSubnets(ctx, []string{"subnet-1"}).DoAndReturn(func(context.Context, []string) ([]*awsclient.Subnet, error) {
+				return []*awsclient.Subnet{subnet}, nil
+			}).AnyTimes()
+		})
+
+		It("should accept matching resources and complete the route table associations", func() {
+			Expect(ValidateAdoptedState(ctx, awsClient, state, config)).To(Succeed())
+			Expect(state.Data).To(HaveKeyWithValue(zonePrefix+IdentifierZoneSubnetWorkersRouteTableAssoc, "rtbassoc-1"))
+		})
+
+		It("should reject a subnet of another VPC", func() {
+			subnet.VpcId = ptr.To("vpc-2")
+
+			Expect(ValidateAdoptedState(ctx, awsClient, state, config)).To(MatchError(ContainSubstring("subnet subnet-1 does not belong to VPC vpc-1")))
+		})
+
+		It("should reject a subnet with another CIDR", func() {
+			subnet.CidrBlock = "10.0.64.0/19"
+
+			Expect(ValidateAdoptedState(ctx, awsClient, state, config)).To(MatchError(ContainSubstring("does not match the configured CIDR 10.0.0.0/19")))
+		})
+
+		It("should reject a VPC which does not match the configured VPC", func() {
+			config.Networks.VPC = awsapi.VPC{ID: ptr.To("vpc-2")}
+
+			Expect(ValidateAdoptedState(ctx, awsClient, state, config)).To(MatchError(ContainSubstring("does not match the configured VPC vpc-2")))
+		})
+
+		It("should reject a missing VPC", func() {
+			awsClient.EXPECT().GetVpc(ctx, "vpc-3").Return(nil, nil)
+			state.Data[IdentifierVPC] = "vpc-3"
+
+			Expect(ValidateAdoptedState(ctx, awsClient, state, config)).To(MatchError("VPC vpc-3 not found"))
+		})
+	})
+})
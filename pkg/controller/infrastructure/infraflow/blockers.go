@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// deletionBlockersGracePeriod is the time dependents of the infrastructure may take to disappear by themselves,
+	// e.g. the network interfaces of deleted load balancers, before the deletion is not retried anymore.
+	deletionBlockersGracePeriod = 10 * time.Minute
+
+	deletionBlockerNetworkInterface      = "network-interface"
+	deletionBlockerSecurityGroup         = "security-group"
+	deletionBlockerRouteTableAssociation = "route-table-association"
+)
+
+// checkDeletionBlockers lists the resources which are not managed by the extension but use the subnets, route tables
+// or the nodes security group of the infrastructure in an existing VPC. AWS refuses to delete them with a
+// DependencyViolation as long as such resources exist. The blocking resources are reported in the provider status.
+// The deletion is retried for a grace period after they have been found first and fails with a non-retryable error
+// afterward, as they have to be removed by their owner.
+func (c *FlowContext) checkDeletionBlockers(ctx context.Context) error {
+	blockers, err := c.findDeletionBlockers(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := c.computeInfrastructureStatus()
+	status.DeletionBlockers = blockers
+	if err := c.WithPersistorLock(func() error {
+		return PatchProviderStatusAndState(ctx, c.runtimeClient, c.infra, c.networking, status, nil, c.getEgressCIDRs(),
+			c.state.Get(IdentifierVpcIPv6CidrBlock), c.state.Get(IdentifierServiceCIDR))
+	}); err != nil {
+		return fmt.Errorf("failed to report deletion blockers: %w", err)
+	}
+	if len(blockers) == 0 {
+		c.state.Delete(IdentifierDeletionBlockedSince)
+		return nil
+	}
+
+	blockedSince := time.Now()
+	if v := c.state.Get(IdentifierDeletionBlockedSince); v != nil {
+		if t, err := time.Parse(time.RFC3339, *v); err == nil {
+			blockedSince = t
+		}
+	}
+	c.state.Set(IdentifierDeletionBlockedSince, blockedSince.UTC().Format(time.RFC3339))
+
+	c.deletionBlockedCode = v1beta1.ErrorRetryableInfraDependencies
+	if time.Since(blockedSince) >= deletionBlockersGracePeriod {
+		c.deletionBlockedCode = v1beta1.ErrorInfraDependencies
+	}
+	var descriptions []string
+	for _, blocker := range blockers {
+		description := blocker.Type + " " + blocker.ID
+		if blocker.Owner != "" {
+			description += " of " + blocker.Owner
+		}
+		descriptions = append(descriptions, description+" uses "+blocker.Dependency)
+	}
+	return fmt.Errorf("resources not managed by the infrastructure must be removed before it can be deleted: %s",
+		strings.Join(descriptions, ", "))
+}
+
+func (c *FlowContext) findDeletionBlockers(ctx context.Context) ([]awsv1alpha1.DeletionBlocker, error) {
+	var (
+		subnetIDs     = sets.New[string]()
+		routeTableIDs []string
+		natGatewayIDs []string
+		groupID       = ptr.Deref(c.state.Get(IdentifierNodesSecurityGroup), "")
+	)
+	zones := c.state.GetChild(ChildIdZones)
+	for _, zoneName := range zones.GetChildrenKeys() {
+		child := zones.GetChild(zoneName)
+		for _, key := range []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPublic} {
+			if id := child.Get(key); id != nil {
+				subnetIDs.Insert(*id)
+			}
+		}
+		if id := child.Get(IdentifierZoneRouteTable); id != nil {
+			routeTableIDs = append(routeTableIDs, *id)
+		}
+		if id := child.Get(IdentifierZoneNATGateway); id != nil {
+			natGatewayIDs = append(natGatewayIDs, *id)
+		}
+	}
+	if id := c.state.Get(IdentifierMainRouteTable); id != nil {
+		routeTableIDs = append(routeTableIDs, *id)
+	}
+
+	var (
+		blockers []awsv1alpha1.DeletionBlocker
+		seen     = sets.New[string]()
+		queries  [][]ec2types.Filter
+	)
+	if subnetIDs.Len() > 0 {
+		queries = append(queries, awsclient.WithFilters().With(awsclient.FilterSubnetID, sets.List(subnetIDs)...).Build())
+	}
+	if groupID != "" {
+		queries = append(queries, awsclient.WithFilters().With(awsclient.FilterGroupID, groupID).Build())
+	}
+	for _, filters := range queries {
+		interfaces, err := c.client.FindNetworkInterfaces(ctx, filters)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range interfaces {
+			// the network interfaces of the NAT gateways are deleted together with them
+			if seen.Has(item.NetworkInterfaceId) || slices.ContainsFunc(natGatewayIDs, func(id string) bool {
+				return strings.Contains(item.Description, id)
+			}) {
+				continue
+			}
+			seen.Insert(item.NetworkInterfaceId)
+			dependency := item.SubnetId
+			if !subnetIDs.Has(dependency) {
+				dependency = groupID
+			}
+			blockers = append(blockers, awsv1alpha1.DeletionBlocker{
+				Type:        deletionBlockerNetworkInterface,
+				ID:          item.NetworkInterfaceId,
+				Owner:       ptr.Deref(item.RequesterId, item.OwnerId),
+				Description: item.Description,
+				Dependency:  dependency,
+			})
+		}
+	}
+
+	if groupID != "" {
+		for _, name := range []string{awsclient.FilterIngressGroupID, awsclient.FilterEgressGroupID} {
+			groups, err := c.client.FindSecurityGroups(ctx, awsclient.WithFilters().With(name, groupID).Build())
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range groups {
+				if item.GroupId == groupID || seen.Has(item.GroupId) {
+					continue
+				}
+				seen.Insert(item.GroupId)
+				blockers = append(blockers, awsv1alpha1.DeletionBlocker{
+					Type:        deletionBlockerSecurityGroup,
+					ID:          item.GroupId,
+					Owner:       item.OwnerId,
+					Description: item.GroupName,
+					Dependency:  groupID,
+				})
+			}
+		}
+	}
+
+	for _, id := range routeTableIDs {
+		routeTable, err := c.client.GetRouteTable(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if routeTable == nil {
+			continue
+		}
+		for _, assoc := range routeTable.Associations {
+			if assoc.Main || subnetIDs.Has(ptr.Deref(assoc.SubnetId, "")) {
+				continue
+			}
+			blockers = append(blockers, awsv1alpha1.DeletionBlocker{
+				Type:        deletionBlockerRouteTableAssociation,
+				ID:          assoc.RouteTableAssociationId,
+				Description: "association with " + ptr.Deref(assoc.SubnetId, ptr.Deref(assoc.GatewayId, "")),
+				Dependency:  id,
+			})
+		}
+	}
+	return blockers, nil
+}
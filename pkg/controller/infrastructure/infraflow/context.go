@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"strings"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
@@ -37,6 +39,10 @@ const (
 	TagValueCluster = "1"
 	// TagValueELB is the tag value for the ELB tag keys
 	TagValueELB = "1"
+	// TagKeyOrphanedShoot is the tag key for the shoot of a resource which was retained on deletion of the infrastructure
+	TagKeyOrphanedShoot = "gardener.cloud/orphaned-shoot"
+	// TagKeyOrphanedAt is the tag key for the deletion time of the infrastructure of a retained resource
+	TagKeyOrphanedAt = "gardener.cloud/orphaned-at"
 
 	// IdentifierVPC is the key for the VPC id
 	IdentifierVPC = "VPC"
@@ -62,6 +68,9 @@ const (
 	IdentifierZoneSuffix = "Suffix"
 	// IdentifierManagedZoneNATGWElasticIP is the key for the allocationID of the gardener managed NAT gateway elastic IP
 	IdentifierManagedZoneNATGWElasticIP = "NATGatewayElasticIP"
+	// IdentifierManagedZoneNATGWSecondaryElasticIPs is the key for the comma separated allocationIDs of the gardener
+	// managed secondary NAT gateway elastic IPs
+	IdentifierManagedZoneNATGWSecondaryElasticIPs = "NATGatewaySecondaryElasticIPs"
 	// IdentifierZoneNATGateway is the key for the id of the NAT gateway resource
 	IdentifierZoneNATGateway = "NATGateway"
 	// IdentifierZoneRouteTable is the key for the id of route table of the zone
@@ -78,12 +87,25 @@ const (
 	IdentifierEgressCIDRs = "EgressCIDRs"
 	// IdentifierServiceCIDR is the key for the subnet cidr reservation for the service range.
 	IdentifierServiceCIDR = "ServiceCIDR"
+	// IdentifierDeletionBlockedSince is the key for the time resources not managed by the extension have been found to
+	// block the deletion of the infrastructure first.
+	IdentifierDeletionBlockedSince = "DeletionBlockedSince"
+	// IdentifierVpcFlowLog is the key for the id of the flow log of the VPC
+	IdentifierVpcFlowLog = "VpcFlowLog"
+	// IdentifierNetworkACLPublic is the key for the id of the network ACL of the public utility subnets
+	IdentifierNetworkACLPublic = "NetworkACLPublic"
+	// IdentifierNetworkACLInternal is the key for the id of the network ACL of the internal utility subnets
+	IdentifierNetworkACLInternal = "NetworkACLInternal"
+	// IdentifierNetworkACLWorkers is the key for the id of the network ACL of the workers subnets
+	IdentifierNetworkACLWorkers = "NetworkACLWorkers"
 	// NameIAMRole is the key for the name of the IAM role
 	NameIAMRole = "IAMRoleName"
 	// NameIAMInstanceProfile is the key for the name of the IAM instance profile
 	NameIAMInstanceProfile = "IAMInstanceProfileName"
 	// NameIAMRolePolicy is the key for the name of the IAM role policy
 	NameIAMRolePolicy = "IAMRolePolicyName"
+	// NameFlowLogsIAMRole is the key for the name of the IAM role used to publish flow logs to CloudWatch Logs
+	NameFlowLogsIAMRole = "FlowLogsIAMRoleName"
 	// NameKeyPair is the key for the name of the EC2 key pair resource
 	NameKeyPair = "KeyPair"
 	// ARNIAMRole is the key for the ARN of the IAM role
@@ -97,11 +119,24 @@ const (
 	IdentifierManagedEfsID = "efsSystemID"
 	// ChildEfsMountTargets is the children key for the EFS mount targets
 	ChildEfsMountTargets = "efsMountTargets"
+	// ChildEfsAccessPoints is the children key for the EFS access points
+	ChildEfsAccessPoints = "efsAccessPoints"
+
+	// IdentifierManagedFSxID is the key for the FSx file system ID
+	IdentifierManagedFSxID = "fsxFileSystemID"
+	// IdentifierFSxDNSName is the key for the DNS name of the FSx file system
+	IdentifierFSxDNSName = "fsxDNSName"
+	// IdentifierFSxMountName is the key for the mount name of the FSx for Lustre file system
+	IdentifierFSxMountName = "fsxMountName"
+	// IdentifierFSxSecurityGroup is the key for the id of the FSx security group
+	IdentifierFSxSecurityGroup = "FSxSecurityGroup"
 
 	// ChildIdVPCEndpoints is the child key for the VPC endpoints
 	ChildIdVPCEndpoints = "VPCEndpoints"
 	// ChildIdZones is the child key for the zones
 	ChildIdZones = "Zones"
+	// ChildRetained is the child key for the resources retained on deletion, it maps their IDs to the retained resource type
+	ChildRetained = "Retained"
 
 	// ObjectMainRouteTable is the object key used for caching the main route table object
 	ObjectMainRouteTable = "MainRouteTable"
@@ -142,6 +177,14 @@ type FlowContext struct {
 	updater       awsclient.Updater
 	commonTags    awsclient.Tags
 	networking    *v1beta1.Networking
+	shoot         *v1beta1.Shoot
+	// retained contains the resources to keep, it is only set while deleting the infrastructure.
+	retained sets.Set[awsapi.RetainedResource]
+	// deletionBlockedCode is the error code of the deletion if it is blocked by resources not managed by the extension.
+	deletionBlockedCode v1beta1.ErrorCode
+	// existingVpcFlowLogsCondition is the status, reason and message of the condition reporting the flow logs of an
+	// existing VPC, it is only set after they were checked while reconciling the infrastructure.
+	existingVpcFlowLogsCondition *v1beta1.Condition
 	*shared.BasicFlowContext
 }
 
@@ -168,6 +211,7 @@ func NewFlowContext(opts Opts) (*FlowContext, error) {
 		client:        opts.AwsClient,
 		runtimeClient: opts.RuntimeClient,
 		networking:    opts.Shoot.Spec.Networking,
+		shoot:         opts.Shoot,
 		shootUUID:     string(opts.Shoot.UID),
 	}
 	flowContext.commonTags = awsclient.Tags{
@@ -177,6 +221,20 @@ func NewFlowContext(opts Opts) (*FlowContext, error) {
 	return flowContext, nil
 }
 
+// patchConditions merges the conditions determined while reconciling into the status of the infrastructure.
+func (c *FlowContext) patchConditions(ctx context.Context) error {
+	if c.existingVpcFlowLogsCondition == nil {
+		return nil
+	}
+	checked := c.existingVpcFlowLogsCondition
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, c.infra.Status.Conditions, checked.Type)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, checked.Status, checked.Reason, checked.Message)
+
+	patch := client.MergeFrom(c.infra.DeepCopy())
+	c.infra.Status.Conditions = v1beta1helper.MergeConditions(c.infra.Status.Conditions, condition)
+	return c.runtimeClient.Status().Patch(ctx, c.infra, patch)
+}
+
 func (c *FlowContext) persistState(ctx context.Context) error {
 	return PatchProviderStatusAndState(ctx, c.runtimeClient, c.infra, c.networking, nil, c.computeInfrastructureState(), c.getEgressCIDRs(), c.state.Get(IdentifierVpcIPv6CidrBlock), c.state.Get(IdentifierServiceCIDR))
 }
@@ -264,10 +322,27 @@ func (c *FlowContext) getEgressCIDRs() []string {
 	return nil
 }
 
+// ManagedSecondaryElasticIPs returns the allocation IDs of the managed secondary elastic IPs of the zone.
+func ManagedSecondaryElasticIPs(zoneChild shared.Whiteboard) []string {
+	if v := zoneChild.Get(IdentifierManagedZoneNATGWSecondaryElasticIPs); v != nil && *v != "" {
+		return strings.Split(*v, ",")
+	}
+	return nil
+}
+
 func (c *FlowContext) hasVPC() bool {
 	return c.state.Get(IdentifierVPC) != nil
 }
 
+func (c *FlowContext) hasFlowLogs() bool {
+	return c.state.Get(IdentifierVpcFlowLog) != nil || c.state.Get(NameFlowLogsIAMRole) != nil
+}
+
+func (c *FlowContext) hasNetworkACLs() bool {
+	return c.state.Get(IdentifierNetworkACLPublic) != nil || c.state.Get(IdentifierNetworkACLInternal) != nil ||
+		c.state.Get(IdentifierNetworkACLWorkers) != nil
+}
+
 func (c *FlowContext) commonTagsWithSuffix(suffix string) awsclient.Tags {
 	tags := c.commonTags.Clone()
 	tags[TagKeyName] = fmt.Sprintf("%s-%s", c.namespace, suffix)
@@ -318,6 +393,31 @@ func (c *FlowContext) isCsiEfsEnabled() bool {
 	return c.config != nil && c.config.ElasticFileSystem != nil && c.config.ElasticFileSystem.Enabled
 }
 
+func (c *FlowContext) isFSxEnabled() bool {
+	return c.config != nil && c.config.FSx != nil
+}
+
+func (c *FlowContext) hasFSx() bool {
+	return c.state.Get(IdentifierManagedFSxID) != nil || c.state.Get(IdentifierFSxSecurityGroup) != nil
+}
+
+// hasGatewayEndpoints returns true if gateway endpoints are configured or recorded in the state. The VPC endpoints are
+// not looked up otherwise, as the credentials are not required to be permitted to.
+func (c *FlowContext) hasGatewayEndpoints() bool {
+	return len(c.config.Networks.VPC.GatewayEndpoints) > 0 || len(c.state.GetChild(ChildIdVPCEndpoints).Keys()) > 0
+}
+
+// natGatewayZone returns the name of the zone whose NAT gateway the private subnets of the given zone use, or an empty
+// string if they have no NAT gateway.
+func (c *FlowContext) natGatewayZone(zoneName string) string {
+	return helper.NATGatewayZone(c.config, zoneName)
+}
+
+// hasNATGateway returns true if a NAT gateway is needed in the given zone.
+func (c *FlowContext) hasNATGateway(zoneName string) bool {
+	return helper.HasNATGateway(c.config, zoneName)
+}
+
 // ZoneSuffixHelper provides methods to create suffices for various resources
 type ZoneSuffixHelper struct {
 	suffix string
@@ -343,6 +443,11 @@ func (h *ZoneSuffixHelper) GetSuffixElasticIP() string {
 	return fmt.Sprintf("eip-natgw-%s", h.suffix)
 }
 
+// GetSuffixSecondaryElasticIP builds the suffix for the secondary elastic IP of the NAT gateway with the given index
+func (h *ZoneSuffixHelper) GetSuffixSecondaryElasticIP(index int) string {
+	return fmt.Sprintf("eip-natgw-%s-secondary-%d", h.suffix, index)
+}
+
 // GetSuffixNATGateway builds the suffix for the NAT gateway
 func (h *ZoneSuffixHelper) GetSuffixNATGateway() string {
 	return fmt.Sprintf("natgw-%s", h.suffix)
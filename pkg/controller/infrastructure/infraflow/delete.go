@@ -11,8 +11,11 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/efs"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
@@ -24,19 +27,32 @@ func (c *FlowContext) Delete(ctx context.Context) error {
 		// nothing to do, e.g. if cluster was created with wrong credentials
 		return nil
 	}
+	if policy := c.config.DeletionPolicy; policy != nil {
+		c.retained = sets.New(policy.Retain...)
+	}
+	if err := c.checkDeletionConfirmation(); err != nil {
+		return err
+	}
 	c.BasicFlowContext = NewBasicFlowContext(c.log, c.state, c.persistState)
 	g := c.buildDeleteGraph()
 	f := g.Compile()
 	if err := f.Run(ctx, flow.Opts{Log: c.log}); err != nil {
+		if c.deletionBlockedCode != "" {
+			// the error codes of the tasks are lost in the flow
+			return v1beta1helper.NewErrorWithCodes(flow.Causes(err), c.deletionBlockedCode)
+		}
 		return flow.Causes(err)
 	}
-	return nil
+	return c.recordRetainedResources(ctx)
 }
 
 func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 	g := flow.NewGraph("AWS infrastructure destruction")
 
 	deleteVPC := c.config.Networks.VPC.ID == nil
+	// the resources in a retained VPC are kept, only the Kubernetes load balancers are deleted
+	retainVPC := deleteVPC && c.retains(awsapi.RetainedResourceVPC)
+	deleteVPCResources := c.hasVPC() && !retainVPC
 
 	destroyLoadBalancersAndSecurityGroups := c.AddTask(g, "Destroying Kubernetes load balancers and security groups",
 		c.deleteKubernetesLoadBalancersAndSecurityGroups,
@@ -54,6 +70,10 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 		c.deleteEfs,
 		DoIf(c.isCsiEfsEnabled()), Timeout(defaultTimeout))
 
+	deleteFSx := c.AddTask(g, "delete FSx file system",
+		c.deleteFSx,
+		DoIf(c.hasFSx()), Timeout(fsxDeletionTimeout))
+
 	deleteIAMInstanceProfile := c.AddTask(g, "delete IAM instance profile",
 		c.deleteIAMInstanceProfile,
 		Timeout(defaultTimeout), Dependencies(deleteIAMRolePolicy))
@@ -62,44 +82,63 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 		c.deleteIAMRole,
 		Timeout(defaultTimeout), Dependencies(deleteIAMInstanceProfile, deleteIAMRolePolicy))
 
+	deleteNetworkACLs := c.AddTask(g, "delete network ACLs",
+		c.deleteNetworkACLs,
+		DoIf(deleteVPCResources && c.hasNetworkACLs()), Timeout(defaultTimeout))
+
+	// in an existing VPC, resources not managed by the extension may still use the subnets and security groups
+	checkDeletionBlockers := c.AddTask(g, "check deletion blockers",
+		c.checkDeletionBlockers,
+		DoIf(!deleteVPC && deleteVPCResources), Timeout(defaultTimeout),
+		Dependencies(destroyLoadBalancersAndSecurityGroups, deleteEfs, deleteFSx))
+
 	deleteZones := c.AddTask(g, "delete zones resources",
 		c.deleteZones,
-		DoIf(c.hasVPC()), Timeout(defaultLongTimeout), Dependencies(deleteEfs))
+		DoIf(deleteVPCResources), Timeout(defaultLongTimeout), Dependencies(deleteEfs, deleteFSx, deleteNetworkACLs, checkDeletionBlockers))
 
 	deleteNodesSecurityGroup := c.AddTask(g, "delete nodes security group",
 		c.deleteNodesSecurityGroup,
-		DoIf(c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
+		DoIf(deleteVPCResources), Timeout(defaultTimeout), Dependencies(deleteZones))
 
 	deleteMainRouteTable := c.AddTask(g, "delete main route table",
 		c.deleteMainRouteTable,
-		DoIf(c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
+		DoIf(deleteVPCResources), Timeout(defaultTimeout), Dependencies(deleteZones))
 
 	deleteGatewayEndpoints := c.AddTask(g, "delete gateway endpoints",
 		c.deleteGatewayEndpoints,
-		DoIf(c.hasVPC()), Timeout(defaultTimeout))
+		DoIf(deleteVPCResources), Timeout(defaultTimeout))
 
 	deleteInternetGateway := c.AddTask(g, "delete internet gateway",
 		c.deleteInternetGateway,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteGatewayEndpoints, deleteMainRouteTable))
+		DoIf(deleteVPC && deleteVPCResources), Timeout(defaultTimeout), Dependencies(deleteGatewayEndpoints, deleteMainRouteTable))
 
 	deleteEgressOnlyInternetGateway := c.AddTask(g, "delete egress only internet gateway",
 		c.deleteEgressOnlyInternetGateway,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
+		DoIf(deleteVPC && deleteVPCResources), Timeout(defaultTimeout), Dependencies(deleteZones))
 
 	deleteDefaultSecurityGroup := c.AddTask(g, "delete default security group",
 		c.deleteDefaultSecurityGroup,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteGatewayEndpoints))
+		DoIf(deleteVPC && deleteVPCResources), Timeout(defaultTimeout), Dependencies(deleteGatewayEndpoints))
+
+	deleteFlowLogs := c.AddTask(g, "delete flow logs",
+		c.deleteFlowLogs,
+		DoIf(c.hasFlowLogs()), Timeout(defaultTimeout))
 
 	deleteVpc := c.AddTask(g, "delete VPC",
 		c.deleteVpc,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout),
-		Dependencies(deleteInternetGateway, deleteDefaultSecurityGroup, deleteNodesSecurityGroup, destroyLoadBalancersAndSecurityGroups, deleteEgressOnlyInternetGateway))
+		DoIf(deleteVPC && deleteVPCResources), Timeout(defaultTimeout),
+		Dependencies(deleteInternetGateway, deleteDefaultSecurityGroup, deleteNodesSecurityGroup, destroyLoadBalancersAndSecurityGroups, deleteEgressOnlyInternetGateway, deleteFlowLogs))
 
 	_ = c.AddTask(g, "delete DHCP options for VPC",
 		c.deleteDhcpOptions,
-		DoIf(deleteVPC && c.state.Get(IdentifierDHCPOptions) != nil), Timeout(defaultTimeout),
+		DoIf(deleteVPC && !retainVPC && c.state.Get(IdentifierDHCPOptions) != nil), Timeout(defaultTimeout),
 		Dependencies(deleteVpc))
 
+	_ = c.AddTask(g, "retain VPC",
+		c.retainVPC,
+		DoIf(retainVPC && c.hasVPC()), Timeout(defaultTimeout),
+		Dependencies(destroyLoadBalancersAndSecurityGroups, deleteEfs, deleteFSx, deleteFlowLogs))
+
 	return g
 }
 
@@ -195,6 +234,10 @@ func (c *FlowContext) deleteEgressOnlyInternetGateway(ctx context.Context) error
 }
 
 func (c *FlowContext) deleteGatewayEndpoints(ctx context.Context) error {
+	if !c.hasGatewayEndpoints() {
+		return nil
+	}
+
 	log := LogFromContext(ctx)
 	child := c.state.GetChild(ChildIdVPCEndpoints)
 	current, err := c.collectExistingVPCEndpoints(ctx)
@@ -237,6 +280,94 @@ func (c *FlowContext) deleteVpc(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) deleteFlowLogs(ctx context.Context) error {
+	log := LogFromContext(ctx)
+	if c.state.Get(IdentifierVpcFlowLog) != nil {
+		current, err := FindExisting(ctx, c.state.Get(IdentifierVpcFlowLog), c.commonTagsWithSuffix("flow-logs"),
+			c.client.GetFlowLog, c.client.FindFlowLogsByTags,
+			func(item *awsclient.FlowLog) bool {
+				return c.isVpcMatchingState(&item.ResourceId)
+			})
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			log.Info("deleting...", "FlowLogId", current.FlowLogId)
+			if err := c.client.DeleteFlowLog(ctx, current.FlowLogId); err != nil {
+				return err
+			}
+		}
+		c.state.Delete(IdentifierVpcFlowLog)
+	}
+	return c.deleteFlowLogsIAMRole(ctx)
+}
+
+func (c *FlowContext) deleteFlowLogsIAMRole(ctx context.Context) error {
+	if c.state.Get(NameFlowLogsIAMRole) == nil {
+		return nil
+	}
+	log := LogFromContext(ctx)
+	name := fmt.Sprintf("%s-flow-logs", c.namespace)
+	log.Info("deleting...", "RoleName", name)
+	if err := c.client.DeleteIAMRolePolicy(ctx, name, name); err != nil {
+		return err
+	}
+	if err := c.client.DeleteIAMRole(ctx, name); err != nil {
+		return err
+	}
+	c.state.Delete(NameFlowLogsIAMRole)
+	return nil
+}
+
+func (c *FlowContext) deleteNetworkACLs(ctx context.Context) error {
+	for _, spec := range c.networkACLSpecs() {
+		if err := c.deleteNetworkACL(ctx, spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteNetworkACL restores the default network ACL of the VPC for all subnets associated with the network ACL of the
+// given purpose and deletes it afterwards.
+func (c *FlowContext) deleteNetworkACL(ctx context.Context, spec networkACLSpec) error {
+	if c.state.Get(spec.stateKey) == nil {
+		return nil
+	}
+	log := LogFromContext(ctx)
+	current, err := FindExisting(ctx, c.state.Get(spec.stateKey), c.networkACLTags(spec),
+		c.client.GetNetworkAcl, c.client.FindNetworkAclsByTags,
+		func(item *awsclient.NetworkAcl) bool {
+			return c.isVpcMatchingState(item.VpcId)
+		})
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		if len(current.Associations) > 0 {
+			defaultACL, err := c.client.FindDefaultNetworkAclByVpcId(ctx, *c.state.Get(IdentifierVPC))
+			if err != nil {
+				return err
+			}
+			if defaultACL == nil {
+				return fmt.Errorf("default network ACL of VPC %s not found", *c.state.Get(IdentifierVPC))
+			}
+			for _, assoc := range current.Associations {
+				log.Info("restoring default network ACL association", "SubnetId", assoc.SubnetId)
+				if _, err := c.client.ReplaceNetworkAclAssociation(ctx, assoc.NetworkAclAssociationId, defaultACL.NetworkAclId); err != nil {
+					return err
+				}
+			}
+		}
+		log.Info("deleting...", "NetworkAclId", current.NetworkAclId)
+		if err := c.client.DeleteNetworkAcl(ctx, current.NetworkAclId); err != nil {
+			return err
+		}
+	}
+	c.state.Delete(spec.stateKey)
+	return nil
+}
+
 func (c *FlowContext) deleteDhcpOptions(ctx context.Context) error {
 	if c.state.Get(IdentifierDHCPOptions) == nil {
 		return nil
@@ -400,19 +531,38 @@ func (c *FlowContext) deleteEfs(ctx context.Context) error {
 	if managedEfs != nil && managedEfs.FileSystemId != nil {
 		efsIDs = append(efsIDs, managedEfs.FileSystemId)
 	}
+	retainEfs := managedEfs != nil && managedEfs.FileSystemId != nil && c.retains(awsapi.RetainedResourceElasticFileSystem)
 	for _, efsID := range efsIDs {
-		if err := c.deleteEfsMountTargets(ctx, efsID); err != nil {
-			return fmt.Errorf("failed to delete EFS mount targets: %w", err)
+		// a retained file system keeps its access points and, if the VPC is retained as well, its mount targets
+		retained := retainEfs && *efsID == *managedEfs.FileSystemId
+		if !retained || !c.retains(awsapi.RetainedResourceVPC) {
+			if err := c.deleteEfsMountTargets(ctx, efsID); err != nil {
+				return fmt.Errorf("failed to delete EFS mount targets: %w", err)
+			}
+		}
+		if !retained {
+			if err := c.deleteEfsAccessPoints(ctx, efsID); err != nil {
+				return fmt.Errorf("failed to delete EFS access points: %w", err)
+			}
 		}
 	}
-	// delete mounts from state
+	// delete mounts and access points from state
 	for _, mountKeys := range c.state.GetChild(ChildEfsMountTargets).Keys() {
 		c.state.Delete(mountKeys)
 	}
 	c.state.Delete(ChildEfsMountTargets)
+	accessPoints := c.state.GetChild(ChildEfsAccessPoints)
+	for _, name := range accessPoints.Keys() {
+		accessPoints.Delete(name)
+	}
 
 	// delete the EFS file system only if it was created by Gardener.
-	if managedEfs != nil && managedEfs.FileSystemId != nil {
+	if retainEfs {
+		if err := c.retainFileSystem(ctx, *managedEfs.FileSystemId); err != nil {
+			return err
+		}
+		c.state.Delete(IdentifierManagedEfsID)
+	} else if managedEfs != nil && managedEfs.FileSystemId != nil {
 		log.Info("deleting...", "efsFileSystem", *managedEfs.FileSystemId)
 		err := c.client.DeleteFileSystem(ctx, &efs.DeleteFileSystemInput{
 			FileSystemId: managedEfs.FileSystemId,
@@ -426,6 +576,26 @@ func (c *FlowContext) deleteEfs(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) deleteEfsAccessPoints(ctx context.Context, efsID *string) error {
+	log := LogFromContext(ctx)
+
+	owned, err := c.findOwnedEfsAccessPoints(ctx, efsID)
+	if err != nil {
+		return err
+	}
+	for _, item := range owned {
+		log.Info("deleting...", "AccessPointId", *item.AccessPointId)
+		if err := c.client.DeleteAccessPoint(ctx, &efs.DeleteAccessPointInput{AccessPointId: item.AccessPointId}); err != nil {
+			return err
+		}
+	}
+	child := c.state.GetChild(ChildEfsAccessPoints)
+	for _, name := range child.Keys() {
+		child.Delete(name)
+	}
+	return nil
+}
+
 func (c *FlowContext) deleteEfsMountTargets(ctx context.Context, efsID *string) error {
 	log := LogFromContext(ctx)
 
@@ -468,3 +638,43 @@ func (c *FlowContext) deleteEfsMountTargets(ctx context.Context, efsID *string)
 
 	return nil
 }
+
+func (c *FlowContext) deleteFSx(ctx context.Context) error {
+	log := LogFromContext(ctx)
+
+	current, err := FindExisting(ctx, c.state.Get(IdentifierManagedFSxID), c.commonTagsWithSuffix("fsx"),
+		c.client.GetFSxFileSystem, c.client.FindFSxFileSystemsByTags)
+	if err != nil {
+		return fmt.Errorf("failed to find FSx file system: %w", err)
+	}
+	if current != nil {
+		log.Info("deleting...", "FileSystemId", *current.FileSystemId)
+		if err := c.client.DeleteFSxFileSystem(ctx, *current.FileSystemId); err != nil {
+			return err
+		}
+	}
+	c.state.Delete(IdentifierManagedFSxID)
+	c.state.Delete(IdentifierFSxDNSName)
+	c.state.Delete(IdentifierFSxMountName)
+
+	if c.state.Get(IdentifierFSxSecurityGroup) == nil {
+		return nil
+	}
+	groupName := fmt.Sprintf("%s-fsx", c.namespace)
+	group, err := FindExisting(ctx, c.state.Get(IdentifierFSxSecurityGroup), c.commonTagsWithSuffix("fsx"),
+		c.client.GetSecurityGroup, c.client.FindSecurityGroupsByTags,
+		func(item *awsclient.SecurityGroup) bool {
+			return item.GroupName == groupName && c.isVpcMatchingState(item.VpcId)
+		})
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		log.Info("deleting...", "GroupId", group.GroupId)
+		if err := c.client.DeleteSecurityGroup(ctx, group.GroupId); err != nil {
+			return err
+		}
+	}
+	c.state.Delete(IdentifierFSxSecurityGroup)
+	return nil
+}
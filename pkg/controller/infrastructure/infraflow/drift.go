@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/utils/ptr"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// DriftType is the type of drift detected for an infrastructure resource.
+type DriftType string
+
+const (
+	// DriftTypeMissing is used if a resource recorded in the flow state does not exist anymore.
+	DriftTypeMissing DriftType = "Missing"
+	// DriftTypeModified is used if a resource recorded in the flow state exists, but was modified out-of-band.
+	DriftTypeModified DriftType = "Modified"
+)
+
+// Drift describes an infrastructure resource which deviates from the flow state.
+type Drift struct {
+	// Type is the type of the drift.
+	Type DriftType
+	// Resource is the kind of the resource, e.g. "NAT gateway".
+	Resource string
+	// ID is the id of the resource as recorded in the flow state.
+	ID string
+	// Zone is the zone of the resource, empty for zone-independent resources.
+	Zone string
+	// Message describes the drift.
+	Message string
+}
+
+// String returns a human-readable description of the drift.
+func (d Drift) String() string {
+	resource := fmt.Sprintf("%s %s", d.Resource, d.ID)
+	if d.Zone != "" {
+		resource += fmt.Sprintf(" in zone %s", d.Zone)
+	}
+	if d.Type == DriftTypeMissing {
+		return resource + " is missing"
+	}
+	return fmt.Sprintf("%s was modified: %s", resource, d.Message)
+}
+
+// DetectDrift reads the resources recorded in the given flow state and returns those which are missing or were modified
+// out-of-band. Only the resources relevant for the connectivity of the nodes are checked, i.e. the VPC, the nodes
+// security group, the route tables, the NAT gateways and the elastic IPs managed by Gardener. The configuration is needed
+// to determine the NAT gateway the route table of each zone must target.
+func DetectDrift(ctx context.Context, client awsclient.Interface, config *awsapi.InfrastructureConfig, state shared.Whiteboard) ([]Drift, error) {
+	d := &driftDetector{client: client, config: config, state: state}
+	for _, check := range []func(context.Context) error{
+		d.checkVPC,
+		d.checkNodesSecurityGroup,
+		d.checkMainRouteTable,
+		d.checkZones,
+	} {
+		if err := check(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return d.drifts, nil
+}
+
+type driftDetector struct {
+	client awsclient.Interface
+	config *awsapi.InfrastructureConfig
+	state  shared.Whiteboard
+	drifts []Drift
+}
+
+func (d *driftDetector) missing(resource, id, zone string) {
+	d.drifts = append(d.drifts, Drift{Type: DriftTypeMissing, Resource: resource, ID: id, Zone: zone})
+}
+
+func (d *driftDetector) modified(resource, id, zone, format string, args ...any) {
+	d.drifts = append(d.drifts, Drift{Type: DriftTypeModified, Resource: resource, ID: id, Zone: zone, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *driftDetector) checkVPC(ctx context.Context) error {
+	vpcID := d.state.Get(IdentifierVPC)
+	if vpcID == nil {
+		return nil
+	}
+	vpc, err := d.client.GetVpc(ctx, *vpcID)
+	if err != nil {
+		return fmt.Errorf("failed to get VPC %s: %w", *vpcID, err)
+	}
+	if vpc == nil {
+		d.missing("VPC", *vpcID, "")
+	}
+	return nil
+}
+
+func (d *driftDetector) checkNodesSecurityGroup(ctx context.Context) error {
+	groupID := d.state.Get(IdentifierNodesSecurityGroup)
+	if groupID == nil {
+		return nil
+	}
+	group, err := d.client.GetSecurityGroup(ctx, *groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get security group %s: %w", *groupID, err)
+	}
+	if group == nil {
+		d.missing("security group", *groupID, "")
+		return nil
+	}
+	if vpcID := d.state.Get(IdentifierVPC); vpcID != nil && ptr.Deref(group.VpcId, "") != *vpcID {
+		d.modified("security group", *groupID, "", "belongs to VPC %s instead of %s", ptr.Deref(group.VpcId, ""), *vpcID)
+	}
+	return nil
+}
+
+func (d *driftDetector) checkMainRouteTable(ctx context.Context) error {
+	routeTableID := d.state.Get(IdentifierMainRouteTable)
+	if routeTableID == nil {
+		return nil
+	}
+	routeTable, err := d.client.GetRouteTable(ctx, *routeTableID)
+	if err != nil {
+		return fmt.Errorf("failed to get route table %s: %w", *routeTableID, err)
+	}
+	if routeTable == nil {
+		d.missing("route table", *routeTableID, "")
+		return nil
+	}
+	if gatewayID := d.state.Get(IdentifierInternetGateway); gatewayID != nil {
+		route := findIPv4DefaultRoute(routeTable)
+		if route == nil || ptr.Deref(route.GatewayId, "") != *gatewayID {
+			d.modified("route table", *routeTableID, "", "default route does not target internet gateway %s", *gatewayID)
+		}
+	}
+	return nil
+}
+
+func (d *driftDetector) checkZones(ctx context.Context) error {
+	zones := d.state.GetChild(ChildIdZones)
+	for _, zoneName := range zones.GetChildrenKeys() {
+		if err := d.checkZone(ctx, zoneName, zones.GetChild(zoneName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *driftDetector) checkZone(ctx context.Context, zoneName string, zone shared.Whiteboard) error {
+	if allocationID := zone.Get(IdentifierManagedZoneNATGWElasticIP); allocationID != nil {
+		eip, err := d.client.GetElasticIP(ctx, *allocationID)
+		if err != nil {
+			return fmt.Errorf("failed to get elastic IP %s: %w", *allocationID, err)
+		}
+		if eip == nil {
+			d.missing("elastic IP", *allocationID, zoneName)
+		}
+	}
+
+	if natGatewayID := zone.Get(IdentifierZoneNATGateway); natGatewayID != nil {
+		natGateway, err := d.client.GetNATGateway(ctx, *natGatewayID)
+		if err != nil {
+			return fmt.Errorf("failed to get NAT gateway %s: %w", *natGatewayID, err)
+		}
+		switch {
+		case natGateway == nil:
+			d.missing("NAT gateway", *natGatewayID, zoneName)
+		case !strings.EqualFold(natGateway.State, string(ec2types.NatGatewayStateAvailable)):
+			d.modified("NAT gateway", *natGatewayID, zoneName, "state is %s", natGateway.State)
+		default:
+			if allocationID := zone.Get(IdentifierManagedZoneNATGWElasticIP); allocationID != nil && natGateway.EIPAllocationId != *allocationID {
+				d.modified("NAT gateway", *natGatewayID, zoneName, "uses elastic IP %s instead of %s", natGateway.EIPAllocationId, *allocationID)
+			}
+		}
+	}
+
+	routeTableID := zone.Get(IdentifierZoneRouteTable)
+	if routeTableID == nil {
+		return nil
+	}
+	routeTable, err := d.client.GetRouteTable(ctx, *routeTableID)
+	if err != nil {
+		return fmt.Errorf("failed to get route table %s: %w", *routeTableID, err)
+	}
+	if routeTable == nil {
+		d.missing("route table", *routeTableID, zoneName)
+		return nil
+	}
+	// the private subnets of all zones use the NAT gateway of a single zone in mode SingleNATGateway
+	if natZone := helper.NATGatewayZone(d.config, zoneName); natZone != "" {
+		if natGatewayID := d.state.GetChild(ChildIdZones).GetChild(natZone).Get(IdentifierZoneNATGateway); natGatewayID != nil {
+			route := findIPv4DefaultRoute(routeTable)
+			if route == nil || ptr.Deref(route.NatGatewayId, "") != *natGatewayID {
+				d.modified("route table", *routeTableID, zoneName, "default route does not target NAT gateway %s", *natGatewayID)
+			}
+		}
+	}
+	if subnetID := zone.Get(IdentifierZoneSubnetWorkers); subnetID != nil && !isAssociatedWithSubnet(routeTable, *subnetID) {
+		d.modified("route table", *routeTableID, zoneName, "not associated with workers subnet %s", *subnetID)
+	}
+	return nil
+}
+
+func findIPv4DefaultRoute(routeTable *awsclient.RouteTable) *awsclient.Route {
+	for _, route := range routeTable.Routes {
+		if ptr.Deref(route.DestinationCidrBlock, "") == allIPv4 {
+			return route
+		}
+	}
+	return nil
+}
+
+func isAssociatedWithSubnet(routeTable *awsclient.RouteTable, subnetID string) bool {
+	for _, association := range routeTable.Associations {
+		if ptr.Deref(association.SubnetId, "") == subnetID {
+			return true
+		}
+	}
+	return false
+}
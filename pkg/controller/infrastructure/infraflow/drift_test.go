@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("DetectDrift", func() {
+	const zone = "eu-west-1a"
+
+	var (
+		ctx       = context.Background()
+		ctrl      *gomock.Controller
+		awsClient *mockawsclient.MockInterface
+		config    *awsapi.InfrastructureConfig
+		state     shared.Whiteboard
+
+		natGateway     *awsclient.NATGateway
+		zoneRouteTable *awsclient.RouteTable
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+
+		config = &awsapi.InfrastructureConfig{Networks: awsapi.Networks{Zones: []awsapi.Zone{{Name: zone}}}}
+		state = shared.NewWhiteboard()
+		state.ImportFromFlatMap(shared.FlatMap{
+			IdentifierVPC:                "vpc-1",
+			IdentifierInternetGateway:    "igw-1",
+			IdentifierNodesSecurityGroup: "sg-1",
+			IdentifierMainRouteTable:     "rtb-main",
+			ChildIdZones + shared.Separator + zone + shared.Separator + IdentifierZoneSubnetWorkers:         "subnet-1",
+			ChildIdZones + shared.Separator + zone + shared.Separator + IdentifierManagedZoneNATGWElasticIP: "eipalloc-1",
+			ChildIdZones + shared.Separator + zone + shared.Separator + IdentifierZoneNATGateway:            "nat-1",
+			ChildIdZones + shared.Separator + zone + shared.Separator + IdentifierZoneRouteTable:            "rtb-1",
+		})
+
+		natGateway = &awsclient.NATGateway{NATGatewayId: "nat-1", EIPAllocationId: "eipalloc-1", State: "available"}
+		zoneRouteTable = &awsclient.RouteTable{
+			RouteTableId: "rtb-1",
+			Routes:       []*awsclient.Route{{DestinationCidrBlock: ptr.To("0.0.0.0/0"), NatGatewayId: ptr.To("nat-1")}},
+			Associations: []*awsclient.RouteTableAssociation{{SubnetId: ptr.To("subnet-1")}},
+		}
+
+		awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(&awsclient.VPC{VpcId: "vpc-1"}, nil).AnyTimes()
+		awsClient.EXPECT().GetSecurityGroup(ctx, "sg-1").Return(&awsclient.SecurityGroup{GroupId: "sg-1", VpcId: ptr.To("vpc-1")}, nil).AnyTimes()
+		awsClient.EXPECT().GetRouteTable(ctx, "rtb-main").Return(&awsclient.RouteTable{
+			RouteTableId: "rtb-main",
+			Routes:       []*awsclient.Route{{DestinationCidrBlock: ptr.To("0.0.0.0/0"), GatewayId: ptr.To("igw-1")}},
+		}, nil).AnyTimes()
+		awsClient.EXPECT().GetElasticIP(ctx, "eipalloc-1").Return(&awsclient.ElasticIP{AllocationId: "eipalloc-1"}, nil).AnyTimes()
+		awsClient.EXPECT().GetNATGateway(ctx, "nat-1").DoAndReturn(func(context.Context, string) (*awsclient.NATGateway, error) {
+			return natGateway, nil
+		}).AnyTimes()
+		awsClient.EXPECT().GetRouteTable(ctx, "rtb-1").DoAndReturn(func(context.Context, string) (*awsclient.RouteTable, error) {
+			return zoneRouteTable, nil
+		}).AnyTimes()
+	})
+
+	It("should not report any drift if all resources match the state", func() {
+		Expect(DetectDrift(ctx, awsClient, config, state)).To(BeEmpty())
+	})
+
+	It("should report a missing NAT gateway", func() {
+		natGateway = nil
+
+		drifts, err := DetectDrift(ctx, awsClient, config, state)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(ConsistOf(Drift{Type: DriftTypeMissing, Resource: "NAT gateway", ID: "nat-1", Zone: zone}))
+		Expect(drifts[0].String()).To(Equal("NAT gateway nat-1 in zone eu-west-1a is missing"))
+	})
+
+	It("should report a NAT gateway which is not available", func() {
+		natGateway.State = "failed"
+
+		drifts, err := DetectDrift(ctx, awsClient, config, state)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(ConsistOf(Drift{Type: DriftTypeModified, Resource: "NAT gateway", ID: "nat-1", Zone: zone, Message: "state is failed"}))
+	})
+
+	It("should report an edited route table", func() {
+		zoneRouteTable.Routes = nil
+		zoneRouteTable.Associations = nil
+
+		drifts, err := DetectDrift(ctx, awsClient, config, state)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(ConsistOf(
+			Drift{Type: DriftTypeModified, Resource: "route table", ID: "rtb-1", Zone: zone, Message: "default route does not target NAT gateway nat-1"},
+			Drift{Type: DriftTypeModified, Resource: "route table", ID: "rtb-1", Zone: zone, Message: "not associated with workers subnet subnet-1"},
+		))
+	})
+
+	It("should check the route tables against the shared NAT gateway in mode SingleNATGateway", func() {
+		const otherZone = "eu-west-1b"
+		config.Networks.Zones = append(config.Networks.Zones, awsapi.Zone{Name: otherZone})
+		config.Networks.Egress = &awsapi.Egress{Mode: awsapi.EgressModeSingleNATGateway}
+		state.GetChild(ChildIdZones).GetChild(otherZone).Set(IdentifierZoneSubnetWorkers, "subnet-2")
+		state.GetChild(ChildIdZones).GetChild(otherZone).Set(IdentifierZoneRouteTable, "rtb-2")
+
+		otherZoneRouteTable := &awsclient.RouteTable{
+			RouteTableId: "rtb-2",
+			Routes:       []*awsclient.Route{{DestinationCidrBlock: ptr.To("0.0.0.0/0"), NatGatewayId: ptr.To("nat-1")}},
+			Associations: []*awsclient.RouteTableAssociation{{SubnetId: ptr.To("subnet-2")}},
+		}
+		awsClient.EXPECT().GetRouteTable(ctx, "rtb-2").DoAndReturn(func(context.Context, string) (*awsclient.RouteTable, error) {
+			return otherZoneRouteTable, nil
+		}).AnyTimes()
+
+		Expect(DetectDrift(ctx, awsClient, config, state)).To(BeEmpty())
+
+		otherZoneRouteTable.Routes = nil
+		drifts, err := DetectDrift(ctx, awsClient, config, state)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(ConsistOf(Drift{Type: DriftTypeModified, Resource: "route table", ID: "rtb-2", Zone: otherZone, Message: "default route does not target NAT gateway nat-1"}))
+	})
+
+	It("should return an error if a resource cannot be read", func() {
+		state.Delete(IdentifierVPC)
+		awsClient.EXPECT().GetSecurityGroup(ctx, "sg-2").Return(nil, errors.New("UnauthorizedOperation"))
+		state.Set(IdentifierNodesSecurityGroup, "sg-2")
+
+		_, err := DetectDrift(ctx, awsClient, config, state)
+		Expect(err).To(MatchError(ContainSubstring("failed to get security group sg-2: UnauthorizedOperation")))
+	})
+})
@@ -0,0 +1,417 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// orphanSlot is an entry of the flow state which may be filled with an orphaned resource.
+type orphanSlot[T any] struct {
+	// description names the slot in log messages and errors, e.g. "NAT gateway of zone eu-west-1a".
+	description string
+	board       Whiteboard
+	key         string
+	match       func(item *T) bool
+}
+
+// discoverOrphans adopts the EC2 resources of the cluster which are missing in the flow state, e.g. because a previous
+// reconciliation was interrupted between creating a resource and persisting the state. The resources are found by the
+// cluster tag and assigned to an empty entry of the state if they match its attributes. Resources which are already
+// recorded in the state are never adopted twice. If several resources match an entry, an error with their IDs is
+// returned instead of creating yet another one. Once the infrastructure has been created successfully, the state is
+// complete and no resources are discovered anymore.
+func (c *FlowContext) discoverOrphans(ctx context.Context) error {
+	if !c.mightHaveOrphans() {
+		return nil
+	}
+
+	known := sets.New[string]()
+	for _, value := range c.state.ExportAsFlatMap() {
+		// some entries contain a comma separated list of ids, e.g. the secondary elastic IPs of a zone
+		known.Insert(strings.Split(value, ",")...)
+	}
+	tags := c.clusterTags()
+	hasName := func(itemTags awsclient.Tags, suffix string) bool {
+		name := c.namespace
+		if suffix != "" {
+			name = fmt.Sprintf("%s-%s", c.namespace, suffix)
+		}
+		return itemTags[TagKeyName] == name
+	}
+	managedVPC := c.config.Networks.VPC.ID == nil
+
+	var errs []error
+	if managedVPC {
+		errs = append(errs, adoptOrphans(c, known,
+			func() ([]*awsclient.VPC, error) { return c.client.FindVpcsByTags(ctx, tags) },
+			func(item *awsclient.VPC) string { return item.VpcId },
+			orphanSlot[awsclient.VPC]{"VPC", c.state, IdentifierVPC, func(item *awsclient.VPC) bool {
+				return item.CidrBlock == ptr.Deref(c.config.Networks.VPC.CIDR, "")
+			}},
+		))
+		errs = append(errs, adoptOrphans(c, known,
+			func() ([]*awsclient.DhcpOptions, error) { return c.client.FindVpcDhcpOptionsByTags(ctx, tags) },
+			func(item *awsclient.DhcpOptions) string { return item.DhcpOptionsId },
+			orphanSlot[awsclient.DhcpOptions]{"DHCP options", c.state, IdentifierDHCPOptions, func(item *awsclient.DhcpOptions) bool {
+				return hasName(item.Tags, "")
+			}},
+		))
+	}
+
+	vpcID := c.state.Get(IdentifierVPC)
+	if !managedVPC {
+		vpcID = c.config.Networks.VPC.ID
+	}
+	if vpcID == nil {
+		return errors.Join(errs...)
+	}
+	inVPC := func(id *string) bool {
+		return ptr.Deref(id, "") == *vpcID
+	}
+
+	if managedVPC {
+		errs = append(errs, adoptOrphans(c, known,
+			func() ([]*awsclient.InternetGateway, error) { return c.client.FindInternetGatewaysByTags(ctx, tags) },
+			func(item *awsclient.InternetGateway) string { return item.InternetGatewayId },
+			orphanSlot[awsclient.InternetGateway]{"internet gateway", c.state, IdentifierInternetGateway, func(item *awsclient.InternetGateway) bool {
+				return inVPC(item.VpcId) || (item.VpcId == nil && hasName(item.Tags, ""))
+			}},
+		))
+	}
+	if managedVPC && containsIPv6(c.getIpFamilies()) {
+		errs = append(errs, adoptOrphans(c, known,
+			func() ([]*awsclient.EgressOnlyInternetGateway, error) {
+				return c.client.FindEgressOnlyInternetGatewaysByTags(ctx, tags)
+			},
+			func(item *awsclient.EgressOnlyInternetGateway) string { return item.EgressOnlyInternetGatewayId },
+			orphanSlot[awsclient.EgressOnlyInternetGateway]{"egress-only internet gateway", c.state, IdentifierEgressOnlyInternetGateway, func(item *awsclient.EgressOnlyInternetGateway) bool {
+				return inVPC(item.VpcId) && hasName(item.Tags, "")
+			}},
+		))
+	}
+
+	securityGroupSlots := []orphanSlot[awsclient.SecurityGroup]{
+		{"nodes security group", c.state, IdentifierNodesSecurityGroup, func(item *awsclient.SecurityGroup) bool {
+			return inVPC(item.VpcId) && item.GroupName == fmt.Sprintf("%s-nodes", c.namespace)
+		}},
+	}
+	if c.isFSxEnabled() {
+		securityGroupSlots = append(securityGroupSlots, orphanSlot[awsclient.SecurityGroup]{
+			"FSx security group", c.state, IdentifierFSxSecurityGroup, func(item *awsclient.SecurityGroup) bool {
+				return inVPC(item.VpcId) && item.GroupName == fmt.Sprintf("%s-fsx", c.namespace)
+			},
+		})
+	}
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*awsclient.SecurityGroup, error) { return c.client.FindSecurityGroupsByTags(ctx, tags) },
+		func(item *awsclient.SecurityGroup) string { return item.GroupId },
+		securityGroupSlots...,
+	))
+
+	var endpointSlots []orphanSlot[awsclient.VpcEndpoint]
+	for _, endpoint := range c.config.Networks.VPC.GatewayEndpoints {
+		endpointSlots = append(endpointSlots, orphanSlot[awsclient.VpcEndpoint]{
+			"gateway endpoint " + endpoint, c.state.GetChild(ChildIdVPCEndpoints), endpoint,
+			func(item *awsclient.VpcEndpoint) bool {
+				return inVPC(item.VpcId) && item.ServiceName == c.vpcEndpointServiceNamePrefix()+endpoint
+			},
+		})
+	}
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*awsclient.VpcEndpoint, error) {
+			return c.client.FindVpcEndpoints(ctx, awsclient.WithFilters().WithVpcId(*vpcID).WithTags(tags).Build())
+		},
+		func(item *awsclient.VpcEndpoint) string { return item.VpcEndpointId },
+		endpointSlots...,
+	))
+
+	var networkACLSlots []orphanSlot[awsclient.NetworkAcl]
+	for _, spec := range c.networkACLSpecs() {
+		if spec.config == nil {
+			continue
+		}
+		networkACLSlots = append(networkACLSlots, orphanSlot[awsclient.NetworkAcl]{
+			spec.purpose + " network ACL", c.state, spec.stateKey,
+			func(item *awsclient.NetworkAcl) bool {
+				return inVPC(item.VpcId) && hasName(item.Tags, "nacl-"+spec.purpose)
+			},
+		})
+	}
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*awsclient.NetworkAcl, error) { return c.client.FindNetworkAclsByTags(ctx, tags) },
+		func(item *awsclient.NetworkAcl) string { return item.NetworkAclId },
+		networkACLSlots...,
+	))
+
+	var (
+		routeTableSlots []orphanSlot[awsclient.RouteTable]
+		subnetSlots     []orphanSlot[awsclient.Subnet]
+		natGatewaySlots []orphanSlot[awsclient.NATGateway]
+		processedZones  = sets.New[string]()
+	)
+	if managedVPC {
+		routeTableSlots = append(routeTableSlots, orphanSlot[awsclient.RouteTable]{
+			"main route table", c.state, IdentifierMainRouteTable, func(item *awsclient.RouteTable) bool {
+				return inVPC(item.VpcId) && hasName(item.Tags, "")
+			},
+		})
+	}
+	for _, zone := range c.config.Networks.Zones {
+		if processedZones.Has(zone.Name) {
+			continue
+		}
+		processedZones.Insert(zone.Name)
+
+		child := c.getSubnetZoneChild(zone.Name)
+		helper := c.zoneSuffixHelpers(zone.Name)
+		// the workers subnets of IPv6 only shoots are IPv6 native, i.e. they have no IPv4 CIDR
+		workersCIDR := zone.Workers
+		if !containsIPv4(c.getIpFamilies()) {
+			workersCIDR = ""
+		}
+		for _, subnet := range []struct {
+			purpose, key, suffix, cidr string
+		}{
+			{"workers", IdentifierZoneSubnetWorkers, helper.GetSuffixSubnetWorkers(), workersCIDR},
+			{"internal", IdentifierZoneSubnetPrivate, helper.GetSuffixSubnetPrivate(), zone.Internal},
+			{"public", IdentifierZoneSubnetPublic, helper.GetSuffixSubnetPublic(), zone.Public},
+		} {
+			subnetSlots = append(subnetSlots, orphanSlot[awsclient.Subnet]{
+				fmt.Sprintf("%s subnet of zone %s", subnet.purpose, zone.Name), child, subnet.key,
+				func(item *awsclient.Subnet) bool {
+					return item.AvailabilityZone == zone.Name && item.CidrBlock == subnet.cidr && hasName(item.Tags, subnet.suffix)
+				},
+			})
+		}
+		routeTableSlots = append(routeTableSlots, orphanSlot[awsclient.RouteTable]{
+			"route table of zone " + zone.Name, child, IdentifierZoneRouteTable,
+			func(item *awsclient.RouteTable) bool {
+				return inVPC(item.VpcId) && hasName(item.Tags, fmt.Sprintf("private-%s", zone.Name))
+			},
+		})
+		if c.hasNATGateway(zone.Name) {
+			natGatewaySlots = append(natGatewaySlots, orphanSlot[awsclient.NATGateway]{
+				"NAT gateway of zone " + zone.Name, child, IdentifierZoneNATGateway,
+				func(item *awsclient.NATGateway) bool {
+					if isNATGatewayDeletingOrFailed(item) || !inVPC(item.VpcId) {
+						return false
+					}
+					if public := child.Get(IdentifierZoneSubnetPublic); public != nil {
+						return item.SubnetId == *public
+					}
+					return hasName(item.Tags, helper.GetSuffixNATGateway())
+				},
+			})
+		}
+	}
+
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*awsclient.Subnet, error) {
+			return c.client.FindSubnets(ctx, awsclient.WithFilters().WithVpcId(*vpcID).WithTags(tags).Build())
+		},
+		func(item *awsclient.Subnet) string { return item.SubnetId },
+		subnetSlots...,
+	))
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*awsclient.RouteTable, error) { return c.client.FindRouteTablesByTags(ctx, tags) },
+		func(item *awsclient.RouteTable) string { return item.RouteTableId },
+		routeTableSlots...,
+	))
+	// NAT gateways are matched by the public subnets adopted above
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*awsclient.NATGateway, error) { return c.client.FindNATGatewaysByTags(ctx, tags) },
+		func(item *awsclient.NATGateway) string { return item.NATGatewayId },
+		natGatewaySlots...,
+	))
+
+	// elastic IPs are matched by the NAT gateways adopted above, as the name tag depends on the zone suffix
+	var elasticIPSlots []orphanSlot[awsclient.ElasticIP]
+	for _, zone := range c.config.Networks.Zones {
+		if !c.hasNATGateway(zone.Name) || zone.ElasticIPAllocationID != nil {
+			continue
+		}
+		child := c.getSubnetZoneChild(zone.Name)
+		allocationID, err := c.natGatewayAllocationID(ctx, child)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		helper := c.zoneSuffixHelpers(zone.Name)
+		elasticIPSlots = append(elasticIPSlots, orphanSlot[awsclient.ElasticIP]{
+			"elastic IP of zone " + zone.Name, child, IdentifierManagedZoneNATGWElasticIP,
+			func(item *awsclient.ElasticIP) bool {
+				if allocationID != "" {
+					return item.AllocationId == allocationID
+				}
+				return item.AssociationID == nil && hasName(item.Tags, helper.GetSuffixElasticIP())
+			},
+		})
+	}
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*awsclient.ElasticIP, error) { return c.client.FindElasticIPsByTags(ctx, tags) },
+		func(item *awsclient.ElasticIP) string { return item.AllocationId },
+		elasticIPSlots...,
+	))
+	errs = append(errs, c.adoptSecondaryElasticIPs(ctx, known, hasName))
+
+	var efsSlots []orphanSlot[efstypes.FileSystemDescription]
+	if c.isCsiEfsEnabled() && c.config.ElasticFileSystem.ID == nil {
+		efsSlots = append(efsSlots, orphanSlot[efstypes.FileSystemDescription]{
+			"EFS file system", c.state, IdentifierManagedEfsID, func(item *efstypes.FileSystemDescription) bool {
+				return ptr.Deref(item.CreationToken, "") == c.shootUUID && item.LifeCycleState != efstypes.LifeCycleStateDeleting &&
+					item.LifeCycleState != efstypes.LifeCycleStateDeleted
+			},
+		})
+	}
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*efstypes.FileSystemDescription, error) { return c.client.FindFileSystemsByTags(ctx, tags) },
+		func(item *efstypes.FileSystemDescription) string { return ptr.Deref(item.FileSystemId, "") },
+		efsSlots...,
+	))
+
+	var fsxSlots []orphanSlot[fsxtypes.FileSystem]
+	if c.isFSxEnabled() {
+		fsxSlots = append(fsxSlots, orphanSlot[fsxtypes.FileSystem]{
+			"FSx file system", c.state, IdentifierManagedFSxID, func(item *fsxtypes.FileSystem) bool {
+				return c.commonTagsWithSuffix("fsx").ContainFSxTags(item.Tags) && item.Lifecycle != fsxtypes.FileSystemLifecycleDeleting
+			},
+		})
+	}
+	errs = append(errs, adoptOrphans(c, known,
+		func() ([]*fsxtypes.FileSystem, error) { return c.client.FindFSxFileSystemsByTags(ctx, tags) },
+		func(item *fsxtypes.FileSystem) string { return ptr.Deref(item.FileSystemId, "") },
+		fsxSlots...,
+	))
+
+	return errors.Join(errs...)
+}
+
+// mightHaveOrphans returns true if the state might miss resources, i.e. if it is empty or the infrastructure has not been
+// created successfully yet.
+func (c *FlowContext) mightHaveOrphans() bool {
+	if len(c.state.ExportAsFlatMap()) == 0 {
+		return true
+	}
+	lastOperation := c.infra.Status.LastOperation
+	return lastOperation == nil ||
+		(lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate && lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded)
+}
+
+// adoptSecondaryElasticIPs appends the orphaned secondary elastic IPs to the managed secondary elastic IPs of the zones.
+// They are recorded as list in the order of their index, i.e. the orphan of the next index is adopted until one is
+// missing.
+func (c *FlowContext) adoptSecondaryElasticIPs(ctx context.Context, known sets.Set[string], hasName func(awsclient.Tags, string) bool) error {
+	var (
+		errs       []error
+		elasticIPs []*awsclient.ElasticIP
+		listed     bool
+	)
+	find := func() ([]*awsclient.ElasticIP, error) {
+		if listed {
+			return elasticIPs, nil
+		}
+		var err error
+		elasticIPs, err = c.client.FindElasticIPsByTags(ctx, c.clusterTags())
+		listed = err == nil
+		return elasticIPs, err
+	}
+	for _, zone := range c.config.Networks.Zones {
+		if !c.hasNATGateway(zone.Name) || zone.SecondaryElasticIPs == nil {
+			continue
+		}
+		child := c.getSubnetZoneChild(zone.Name)
+		helper := c.zoneSuffixHelpers(zone.Name)
+		managed := ManagedSecondaryElasticIPs(child)
+		for index := len(managed); index < int(ptr.Deref(zone.SecondaryElasticIPs.Count, 0)); index++ {
+			// the slot is filled in a scratch board as the state contains a single entry for all secondary elastic IPs
+			board := NewWhiteboard()
+			suffix := helper.GetSuffixSecondaryElasticIP(index + 1)
+			if err := adoptOrphans(c, known, find,
+				func(item *awsclient.ElasticIP) string { return item.AllocationId },
+				orphanSlot[awsclient.ElasticIP]{
+					fmt.Sprintf("secondary elastic IP %d of zone %s", index+1, zone.Name), board, IdentifierManagedZoneNATGWSecondaryElasticIPs,
+					func(item *awsclient.ElasticIP) bool { return hasName(item.Tags, suffix) },
+				},
+			); err != nil {
+				errs = append(errs, err)
+				break
+			}
+			allocationID := board.Get(IdentifierManagedZoneNATGWSecondaryElasticIPs)
+			if allocationID == nil {
+				break
+			}
+			managed = append(managed, *allocationID)
+			child.Set(IdentifierManagedZoneNATGWSecondaryElasticIPs, strings.Join(managed, ","))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// natGatewayAllocationID returns the allocation ID of the primary elastic IP of the NAT gateway recorded in the given
+// zone state or an empty string if there is none.
+func (c *FlowContext) natGatewayAllocationID(ctx context.Context, child Whiteboard) (string, error) {
+	id := ptr.Deref(child.Get(IdentifierZoneNATGateway), "")
+	if id == "" {
+		return "", nil
+	}
+	gateway, err := c.client.GetNATGateway(ctx, id)
+	if err != nil || gateway == nil {
+		return "", err
+	}
+	return gateway.EIPAllocationId, nil
+}
+
+// adoptOrphans fills the empty slots with the unique matching resource returned by find. The resources are only listed
+// if at least one slot is empty.
+func adoptOrphans[T any](c *FlowContext, known sets.Set[string], find func() ([]*T, error), id func(item *T) string, slots ...orphanSlot[T]) error {
+	var empty []orphanSlot[T]
+	for _, slot := range slots {
+		// an empty value is used as placeholder while a resource is being created
+		if ptr.Deref(slot.board.Get(slot.key), "") == "" {
+			empty = append(empty, slot)
+		}
+	}
+	if len(empty) == 0 {
+		return nil
+	}
+
+	items, err := find()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, slot := range empty {
+		var matches []string
+		for _, item := range items {
+			if itemID := id(item); !known.Has(itemID) && slot.match(item) {
+				matches = append(matches, itemID)
+			}
+		}
+		switch len(matches) {
+		case 0:
+		case 1:
+			c.log.Info("adopting orphaned resource missing in state", "resource", slot.description, "id", matches[0])
+			slot.board.Set(slot.key, matches[0])
+			known.Insert(matches[0])
+		default:
+			errs = append(errs, fmt.Errorf("%w for %s, resources have to be deleted manually: %s",
+				ErrorMultipleMatches, slot.description, strings.Join(matches, ", ")))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+// QuotaRequests returns the service quotas the reconciliation consumes for resources which do not exist yet.
+func (c *FlowContext) QuotaRequests() []aws.QuotaRequest {
+	var requests []aws.QuotaRequest
+	if c.config.Networks.VPC.ID == nil && c.state.Get(IdentifierVPC) == nil {
+		requests = append(requests, aws.QuotaRequest{Quota: aws.QuotaVPCs, Amount: 1})
+	}
+
+	zones := c.state.GetChild(ChildIdZones)
+	processedZones := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if processedZones.Has(zone.Name) {
+			continue
+		}
+		processedZones.Insert(zone.Name)
+		if !c.hasNATGateway(zone.Name) {
+			continue
+		}
+
+		child := zones.GetChild(zone.Name)
+		if zone.ElasticIPAllocationID == nil && child.Get(IdentifierManagedZoneNATGWElasticIP) == nil {
+			requests = append(requests, aws.QuotaRequest{Quota: aws.QuotaElasticIPs, Amount: 1})
+		}
+		if zone.SecondaryElasticIPs != nil {
+			if missing := int(ptr.Deref(zone.SecondaryElasticIPs.Count, 0)) - len(ManagedSecondaryElasticIPs(child)); missing > 0 {
+				requests = append(requests, aws.QuotaRequest{Quota: aws.QuotaElasticIPs, Amount: missing})
+			}
+		}
+		if child.Get(IdentifierZoneNATGateway) == nil {
+			requests = append(requests, aws.QuotaRequest{Quota: aws.QuotaNATGateways, Zone: zone.Name, Amount: 1})
+		}
+	}
+	return requests
+}
@@ -20,12 +20,16 @@ import (
 
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
@@ -33,6 +37,7 @@ import (
 const (
 	defaultTimeout         = 90 * time.Second
 	defaultLongTimeout     = 3 * time.Minute
+	fsxDeletionTimeout     = 15 * time.Minute
 	allIPv4                = "0.0.0.0/0"
 	allIPv6                = "::/0"
 	nat64Prefix            = "64:ff9b::/96"
@@ -42,11 +47,21 @@ const (
 // Reconcile creates and runs the flow to reconcile the AWS infrastructure.
 func (c *FlowContext) Reconcile(ctx context.Context) error {
 	c.BasicFlowContext = NewBasicFlowContext(c.log, c.state, c.persistState)
+	if err := c.discoverOrphans(ctx); err != nil {
+		c.log.Error(err, "discovery of orphaned resources failed")
+		return errors.Join(err, c.persistState(ctx))
+	}
+	if err := c.persistState(ctx); err != nil {
+		return err
+	}
 	g := c.buildReconcileGraph()
 	f := g.Compile()
 	if err := f.Run(ctx, flow.Opts{Log: c.log}); err != nil {
 		c.log.Error(err, "flow reconciliation failed")
-		return errors.Join(flow.Causes(err), c.persistState(ctx))
+		return errors.Join(flow.Causes(err), c.persistState(ctx), c.patchConditions(ctx))
+	}
+	if err := c.patchConditions(ctx); err != nil {
+		return err
 	}
 
 	status := c.computeInfrastructureStatus()
@@ -73,6 +88,14 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureVpcIPv6CidrBlock,
 		Timeout(defaultTimeout), Dependencies(ensureVpc))
 
+	_ = c.AddTask(g, "ensure flow logs",
+		c.ensureFlowLogs,
+		DoIf(createVPC && (c.config.FlowLogs != nil || c.hasFlowLogs())), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
+	_ = c.AddTask(g, "check flow logs of existing VPC",
+		c.checkExistingVpcFlowLogs,
+		DoIf(!createVPC), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
 	ensureDefaultSecurityGroup := c.AddTask(g, "ensure default security group",
 		c.ensureDefaultSecurityGroup,
 		DoIf(createVPC), Timeout(defaultTimeout), Dependencies(ensureVpc))
@@ -97,14 +120,30 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureNodesSecurityGroup,
 		Timeout(defaultTimeout), Dependencies(ensureVpc))
 
+	ensureNetworkACLs := c.AddTask(g, "ensure network ACLs",
+		c.ensureNetworkACLs,
+		DoIf(c.config.Networks.NetworkACLs != nil), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
 	ensureZones := c.AddTask(g, "ensure zones resources",
 		c.ensureZones,
-		Timeout(defaultLongTimeout), Dependencies(ensureVpc, ensureNodesSecurityGroup, ensureVpcIPv6CidrBloc, ensureMainRouteTable))
+		Timeout(defaultLongTimeout), Dependencies(ensureVpc, ensureNodesSecurityGroup, ensureVpcIPv6CidrBloc, ensureMainRouteTable, ensureNetworkACLs))
+
+	_ = c.AddTask(g, "delete removed network ACLs",
+		c.deleteRemovedNetworkACLs,
+		DoIf(c.hasNetworkACLs()), Timeout(defaultTimeout), Dependencies(ensureZones))
 
 	_ = c.AddTask(g, "ensure efs file system",
 		c.ensureEfs,
 		DoIf(c.isCsiEfsEnabled()), Timeout(defaultTimeout), Dependencies(ensureZones))
 
+	_ = c.AddTask(g, "ensure FSx file system",
+		c.ensureFSx,
+		DoIf(c.isFSxEnabled()), Timeout(defaultLongTimeout), Dependencies(ensureZones))
+
+	_ = c.AddTask(g, "delete removed FSx file system",
+		c.deleteFSx,
+		DoIf(!c.isFSxEnabled() && c.hasFSx()), Timeout(fsxDeletionTimeout))
+
 	_ = c.AddTask(g, "ensure subnet cidr reservation",
 		c.ensureSubnetCidrReservation,
 		Timeout(defaultLongTimeout), Dependencies(ensureZones))
@@ -327,6 +366,202 @@ func (c *FlowContext) validateVpc(ctx context.Context, item *awsclient.VPC) erro
 	return nil
 }
 
+func (c *FlowContext) ensureFlowLogs(ctx context.Context) error {
+	if c.config.FlowLogs == nil {
+		return c.deleteFlowLogs(ctx)
+	}
+
+	log := LogFromContext(ctx)
+	vpcID := *c.state.Get(IdentifierVPC)
+	desired := &awsclient.FlowLog{
+		Tags:                   c.commonTagsWithSuffix("flow-logs"),
+		ResourceId:             vpcID,
+		TrafficType:            string(ptr.Deref(c.config.FlowLogs.TrafficType, aws.FlowLogTrafficTypeAll)),
+		LogFormat:              c.config.FlowLogs.LogFormat,
+		MaxAggregationInterval: ptr.To(ptr.Deref(c.config.FlowLogs.MaxAggregationInterval, 600)),
+	}
+	if c.config.FlowLogs.S3BucketARN != nil {
+		desired.LogDestinationType = string(ec2types.LogDestinationTypeS3)
+		desired.LogDestination = c.config.FlowLogs.S3BucketARN
+	} else {
+		roleARN, err := c.ensureFlowLogsIAMRole(ctx)
+		if err != nil {
+			return err
+		}
+		desired.LogDestinationType = string(ec2types.LogDestinationTypeCloudWatchLogs)
+		desired.LogGroupName = c.config.FlowLogs.CloudWatchLogGroup
+		desired.DeliverLogsPermissionArn = &roleARN
+	}
+
+	current, err := FindExisting(ctx, c.state.Get(IdentifierVpcFlowLog), desired.Tags,
+		c.client.GetFlowLog, c.client.FindFlowLogsByTags,
+		func(item *awsclient.FlowLog) bool {
+			return item.ResourceId == vpcID
+		})
+	if err != nil {
+		return err
+	}
+
+	// flow logs cannot be modified, they are replaced if the configuration has changed
+	if current != nil && !flowLogMatches(desired, current) {
+		log.Info("replacing...", "FlowLogId", current.FlowLogId)
+		if err := c.client.DeleteFlowLog(ctx, current.FlowLogId); err != nil {
+			return err
+		}
+		c.state.Delete(IdentifierVpcFlowLog)
+		current = nil
+	}
+	if current == nil {
+		log.Info("creating...")
+		created, err := c.client.CreateFlowLog(ctx, desired)
+		if err != nil {
+			return err
+		}
+		current = created
+	}
+	c.state.Set(IdentifierVpcFlowLog, current.FlowLogId)
+
+	if desired.LogDestinationType != string(ec2types.LogDestinationTypeCloudWatchLogs) {
+		return c.deleteFlowLogsIAMRole(ctx)
+	}
+	return nil
+}
+
+// flowLogMatches returns true if the current flow log has the desired destination and settings.
+func flowLogMatches(desired, current *awsclient.FlowLog) bool {
+	if desired.LogDestinationType != current.LogDestinationType || desired.TrafficType != current.TrafficType ||
+		ptr.Deref(desired.MaxAggregationInterval, 0) != ptr.Deref(current.MaxAggregationInterval, 0) {
+		return false
+	}
+	if desired.LogFormat != nil && *desired.LogFormat != ptr.Deref(current.LogFormat, "") {
+		return false
+	}
+	if desired.LogDestinationType == string(ec2types.LogDestinationTypeCloudWatchLogs) {
+		return ptr.Deref(desired.LogGroupName, "") == ptr.Deref(current.LogGroupName, "") &&
+			ptr.Deref(desired.DeliverLogsPermissionArn, "") == ptr.Deref(current.DeliverLogsPermissionArn, "")
+	}
+	return ptr.Deref(desired.LogDestination, "") == ptr.Deref(current.LogDestination, "")
+}
+
+// flowLogsRolePolicyDocument allows the flow logs service to publish to the log group with the given ARN only. It is not
+// permitted to create the log group, i.e. the log group has to exist.
+const flowLogsRolePolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "logs:DescribeLogGroups",
+        "logs:DescribeLogStreams"
+      ],
+      "Resource": [
+        "%[1]s",
+        "%[1]s:*"
+      ]
+    }
+  ]
+}`
+
+// ensureFlowLogsIAMRole ensures the role which allows the flow logs service to publish to CloudWatch Logs and returns
+// its ARN.
+func (c *FlowContext) ensureFlowLogsIAMRole(ctx context.Context) (string, error) {
+	log := LogFromContext(ctx)
+	name := fmt.Sprintf("%s-flow-logs", c.namespace)
+	accountID, err := c.client.GetAccountID(ctx)
+	if err != nil {
+		return "", err
+	}
+	desired := &awsclient.IAMRole{
+		RoleName: name,
+		Path:     "/",
+		AssumeRolePolicyDocument: fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "%s"
+      },
+      "Action": "sts:AssumeRole",
+      "Condition": {
+        "StringEquals": {
+          "aws:SourceAccount": "%s"
+        }
+      }
+    }
+  ]
+}`, awsclient.ServicePrincipal("vpc-flow-logs", c.client.GetPartition()), accountID),
+	}
+	current, err := c.client.GetIAMRole(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if current != nil {
+		if _, err := c.updater.UpdateIAMRole(ctx, desired, current); err != nil {
+			return "", err
+		}
+	} else {
+		log.Info("creating...", "RoleName", name)
+		if current, err = c.client.CreateIAMRole(ctx, desired); err != nil {
+			return "", err
+		}
+	}
+	c.state.Set(NameFlowLogsIAMRole, name)
+
+	logGroupARN := fmt.Sprintf("arn:%s:logs:%s:%s:log-group:%s", c.client.GetPartition(), c.infraSpec.Region, accountID,
+		*c.config.FlowLogs.CloudWatchLogGroup)
+	policy := &awsclient.IAMRolePolicy{
+		PolicyName:     name,
+		RoleName:       name,
+		PolicyDocument: fmt.Sprintf(flowLogsRolePolicyDocument, logGroupARN),
+	}
+	currentPolicy, err := c.client.GetIAMRolePolicy(ctx, policy.PolicyName, policy.RoleName)
+	if err != nil {
+		return "", err
+	}
+	if currentPolicy == nil || currentPolicy.PolicyDocument != policy.PolicyDocument {
+		if err := c.client.PutIAMRolePolicy(ctx, policy); err != nil {
+			return "", err
+		}
+	}
+	return current.ARN, nil
+}
+
+// ConditionTypeExistingVPCFlowLogs is the type of the infrastructure condition which reports whether the existing VPC of
+// the shoot has flow logs.
+const ConditionTypeExistingVPCFlowLogs v1beta1.ConditionType = "ExistingVPCFlowLogs"
+
+// checkExistingVpcFlowLogs reports whether the existing VPC has flow logs with the condition ExistingVPCFlowLogs, as
+// they are not managed by the extension. If the flow logs cannot be read, the condition status is unknown and the
+// reconciliation continues.
+func (c *FlowContext) checkExistingVpcFlowLogs(ctx context.Context) error {
+	log := LogFromContext(ctx)
+	vpcID := *c.state.Get(IdentifierVPC)
+	condition := &v1beta1.Condition{Type: ConditionTypeExistingVPCFlowLogs}
+
+	flowLogs, err := c.client.FindFlowLogsByResourceID(ctx, vpcID)
+	switch {
+	case err != nil:
+		log.Error(err, "checking flow logs of existing VPC failed", "vpc", vpcID)
+		condition.Status = v1beta1.ConditionUnknown
+		condition.Reason = "FlowLogsCheckFailed"
+		condition.Message = fmt.Sprintf("Flow logs of VPC %s could not be read: %s", vpcID, err)
+	case len(flowLogs) == 0:
+		log.Info("existing VPC has no flow logs", "vpc", vpcID)
+		condition.Status = v1beta1.ConditionFalse
+		condition.Reason = "FlowLogsMissing"
+		condition.Message = fmt.Sprintf("VPC %s has no flow logs.", vpcID)
+	default:
+		condition.Status = v1beta1.ConditionTrue
+		condition.Reason = "FlowLogsFound"
+		condition.Message = fmt.Sprintf("VPC %s has %d flow logs.", vpcID, len(flowLogs))
+	}
+	c.existingVpcFlowLogsCondition = condition
+	return nil
+}
+
 func (c *FlowContext) ensureDefaultSecurityGroup(ctx context.Context) error {
 	current, err := c.client.FindDefaultSecurityGroupByVpcId(ctx, *c.state.Get(IdentifierVPC))
 	if err != nil {
@@ -383,6 +618,10 @@ func (c *FlowContext) ensureInternetGateway(ctx context.Context) error {
 }
 
 func (c *FlowContext) ensureGatewayEndpoints(ctx context.Context) error {
+	if !c.hasGatewayEndpoints() {
+		return nil
+	}
+
 	log := LogFromContext(ctx)
 	child := c.state.GetChild(ChildIdVPCEndpoints)
 
@@ -711,6 +950,12 @@ func (c *FlowContext) ensureNodesSecurityGroup(ctx context.Context) error {
 }
 
 func (c *FlowContext) ensureEgressCIDRs(ctx context.Context) error {
+	// the private subnets have no egress in mode None
+	if !slices.ContainsFunc(c.config.Networks.Zones, func(zone aws.Zone) bool { return c.hasNATGateway(zone.Name) }) {
+		c.state.Set(IdentifierEgressCIDRs, "")
+		return nil
+	}
+
 	var egressIPs []string
 	tags := awsclient.Tags{
 		c.tagKeyCluster(): TagValueCluster,
@@ -725,11 +970,133 @@ func (c *FlowContext) ensureEgressCIDRs(ctx context.Context) error {
 			continue
 		}
 		egressIPs = append(egressIPs, fmt.Sprintf("%s/32", nat.PublicIP))
+		for _, address := range nat.SecondaryAddresses {
+			if address.PublicIP != "" && address.Status == string(ec2types.NatGatewayAddressStatusSucceeded) {
+				egressIPs = append(egressIPs, fmt.Sprintf("%s/32", address.PublicIP))
+			}
+		}
 	}
 	c.state.Set(IdentifierEgressCIDRs, strings.Join(egressIPs, ","))
 	return nil
 }
 
+// networkACLSpec describes the network ACL of the subnets of one purpose.
+type networkACLSpec struct {
+	purpose   string
+	stateKey  string
+	subnetKey string
+	config    *aws.NetworkACL
+}
+
+func (c *FlowContext) networkACLSpecs() []networkACLSpec {
+	acls := ptr.Deref(c.config.Networks.NetworkACLs, aws.NetworkACLs{})
+	return []networkACLSpec{
+		{"public", IdentifierNetworkACLPublic, IdentifierZoneSubnetPublic, acls.Public},
+		{"internal", IdentifierNetworkACLInternal, IdentifierZoneSubnetPrivate, acls.Internal},
+		{"workers", IdentifierNetworkACLWorkers, IdentifierZoneSubnetWorkers, acls.Workers},
+	}
+}
+
+func (c *FlowContext) networkACLTags(spec networkACLSpec) awsclient.Tags {
+	return c.commonTagsWithSuffix("nacl-" + spec.purpose)
+}
+
+func (c *FlowContext) ensureNetworkACLs(ctx context.Context) error {
+	for _, spec := range c.networkACLSpecs() {
+		if spec.config == nil {
+			continue
+		}
+		if err := c.ensureNetworkACL(ctx, spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *FlowContext) ensureNetworkACL(ctx context.Context, spec networkACLSpec) error {
+	log := LogFromContext(ctx)
+	desired := &awsclient.NetworkAcl{
+		Tags:  c.networkACLTags(spec),
+		VpcId: c.state.Get(IdentifierVPC),
+	}
+	for _, rule := range spec.config.Ingress {
+		desired.Entries = append(desired.Entries, networkACLEntry(rule, false))
+	}
+	for _, rule := range spec.config.Egress {
+		desired.Entries = append(desired.Entries, networkACLEntry(rule, true))
+	}
+
+	current, err := FindExisting(ctx, c.state.Get(spec.stateKey), desired.Tags,
+		c.client.GetNetworkAcl, c.client.FindNetworkAclsByTags,
+		func(item *awsclient.NetworkAcl) bool {
+			return c.isVpcMatchingState(item.VpcId)
+		})
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		log.Info("creating...", "purpose", spec.purpose)
+		if current, err = c.client.CreateNetworkAcl(ctx, desired); err != nil {
+			return err
+		}
+	}
+	c.state.Set(spec.stateKey, current.NetworkAclId)
+
+	if _, err := c.updater.UpdateNetworkAcl(ctx, desired, current); err != nil {
+		return err
+	}
+	return nil
+}
+
+// networkACLEntry converts a rule of the infrastructure config to a network ACL entry.
+func networkACLEntry(rule aws.NetworkACLRule, egress bool) *awsclient.NetworkAclEntry {
+	entry := &awsclient.NetworkAclEntry{
+		RuleNumber: rule.RuleNumber,
+		Egress:     egress,
+		Protocol:   networkACLProtocolNumber(rule.Protocol),
+		RuleAction: string(rule.Action),
+	}
+	if strings.Contains(rule.CIDR, ":") {
+		entry.Ipv6CidrBlock = ptr.To(rule.CIDR)
+	} else {
+		entry.CidrBlock = ptr.To(rule.CIDR)
+	}
+	if entry.HasPortRange() {
+		entry.FromPort = rule.FromPort
+		entry.ToPort = rule.ToPort
+	}
+	return entry
+}
+
+func networkACLProtocolNumber(protocol string) string {
+	switch protocol {
+	case "all":
+		return "-1"
+	case "tcp":
+		return "6"
+	case "udp":
+		return "17"
+	case "icmp":
+		return "1"
+	case "icmpv6":
+		return "58"
+	default:
+		return protocol
+	}
+}
+
+func (c *FlowContext) deleteRemovedNetworkACLs(ctx context.Context) error {
+	for _, spec := range c.networkACLSpecs() {
+		if spec.config != nil {
+			continue
+		}
+		if err := c.deleteNetworkACL(ctx, spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *FlowContext) ensureZones(ctx context.Context) error {
 	log := LogFromContext(ctx)
 	var desired []*awsclient.Subnet
@@ -841,6 +1208,7 @@ func (c *FlowContext) ensureZones(ctx context.Context) error {
 	}
 
 	// TODO: @hebelsan - remove processedZones after migration of shoots with duplicated zone name entries
+	var zones []*aws.Zone
 	processedZones = sets.New[string]()
 	for _, item := range c.config.Networks.Zones {
 		if processedZones.Has(item.Name) {
@@ -849,7 +1217,29 @@ func (c *FlowContext) ensureZones(ctx context.Context) error {
 		processedZones.Insert(item.Name)
 
 		zone := item
-		c.addZoneReconcileTasks(g, &zone, dependencies.Get(zone.Name))
+		zones = append(zones, &zone)
+	}
+
+	// the route tables of all zones must be updated before NAT gateways which are not needed anymore are deleted,
+	// as route tables of other zones may use them if the egress mode changed.
+	natGateways := map[string]flow.TaskIDer{}
+	for _, zone := range zones {
+		if c.hasNATGateway(zone.Name) {
+			natGateways[zone.Name] = c.addNATGatewayReconcileTasks(g, zone, dependencies.Get(zone.Name))
+		}
+	}
+	var routingTables []flow.TaskIDer
+	for _, zone := range zones {
+		var natGateway []flow.TaskIDer
+		if task, ok := natGateways[c.natGatewayZone(zone.Name)]; ok {
+			natGateway = append(natGateway, task)
+		}
+		routingTables = append(routingTables, c.addZoneReconcileTasks(g, zone, append(dependencies.Get(zone.Name), natGateway...)))
+	}
+	for _, zone := range zones {
+		if !c.hasNATGateway(zone.Name) {
+			c.addNATGatewayDeletionTasks(g, zone.Name, routingTables...)
+		}
 	}
 	f := g.Compile()
 	if err := f.Run(ctx, flow.Opts{Log: c.log}); err != nil {
@@ -933,7 +1323,7 @@ func (c *FlowContext) addSubnetReconcileTasks(g *flow.Graph, desired, current *a
 		Timeout(defaultTimeout)), nil
 }
 
-func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zone *aws.Zone, dependencies []flow.TaskIDer) {
+func (c *FlowContext) addNATGatewayReconcileTasks(g *flow.Graph, zone *aws.Zone, dependencies []flow.TaskIDer) flow.TaskIDer {
 	ensureRecreateNATGateway := c.AddTask(g, "ensure NAT gateway recreation "+zone.Name,
 		c.ensureRecreateNATGateway(zone),
 		Timeout(defaultTimeout), Dependencies(dependencies...))
@@ -946,9 +1336,33 @@ func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zone *aws.Zone, depen
 		c.ensureNATGateway(zone),
 		Timeout(defaultLongTimeout), Dependencies(dependencies...), Dependencies(ensureElasticIP))
 
+	_ = c.AddTask(g, "ensure NAT gateway secondary elastic IPs "+zone.Name,
+		c.ensureNATGatewaySecondaryElasticIPs(zone),
+		Timeout(defaultLongTimeout), Dependencies(ensureNATGateway))
+
+	return ensureNATGateway
+}
+
+// addNATGatewayDeletionTasks adds tasks deleting the NAT gateway and the elastic IP of a zone which does not need a NAT
+// gateway (anymore).
+func (c *FlowContext) addNATGatewayDeletionTasks(g *flow.Graph, zoneName string, dependencies ...flow.TaskIDer) {
+	deleteNATGateway := c.AddTask(g, "delete NAT gateway "+zoneName,
+		c.deleteNATGateway(zoneName),
+		Timeout(defaultLongTimeout), Dependencies(dependencies...))
+
+	_ = c.AddTask(g, "delete NAT gateway elastic IP "+zoneName,
+		c.deleteElasticIP(zoneName),
+		Timeout(defaultTimeout), Dependencies(deleteNATGateway))
+
+	_ = c.AddTask(g, "delete NAT gateway secondary elastic IPs "+zoneName,
+		c.deleteSecondaryElasticIPs(zoneName),
+		Timeout(defaultTimeout), Dependencies(deleteNATGateway))
+}
+
+func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zone *aws.Zone, dependencies []flow.TaskIDer) flow.TaskIDer {
 	ensureRoutingTable := c.AddTask(g, "ensure route table "+zone.Name,
 		c.ensurePrivateRoutingTable(zone.Name),
-		Timeout(defaultTimeout), Dependencies(dependencies...), Dependencies(ensureNATGateway))
+		Timeout(defaultTimeout), Dependencies(dependencies...))
 
 	_ = c.AddTask(g, "ensure route table associations "+zone.Name,
 		c.ensureRoutingTableAssociations(zone.Name),
@@ -957,6 +1371,12 @@ func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zone *aws.Zone, depen
 	_ = c.AddTask(g, "ensure VPC endpoints route table associations "+zone.Name,
 		c.ensureVPCEndpointsRoutingTableAssociations(zone.Name),
 		Timeout(defaultTimeout), Dependencies(dependencies...), Dependencies(ensureRoutingTable))
+
+	_ = c.AddTask(g, "ensure network ACL associations "+zone.Name,
+		c.ensureNetworkACLAssociations(zone.Name),
+		DoIf(c.config.Networks.NetworkACLs != nil), Timeout(defaultTimeout), Dependencies(dependencies...))
+
+	return ensureRoutingTable
 }
 
 func (c *FlowContext) addZoneDeletionTasks(g *flow.Graph, zoneName string) flow.TaskIDer {
@@ -976,6 +1396,10 @@ func (c *FlowContext) addZoneDeletionTasks(g *flow.Graph, zoneName string) flow.
 		c.deleteElasticIP(zoneName),
 		Timeout(defaultTimeout), Dependencies(deleteNATGateway))
 
+	_ = c.AddTask(g, "delete NAT gateway secondary elastic IPs "+zoneName,
+		c.deleteSecondaryElasticIPs(zoneName),
+		Timeout(defaultTimeout), Dependencies(deleteNATGateway))
+
 	return deleteNATGateway
 }
 
@@ -1186,6 +1610,11 @@ func (c *FlowContext) ensureElasticIP(zone *aws.Zone) flow.TaskFn {
 				return err
 			}
 		} else {
+			// existing elastic IPs are kept, the pool only applies to newly allocated ones
+			if pool := c.config.Networks.ElasticIPPool; pool != nil {
+				desired.PublicIPv4Pool = pool.PublicIPv4PoolID
+				desired.IPAMPoolID = pool.IPAMPoolID
+			}
 			log.Info("creating...")
 			created, err := c.client.CreateElasticIP(ctx, desired)
 			if err != nil {
@@ -1210,7 +1639,13 @@ func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
 		if err != nil {
 			return err
 		}
-		err = c.deleteElasticIpWithWait(ctx, current)
+		if c.retains(aws.RetainedResourceElasticIPs) {
+			if current != nil {
+				err = c.retainEC2Resources(ctx, aws.RetainedResourceElasticIPs, current.AllocationId)
+			}
+		} else {
+			err = c.deleteElasticIpWithWait(ctx, current)
+		}
 		if err != nil {
 			return err
 		}
@@ -1219,6 +1654,139 @@ func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
 	}
 }
 
+// ensureNATGatewaySecondaryElasticIPs associates the configured secondary elastic IPs with the NAT gateway of the zone
+// and disassociates all others. Managed secondary elastic IPs which are not needed anymore are released.
+func (c *FlowContext) ensureNATGatewaySecondaryElasticIPs(zone *aws.Zone) flow.TaskFn {
+	return func(ctx context.Context) error {
+		log := LogFromContext(ctx)
+		helper := c.zoneSuffixHelpers(zone.Name)
+		child := c.getSubnetZoneChild(zone.Name)
+		natGatewayID := child.Get(IdentifierZoneNATGateway)
+		if natGatewayID == nil || *natGatewayID == "" {
+			return nil
+		}
+
+		previous := ManagedSecondaryElasticIPs(child)
+		var desired, managed []string
+		if secondary := zone.SecondaryElasticIPs; secondary != nil {
+			desired = secondary.AllocationIDs
+			for i := 0; i < int(ptr.Deref(secondary.Count, 0)); i++ {
+				var id *string
+				if i < len(previous) {
+					id = &previous[i]
+				}
+				eip := &awsclient.ElasticIP{
+					Tags: c.commonTagsWithSuffix(helper.GetSuffixSecondaryElasticIP(i + 1)),
+					Vpc:  true,
+				}
+				current, err := FindExisting(ctx, id, eip.Tags, c.client.GetElasticIP, c.client.FindElasticIPsByTags,
+					func(item *awsclient.ElasticIP) bool {
+						return item.Tags[TagKeyName] == eip.Tags[TagKeyName]
+					})
+				if err != nil {
+					return err
+				}
+				if current == nil {
+					if pool := c.config.Networks.ElasticIPPool; pool != nil {
+						eip.PublicIPv4Pool = pool.PublicIPv4PoolID
+						eip.IPAMPoolID = pool.IPAMPoolID
+					}
+					log.Info("creating secondary elastic IP...", "index", i+1)
+					if current, err = c.client.CreateElasticIP(ctx, eip); err != nil {
+						return err
+					}
+				}
+				managed = append(managed, current.AllocationId)
+				// keep track of the previous elastic IPs as well until they are released
+				child.Set(IdentifierManagedZoneNATGWSecondaryElasticIPs, strings.Join(append(slices.Clone(managed), slices.DeleteFunc(slices.Clone(previous), func(id string) bool {
+					return slices.Contains(managed, id)
+				})...), ","))
+			}
+			desired = append(desired, managed...)
+		}
+
+		current, err := c.client.GetNATGateway(ctx, *natGatewayID)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return fmt.Errorf("NAT gateway %s not found", *natGatewayID)
+		}
+
+		var obsolete []*awsclient.NATGatewayAddress
+		associated := sets.New[string]()
+		for _, address := range current.SecondaryAddresses {
+			if address.AllocationId == "" || !slices.Contains(desired, address.AllocationId) {
+				obsolete = append(obsolete, address)
+				continue
+			}
+			associated.Insert(address.AllocationId)
+		}
+		if len(obsolete) > 0 {
+			log.Info("disassociating secondary addresses...", "count", len(obsolete))
+			if err := c.client.DisassociateNATGatewayAddresses(ctx, current.NATGatewayId, obsolete); err != nil {
+				return err
+			}
+		}
+		var missing []string
+		for _, allocationID := range desired {
+			if !associated.Has(allocationID) {
+				missing = append(missing, allocationID)
+			}
+		}
+		if len(missing) > 0 {
+			log.Info("associating secondary elastic IPs...", "allocationIDs", missing)
+			waiter := informOnWaiting(log, 10*time.Second, "still associating...")
+			err := c.client.AssociateNATGatewayAddresses(ctx, current.NATGatewayId, missing)
+			waiter.Done(err)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, allocationID := range previous {
+			if slices.Contains(managed, allocationID) {
+				continue
+			}
+			if err := c.deleteElasticIpWithWait(ctx, &awsclient.ElasticIP{AllocationId: allocationID}); err != nil {
+				return err
+			}
+		}
+		if len(managed) > 0 {
+			child.Set(IdentifierManagedZoneNATGWSecondaryElasticIPs, strings.Join(managed, ","))
+		} else {
+			child.Delete(IdentifierManagedZoneNATGWSecondaryElasticIPs)
+		}
+		return nil
+	}
+}
+
+// deleteSecondaryElasticIPs releases the managed secondary elastic IPs of the zone, unless they are retained on deletion
+// of the infrastructure. The NAT gateway must have been deleted before.
+func (c *FlowContext) deleteSecondaryElasticIPs(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		child := c.getSubnetZoneChild(zoneName)
+		if c.retains(aws.RetainedResourceElasticIPs) {
+			if err := c.retainEC2Resources(ctx, aws.RetainedResourceElasticIPs, ManagedSecondaryElasticIPs(child)...); err != nil {
+				return err
+			}
+			child.Delete(IdentifierManagedZoneNATGWSecondaryElasticIPs)
+			return nil
+		}
+		for _, allocationID := range ManagedSecondaryElasticIPs(child) {
+			current, err := c.client.GetElasticIP(ctx, allocationID)
+			if err != nil {
+				return err
+			}
+			if err := c.deleteElasticIpWithWait(ctx, current); err != nil {
+				return err
+			}
+		}
+		child.Delete(IdentifierManagedZoneNATGWSecondaryElasticIPs)
+		return nil
+	}
+}
+
 func (c *FlowContext) deleteElasticIpWithWait(ctx context.Context, elasticIP *awsclient.ElasticIP) error {
 	if elasticIP != nil {
 		log := LogFromContext(ctx)
@@ -1400,22 +1968,30 @@ func (c *FlowContext) ensurePrivateRoutingTable(zoneName string) flow.TaskFn {
 		child := c.getSubnetZoneChild(zoneName)
 		id := child.Get(IdentifierZoneRouteTable)
 
-		var routes []*awsclient.Route
-
-		routes = append(routes, &awsclient.Route{
-			DestinationCidrBlock: ptr.To(allIPv4),
-			NatGatewayId:         child.Get(IdentifierZoneNATGateway),
-		})
+		var (
+			routes       []*awsclient.Route
+			natGatewayID *string
+			natZone      = c.natGatewayZone(zoneName)
+		)
+		if natZone != "" {
+			natGatewayID = c.getSubnetZoneChild(natZone).Get(IdentifierZoneNATGateway)
+			routes = append(routes, &awsclient.Route{
+				DestinationCidrBlock: ptr.To(allIPv4),
+				NatGatewayId:         natGatewayID,
+			})
+		}
 
 		if containsIPv6(c.getIpFamilies()) {
 			routes = append(routes, &awsclient.Route{
 				DestinationIpv6CidrBlock:    ptr.To(allIPv6),
 				EgressOnlyInternetGatewayId: c.state.Get(IdentifierEgressOnlyInternetGateway),
 			})
-			routes = append(routes, &awsclient.Route{
-				DestinationIpv6CidrBlock: ptr.To(nat64Prefix),
-				NatGatewayId:             child.Get(IdentifierZoneNATGateway),
-			})
+			if natZone != "" {
+				routes = append(routes, &awsclient.Route{
+					DestinationIpv6CidrBlock: ptr.To(nat64Prefix),
+					NatGatewayId:             natGatewayID,
+				})
+			}
 		}
 
 		desired := &awsclient.RouteTable{
@@ -1438,6 +2014,11 @@ func (c *FlowContext) ensurePrivateRoutingTable(zoneName string) flow.TaskFn {
 			if _, err := c.updater.UpdateRouteTable(ctx, log, desired, current); err != nil {
 				return err
 			}
+			if natZone == "" {
+				if err := c.deleteNATGatewayRoutes(ctx, current); err != nil {
+					return err
+				}
+			}
 		} else {
 			log.Info("creating...", "zone", zoneName)
 			created, err := c.client.CreateRouteTable(ctx, desired)
@@ -1455,6 +2036,25 @@ func (c *FlowContext) ensurePrivateRoutingTable(zoneName string) flow.TaskFn {
 	}
 }
 
+// deleteNATGatewayRoutes deletes the routes to NAT gateways of the given route table. They are not deleted by the
+// updater if the zone has no NAT gateway anymore, as it keeps routes to destinations which are not desired.
+func (c *FlowContext) deleteNATGatewayRoutes(ctx context.Context, routeTable *awsclient.RouteTable) error {
+	log := LogFromContext(ctx)
+	for _, route := range routeTable.Routes {
+		if route == nil || route.NatGatewayId == nil {
+			continue
+		}
+		if ptr.Deref(route.DestinationCidrBlock, "") != allIPv4 && ptr.Deref(route.DestinationIpv6CidrBlock, "") != nat64Prefix {
+			continue
+		}
+		if err := c.client.DeleteRoute(ctx, routeTable.RouteTableId, route); err != nil {
+			return err
+		}
+		log.Info("Deleted route to NAT gateway", "NATGatewayId", *route.NatGatewayId)
+	}
+	return nil
+}
+
 func (c *FlowContext) deletePrivateRoutingTable(zoneName string) flow.TaskFn {
 	return func(ctx context.Context) error {
 		log := LogFromContext(ctx)
@@ -1585,6 +2185,46 @@ func (c *FlowContext) ensureZoneRoutingTableAssociation(ctx context.Context, zon
 	return nil
 }
 
+// ensureNetworkACLAssociations associates the subnets of the zone with the network ACLs of their purposes. Subnets of
+// purposes without network ACL are left untouched, they are restored to the default network ACL when the network ACL
+// is deleted.
+func (c *FlowContext) ensureNetworkACLAssociations(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		log := LogFromContext(ctx)
+		child := c.getSubnetZoneChild(zoneName)
+		for _, spec := range c.networkACLSpecs() {
+			aclID := c.state.Get(spec.stateKey)
+			if spec.config == nil || aclID == nil {
+				continue
+			}
+			subnetID := child.Get(spec.subnetKey)
+			if subnetID == nil {
+				return fmt.Errorf("missing subnet id for key %s", spec.subnetKey)
+			}
+			current, err := c.client.FindNetworkAclBySubnetId(ctx, *subnetID)
+			if err != nil {
+				return err
+			}
+			if current == nil {
+				return fmt.Errorf("network ACL of subnet %s not found", *subnetID)
+			}
+			if current.NetworkAclId == *aclID {
+				continue
+			}
+			for _, assoc := range current.Associations {
+				if assoc.SubnetId != *subnetID {
+					continue
+				}
+				log.Info("replacing network ACL association", "SubnetId", *subnetID, "NetworkAclId", *aclID)
+				if _, err := c.client.ReplaceNetworkAclAssociation(ctx, assoc.NetworkAclAssociationId, *aclID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
 func (c *FlowContext) ensureVPCEndpointsRoutingTableAssociations(zoneName string) flow.TaskFn {
 	return func(ctx context.Context) error {
 		for _, endpoint := range c.config.Networks.VPC.GatewayEndpoints {
@@ -1686,18 +2326,18 @@ func (c *FlowContext) ensureIAMRole(ctx context.Context) error {
 	desired := &awsclient.IAMRole{
 		RoleName: fmt.Sprintf("%s-nodes", c.namespace),
 		Path:     "/",
-		AssumeRolePolicyDocument: `{
+		AssumeRolePolicyDocument: fmt.Sprintf(`{
   "Version": "2012-10-17",
   "Statement": [
     {
       "Effect": "Allow",
       "Principal": {
-        "Service": "ec2.amazonaws.com"
+        "Service": "%s"
       },
       "Action": "sts:AssumeRole"
     }
   ]
-}`,
+}`, awsclient.ServicePrincipal("ec2", c.client.GetPartition())),
 	}
 	current, err := c.client.GetIAMRole(ctx, desired.RoleName)
 	if err != nil {
@@ -1796,6 +2436,15 @@ const iamRolePolicyTemplate = `{
       "Resource": [
         "*"
       ]
+    }{{ end }}{{ if .ecrRoleARNs }},
+    {
+      "Effect": "Allow",
+      "Action": [
+        "sts:AssumeRole"
+      ],
+      "Resource": [{{ range $i, $arn := .ecrRoleARNs }}{{ if $i }},{{ end }}
+        "{{ $arn }}"{{ end }}
+      ]
     }{{ end }}
   ]
 }`
@@ -1810,6 +2459,7 @@ func (c *FlowContext) ensureIAMRolePolicy(ctx context.Context) error {
 	templateData := map[string]any{
 		"enableECRAccess": ptr.Deref(c.config.EnableECRAccess, true),
 		"enableEfsAccess": ptr.Deref(c.config.ElasticFileSystem, aws.ElasticFileSystemConfig{}).Enabled,
+		"ecrRoleARNs":     c.ecrRoleARNs(),
 	}
 	if err := t.Execute(&buffer, templateData); err != nil {
 		return fmt.Errorf("executing policyDocument template failed: %s", err)
@@ -1844,6 +2494,20 @@ func (c *FlowContext) ensureIAMRolePolicy(ctx context.Context) error {
 	return nil
 }
 
+// ecrRoleARNs returns the sorted ARNs of the roles the ECR credential provider on the nodes is allowed to assume.
+func (c *FlowContext) ecrRoleARNs() []string {
+	if !ptr.Deref(c.config.EnableECRAccess, true) || c.config.ECR == nil {
+		return nil
+	}
+	roleARNs := sets.New[string]()
+	for _, registry := range c.config.ECR.Registries {
+		if registry.RoleARN != nil {
+			roleARNs.Insert(*registry.RoleARN)
+		}
+	}
+	return sets.List(roleARNs)
+}
+
 func (c *FlowContext) ensureKeyPair(ctx context.Context) error {
 	log := LogFromContext(ctx)
 	desired := &awsclient.KeyPairInfo{
@@ -1903,7 +2567,15 @@ func (c *FlowContext) ensureEfs(ctx context.Context) error {
 		return err
 	}
 
-	return c.ensureEfsMountTargets(ctx)
+	if err := c.ensureEfsMountTargets(ctx); err != nil {
+		return err
+	}
+
+	if err := c.ensureEfsSettings(ctx); err != nil {
+		return err
+	}
+
+	return c.ensureEfsAccessPoints(ctx)
 }
 
 func (c *FlowContext) ensureEfsCreateFileSystem(ctx context.Context) error {
@@ -1932,10 +2604,19 @@ func (c *FlowContext) ensureEfsCreateFileSystem(ctx context.Context) error {
 		return nil
 	}
 
+	efsConfig := c.config.ElasticFileSystem
 	inputCreate := &efs.CreateFileSystemInput{
 		Tags:          c.commonTags.AddManagedTag().ToEfsTags(),
 		CreationToken: ptr.To(c.shootUUID),
 		Encrypted:     ptr.To(true),
+		KmsKeyId:      efsConfig.KMSKeyID,
+		Backup:        efsConfig.AutomaticBackups,
+	}
+	if efsConfig.PerformanceMode != nil {
+		inputCreate.PerformanceMode = efstypes.PerformanceMode(*efsConfig.PerformanceMode)
+	}
+	if efsConfig.ThroughputMode != nil {
+		inputCreate.ThroughputMode, inputCreate.ProvisionedThroughputInMibps = c.efsThroughput()
 	}
 	efsCreate, err := c.client.CreateFileSystem(ctx, inputCreate)
 	if err != nil {
@@ -1956,13 +2637,8 @@ func (c *FlowContext) ensureEfsCreateFileSystem(ctx context.Context) error {
 func (c *FlowContext) ensureEfsMountTargets(ctx context.Context) error {
 	log := LogFromContext(ctx)
 
-	var efsID *string
-	switch {
-	case c.config.ElasticFileSystem.ID != nil:
-		efsID = c.config.ElasticFileSystem.ID
-	case c.state.Get(IdentifierManagedEfsID) != nil:
-		efsID = c.state.Get(IdentifierManagedEfsID)
-	default:
+	efsID := c.efsID()
+	if efsID == nil {
 		return fmt.Errorf("trying to ensure efs mount targets, but efs id is not set")
 	}
 
@@ -2048,6 +2724,367 @@ func (c *FlowContext) ensureEfsMountTargets(ctx context.Context) error {
 	return nil
 }
 
+// ensureEfsSettings updates the settings of the managed EFS file system which can be changed in place. Settings which
+// are not configured are kept as they are.
+func (c *FlowContext) ensureEfsSettings(ctx context.Context) error {
+	log := LogFromContext(ctx)
+
+	efsID := c.state.Get(IdentifierManagedEfsID)
+	if c.config.ElasticFileSystem.ID != nil || efsID == nil {
+		return nil
+	}
+	efsConfig := c.config.ElasticFileSystem
+
+	if efsConfig.ThroughputMode != nil {
+		current, err := c.client.GetFileSystem(ctx, *efsID)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return fmt.Errorf("managed EFS file system %s not found", *efsID)
+		}
+		mode, provisioned := c.efsThroughput()
+		if current.ThroughputMode != mode || !ptr.Equal(current.ProvisionedThroughputInMibps, provisioned) {
+			log.Info("updating EFS throughput", "efsFileSystem", *efsID, "throughputMode", mode)
+			if err := c.client.UpdateFileSystem(ctx, &efs.UpdateFileSystemInput{
+				FileSystemId:                 efsID,
+				ThroughputMode:               mode,
+				ProvisionedThroughputInMibps: provisioned,
+			}); err != nil {
+				return fmt.Errorf("failed to update throughput of EFS %s: %w", *efsID, err)
+			}
+		}
+	}
+
+	if efsConfig.LifecyclePolicy != nil {
+		current, err := c.client.DescribeLifecycleConfiguration(ctx, &efs.DescribeLifecycleConfigurationInput{FileSystemId: efsID})
+		if err != nil {
+			return fmt.Errorf("failed to describe lifecycle configuration of EFS %s: %w", *efsID, err)
+		}
+		desired := efsLifecyclePolicies(efsConfig.LifecyclePolicy)
+		if !reflect.DeepEqual(mergeEfsLifecyclePolicies(current), mergeEfsLifecyclePolicies(desired)) {
+			log.Info("updating EFS lifecycle configuration", "efsFileSystem", *efsID)
+			if err := c.client.PutLifecycleConfiguration(ctx, &efs.PutLifecycleConfigurationInput{
+				FileSystemId:      efsID,
+				LifecyclePolicies: desired,
+			}); err != nil {
+				return fmt.Errorf("failed to update lifecycle configuration of EFS %s: %w", *efsID, err)
+			}
+		}
+	}
+
+	if efsConfig.AutomaticBackups != nil {
+		current, err := c.client.DescribeBackupPolicy(ctx, &efs.DescribeBackupPolicyInput{FileSystemId: efsID})
+		if err != nil {
+			return fmt.Errorf("failed to describe backup policy of EFS %s: %w", *efsID, err)
+		}
+		enabled := current != nil && (current.Status == efstypes.StatusEnabled || current.Status == efstypes.StatusEnabling)
+		if enabled != *efsConfig.AutomaticBackups {
+			status := efstypes.StatusDisabled
+			if *efsConfig.AutomaticBackups {
+				status = efstypes.StatusEnabled
+			}
+			log.Info("updating EFS backup policy", "efsFileSystem", *efsID, "status", status)
+			if err := c.client.PutBackupPolicy(ctx, &efs.PutBackupPolicyInput{
+				FileSystemId: efsID,
+				BackupPolicy: &efstypes.BackupPolicy{Status: status},
+			}); err != nil {
+				return fmt.Errorf("failed to update backup policy of EFS %s: %w", *efsID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureEfsAccessPoints creates the configured access points and deletes the obsolete ones. Access points cannot be
+// modified, so they are replaced if their configuration has changed.
+func (c *FlowContext) ensureEfsAccessPoints(ctx context.Context) error {
+	log := LogFromContext(ctx)
+
+	efsID := c.efsID()
+	if efsID == nil {
+		return fmt.Errorf("trying to ensure efs access points, but efs id is not set")
+	}
+
+	owned, err := c.findOwnedEfsAccessPoints(ctx, efsID)
+	if err != nil {
+		return err
+	}
+
+	child := c.state.GetChild(ChildEfsAccessPoints)
+	desiredIDs := sets.New[string]()
+	for _, accessPoint := range c.config.ElasticFileSystem.AccessPoints {
+		desired := c.efsAccessPointInput(efsID, accessPoint)
+		nameTag := c.commonTagsWithSuffix(efsAccessPointSuffix(accessPoint.Name))[TagKeyName]
+
+		var current *efstypes.AccessPointDescription
+		for i, item := range owned {
+			if *item.AccessPointId == ptr.Deref(child.Get(accessPoint.Name), "") || efsTagValue(item.Tags, TagKeyName) == nameTag {
+				current = &owned[i]
+				break
+			}
+		}
+
+		if current != nil && !efsAccessPointMatches(current, desired) {
+			log.Info("replacing EFS access point as its configuration has changed", "name", accessPoint.Name, "AccessPointId", *current.AccessPointId)
+			if err := c.client.DeleteAccessPoint(ctx, &efs.DeleteAccessPointInput{AccessPointId: current.AccessPointId}); err != nil {
+				return fmt.Errorf("failed to delete EFS access point %s: %w", *current.AccessPointId, err)
+			}
+			current = nil
+		}
+
+		if current == nil {
+			log.Info("creating EFS access point", "name", accessPoint.Name)
+			current, err = c.client.CreateAccessPoint(ctx, desired)
+			if err != nil {
+				return fmt.Errorf("failed to create EFS access point %s: %w", accessPoint.Name, err)
+			}
+			log.Info("created EFS access point", "name", accessPoint.Name, "AccessPointId", *current.AccessPointId)
+		}
+
+		child.Set(accessPoint.Name, *current.AccessPointId)
+		desiredIDs.Insert(*current.AccessPointId)
+	}
+
+	for _, item := range owned {
+		if desiredIDs.Has(*item.AccessPointId) {
+			continue
+		}
+		log.Info("deleting obsolete EFS access point", "AccessPointId", *item.AccessPointId)
+		if err := c.client.DeleteAccessPoint(ctx, &efs.DeleteAccessPointInput{AccessPointId: item.AccessPointId}); err != nil {
+			return fmt.Errorf("failed to delete EFS access point %s: %w", *item.AccessPointId, err)
+		}
+	}
+	for _, name := range child.Keys() {
+		if id := child.Get(name); id == nil || !desiredIDs.Has(*id) {
+			child.Delete(name)
+		}
+	}
+
+	return nil
+}
+
+// findOwnedEfsAccessPoints returns the access points of the file system which were created for this cluster.
+func (c *FlowContext) findOwnedEfsAccessPoints(ctx context.Context, efsID *string) ([]efstypes.AccessPointDescription, error) {
+	accessPoints, err := c.client.DescribeAccessPoints(ctx, &efs.DescribeAccessPointsInput{FileSystemId: efsID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe access points of EFS %s: %w", *efsID, err)
+	}
+	var owned []efstypes.AccessPointDescription
+	for _, item := range accessPoints {
+		if item.AccessPointId != nil && c.clusterTags().ContainEfsTags(item.Tags) {
+			owned = append(owned, item)
+		}
+	}
+	return owned, nil
+}
+
+func (c *FlowContext) efsAccessPointInput(efsID *string, accessPoint aws.EFSAccessPoint) *efs.CreateAccessPointInput {
+	input := &efs.CreateAccessPointInput{
+		FileSystemId: efsID,
+		Tags:         c.commonTagsWithSuffix(efsAccessPointSuffix(accessPoint.Name)).AddManagedTag().ToEfsTags(),
+		RootDirectory: &efstypes.RootDirectory{
+			Path: ptr.To(accessPoint.Path),
+			CreationInfo: &efstypes.CreationInfo{
+				OwnerUid:    ptr.To[int64](0),
+				OwnerGid:    ptr.To[int64](0),
+				Permissions: ptr.To(ptr.Deref(accessPoint.Permissions, "0755")),
+			},
+		},
+	}
+	if user := accessPoint.PosixUser; user != nil {
+		input.PosixUser = &efstypes.PosixUser{
+			Uid:           ptr.To(user.UID),
+			Gid:           ptr.To(user.GID),
+			SecondaryGids: slices.Clone(user.SecondaryGIDs),
+		}
+		input.RootDirectory.CreationInfo.OwnerUid = ptr.To(user.UID)
+		input.RootDirectory.CreationInfo.OwnerGid = ptr.To(user.GID)
+	}
+	return input
+}
+
+// efsThroughput returns the configured throughput mode and provisioned throughput of the managed EFS file system.
+func (c *FlowContext) efsThroughput() (efstypes.ThroughputMode, *float64) {
+	efsConfig := c.config.ElasticFileSystem
+	mode := efstypes.ThroughputMode(ptr.Deref(efsConfig.ThroughputMode, aws.EFSThroughputModeBursting))
+	if mode != efstypes.ThroughputModeProvisioned || efsConfig.ProvisionedThroughputInMibps == nil {
+		return mode, nil
+	}
+	return mode, ptr.To(float64(*efsConfig.ProvisionedThroughputInMibps))
+}
+
+func (c *FlowContext) efsID() *string {
+	if c.config.ElasticFileSystem != nil && c.config.ElasticFileSystem.ID != nil {
+		return c.config.ElasticFileSystem.ID
+	}
+	return c.state.Get(IdentifierManagedEfsID)
+}
+
+func (c *FlowContext) ensureFSx(ctx context.Context) error {
+	if err := c.ensureFSxSecurityGroup(ctx); err != nil {
+		return err
+	}
+	return c.ensureFSxFileSystem(ctx)
+}
+
+func (c *FlowContext) ensureFSxSecurityGroup(ctx context.Context) error {
+	log := LogFromContext(ctx)
+	groupName := fmt.Sprintf("%s-fsx", c.namespace)
+
+	desired := &awsclient.SecurityGroup{
+		Tags:        c.commonTagsWithSuffix("fsx"),
+		GroupName:   groupName,
+		VpcId:       c.state.Get(IdentifierVPC),
+		Description: ptr.To("Security group for FSx file system"),
+		Rules: []*awsclient.SecurityGroupRule{
+			{
+				Type:       awsclient.SecurityGroupRuleTypeEgress,
+				Protocol:   "-1",
+				CidrBlocks: []string{allIPv4},
+			},
+		},
+	}
+
+	// the nodes mount the file system from the worker subnets
+	// see https://docs.aws.amazon.com/fsx/latest/LustreGuide/limit-access-security-groups.html and
+	// https://docs.aws.amazon.com/fsx/latest/OpenZFSGuide/limit-access-security-groups.html
+	var workerCIDRs []string
+	for _, zone := range c.config.Networks.Zones {
+		if !slices.Contains(workerCIDRs, zone.Workers) {
+			workerCIDRs = append(workerCIDRs, zone.Workers)
+		}
+	}
+	type portRange struct {
+		protocol         string
+		fromPort, toPort int32
+	}
+	var portRanges []portRange
+	switch c.config.FSx.Type {
+	case aws.FSxFileSystemTypeLustre:
+		portRanges = []portRange{{"tcp", 988, 988}, {"tcp", 1018, 1023}}
+		// the file servers of a Lustre file system communicate with each other
+		for _, r := range portRanges {
+			desired.Rules = append(desired.Rules, &awsclient.SecurityGroupRule{
+				Type:     awsclient.SecurityGroupRuleTypeIngress,
+				FromPort: ptr.To(r.fromPort),
+				ToPort:   ptr.To(r.toPort),
+				Protocol: r.protocol,
+				Self:     true,
+			})
+		}
+	case aws.FSxFileSystemTypeOpenZFS:
+		for _, protocol := range []string{"tcp", "udp"} {
+			portRanges = append(portRanges, portRange{protocol, 111, 111}, portRange{protocol, 2049, 2049}, portRange{protocol, 20001, 20003})
+		}
+	}
+	for _, r := range portRanges {
+		desired.Rules = append(desired.Rules, &awsclient.SecurityGroupRule{
+			Type:       awsclient.SecurityGroupRuleTypeIngress,
+			FromPort:   ptr.To(r.fromPort),
+			ToPort:     ptr.To(r.toPort),
+			Protocol:   r.protocol,
+			CidrBlocks: workerCIDRs,
+		})
+	}
+
+	current, err := FindExisting(ctx, c.state.Get(IdentifierFSxSecurityGroup), c.commonTagsWithSuffix("fsx"),
+		c.client.GetSecurityGroup, c.client.FindSecurityGroupsByTags,
+		func(item *awsclient.SecurityGroup) bool {
+			return item.GroupName == groupName && c.isVpcMatchingState(item.VpcId)
+		})
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		log.Info("creating...")
+		created, err := c.client.CreateSecurityGroup(ctx, desired)
+		if err != nil {
+			return err
+		}
+		if current, err = c.client.GetSecurityGroup(ctx, created.GroupId); err != nil {
+			return err
+		}
+	}
+	c.state.Set(IdentifierFSxSecurityGroup, current.GroupId)
+	_, err = c.updater.UpdateSecurityGroup(ctx, desired, current)
+	return err
+}
+
+func (c *FlowContext) ensureFSxFileSystem(ctx context.Context) error {
+	log := LogFromContext(ctx)
+
+	current, err := FindExisting(ctx, c.state.Get(IdentifierManagedFSxID), c.commonTagsWithSuffix("fsx"),
+		c.client.GetFSxFileSystem, c.client.FindFSxFileSystemsByTags)
+	if err != nil {
+		return fmt.Errorf("failed to find FSx file system: %w", err)
+	}
+
+	if current == nil {
+		zoneName := helper.FSxZone(c.config)
+		subnetID := c.getSubnetZoneChild(zoneName).Get(IdentifierZoneSubnetWorkers)
+		if subnetID == nil {
+			return fmt.Errorf("missing workers subnet of zone %s for FSx file system", zoneName)
+		}
+
+		fsxConfig := c.config.FSx
+		deploymentType := helper.FSxDeploymentType(fsxConfig)
+		input := &fsx.CreateFileSystemInput{
+			ClientRequestToken: ptr.To(c.shootUUID),
+			StorageCapacity:    ptr.To(fsxConfig.StorageCapacityGiB),
+			SubnetIds:          []string{*subnetID},
+			SecurityGroupIds:   []string{*c.state.Get(IdentifierFSxSecurityGroup)},
+			Tags:               c.commonTagsWithSuffix("fsx").ToFSxTags(),
+		}
+		switch fsxConfig.Type {
+		case aws.FSxFileSystemTypeLustre:
+			input.FileSystemType = fsxtypes.FileSystemTypeLustre
+			input.FileSystemTypeVersion = ptr.To("2.15")
+			input.LustreConfiguration = &fsxtypes.CreateFileSystemLustreConfiguration{
+				DeploymentType: fsxtypes.LustreDeploymentType(deploymentType),
+			}
+			if fsxConfig.Lustre != nil {
+				input.LustreConfiguration.PerUnitStorageThroughput = fsxConfig.Lustre.PerUnitStorageThroughput
+			}
+		case aws.FSxFileSystemTypeOpenZFS:
+			input.FileSystemType = fsxtypes.FileSystemTypeOpenzfs
+			input.OpenZFSConfiguration = &fsxtypes.CreateFileSystemOpenZFSConfiguration{
+				DeploymentType:     fsxtypes.OpenZFSDeploymentType(deploymentType),
+				ThroughputCapacity: ptr.To(fsxConfig.OpenZFS.ThroughputCapacity),
+				RootVolumeConfiguration: &fsxtypes.OpenZFSCreateRootVolumeConfiguration{
+					NfsExports: []fsxtypes.OpenZFSNfsExport{{
+						ClientConfigurations: []fsxtypes.OpenZFSClientConfiguration{{
+							Clients: ptr.To("*"),
+							Options: []string{"rw", "crossmnt", "no_root_squash"},
+						}},
+					}},
+				},
+			}
+		}
+
+		log.Info("creating...", "type", fsxConfig.Type, "zone", zoneName)
+		if current, err = c.client.CreateFSxFileSystem(ctx, input); err != nil {
+			return err
+		}
+		log.Info("created FSx file system", "id", *current.FileSystemId)
+	}
+
+	c.state.Set(IdentifierManagedFSxID, *current.FileSystemId)
+	if current.Lifecycle == fsxtypes.FileSystemLifecycleFailed {
+		var reason string
+		if current.FailureDetails != nil {
+			reason = ptr.Deref(current.FailureDetails.Message, "")
+		}
+		return fmt.Errorf("FSx file system %s failed: %s", *current.FileSystemId, reason)
+	}
+	c.state.Set(IdentifierFSxDNSName, ptr.Deref(current.DNSName, ""))
+	if current.LustreConfiguration != nil {
+		c.state.Set(IdentifierFSxMountName, ptr.Deref(current.LustreConfiguration.MountName, ""))
+	}
+	return nil
+}
+
 func (c *FlowContext) getSubnetZoneChildByItem(item *awsclient.Subnet) Whiteboard {
 	return c.getSubnetZoneChild(getZoneName(item))
 }
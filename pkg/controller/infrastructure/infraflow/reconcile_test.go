@@ -0,0 +1,1125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/fake"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("FlowContext", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.Background()
+
+		awsClient     *fake.Client
+		runtimeClient client.Client
+		infra         *extensionsv1alpha1.Infrastructure
+		shoot         *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		awsClient = fake.New()
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{UID: "8f0d3c6e-8d2f-4bd2-9b36-0b0c7d6a6c1e"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:      ptr.To("10.250.0.0/16"),
+					IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4},
+				},
+			},
+		}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:       "eu-west-1",
+				SSHPublicKey: []byte("ssh-rsa AAAA"),
+			},
+		}
+		setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+			Networks: awsv1alpha1.Networks{
+				VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+				Zones: []awsv1alpha1.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+					{Name: "eu-west-1b", Workers: "10.250.64.0/19", Public: "10.250.96.0/20", Internal: "10.250.112.0/20"},
+				},
+			},
+		})
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build()
+	})
+
+	newFlowContext := func() *FlowContext {
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+		state, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+		Expect(err).NotTo(HaveOccurred())
+		fctx, err := NewFlowContext(Opts{
+			Log:            logr.Discard(),
+			Infrastructure: infra,
+			State:          state,
+			AwsClient:      awsClient,
+			RuntimeClient:  runtimeClient,
+			Shoot:          shoot,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	// getStateData returns the persisted state. The state of infra is not decoded if the last patch was skipped.
+	getStateData := func() map[string]string {
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+		state, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+		Expect(err).NotTo(HaveOccurred())
+		return state.Data
+	}
+
+	// setStateData replaces the persisted state, e.g. to simulate an interrupted reconciliation.
+	setStateData := func(data map[string]string) {
+		raw, err := json.Marshal(&awsv1alpha1.InfrastructureState{
+			TypeMeta: metav1.TypeMeta{APIVersion: awsv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureState"},
+			Data:     data,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		infra.Status.State = &runtime.RawExtension{Raw: raw}
+		Expect(runtimeClient.Status().Update(ctx, infra)).To(Succeed())
+	}
+
+	// createExistingVpc creates a VPC which fulfils the requirements of an existing VPC.
+	createExistingVpc := func() *awsclient.VPC {
+		options, err := awsClient.CreateVpcDhcpOptions(ctx, &awsclient.DhcpOptions{DhcpConfigurations: map[string][]string{
+			"domain-name":         {"eu-west-1.compute.internal"},
+			"domain-name-servers": {"AmazonProvidedDNS"},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		vpc, err := awsClient.CreateVpc(ctx, &awsclient.VPC{CidrBlock: "10.250.0.0/16"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(awsClient.AddVpcDhcpOptionAssociation(vpc.VpcId, ptr.To(options.DhcpOptionsId))).To(Succeed())
+		Expect(awsClient.UpdateVpcAttribute(ctx, vpc.VpcId, "enableDnsHostnames", true)).To(Succeed())
+		igw, err := awsClient.CreateInternetGateway(ctx, &awsclient.InternetGateway{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(awsClient.AttachInternetGateway(ctx, vpc.VpcId, igw.InternetGatewayId)).To(Succeed())
+		return vpc
+	}
+
+	reconcileAndDelete := func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		resources := awsClient.ResourceIDs()
+		Expect(resources).NotTo(BeEmpty())
+
+		By("reconciling again without changes")
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(Equal(resources))
+
+		By("deleting the infrastructure")
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	}
+
+	It("should create and delete the infrastructure of an IPv4 shoot", func() {
+		setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+			Networks: awsv1alpha1.Networks{
+				VPC: awsv1alpha1.VPC{
+					CIDR:             ptr.To("10.250.0.0/16"),
+					GatewayEndpoints: []string{"s3"},
+				},
+				Zones: []awsv1alpha1.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+					{Name: "eu-west-1b", Workers: "10.250.64.0/19", Public: "10.250.96.0/20", Internal: "10.250.112.0/20"},
+				},
+			},
+			ElasticFileSystem: &awsv1alpha1.ElasticFileSystemConfig{Enabled: true},
+		})
+		Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+		status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.VPC.ID).To(HavePrefix("vpc-"))
+		Expect(status.VPC.Subnets).To(HaveLen(4))
+		Expect(status.ElasticFileSystem.ID).To(HavePrefix("fs-"))
+		Expect(infra.Status.EgressCIDRs).To(HaveLen(2))
+
+		reconcileAndDelete()
+	})
+
+	It("should create and delete the infrastructure of a dual-stack shoot", func() {
+		shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+
+		reconcileAndDelete()
+	})
+
+	It("should create the node role in the partition of the region", func() {
+		awsClient = fake.New(fake.WithRegion("cn-north-1"))
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+		role, err := awsClient.GetIAMRole(ctx, namespace+"-nodes")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role.ARN).To(HavePrefix("arn:aws-cn:iam::"))
+		Expect(role.AssumeRolePolicyDocument).To(ContainSubstring(`"Service": "ec2.amazonaws.com.cn"`))
+	})
+
+	It("should only request quotas for resources which do not exist yet", func() {
+		Expect(newFlowContext().QuotaRequests()).To(ConsistOf(
+			aws.QuotaRequest{Quota: aws.QuotaVPCs, Amount: 1},
+			aws.QuotaRequest{Quota: aws.QuotaElasticIPs, Amount: 1},
+			aws.QuotaRequest{Quota: aws.QuotaElasticIPs, Amount: 1},
+			aws.QuotaRequest{Quota: aws.QuotaNATGateways, Zone: "eu-west-1a", Amount: 1},
+			aws.QuotaRequest{Quota: aws.QuotaNATGateways, Zone: "eu-west-1b", Amount: 1},
+		))
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+		Expect(newFlowContext().QuotaRequests()).To(BeEmpty())
+	})
+
+	It("should switch between egress modes without leaving NAT gateways and elastic IPs behind", func() {
+		setEgress := func(egress *awsv1alpha1.Egress) {
+			setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []awsv1alpha1.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+						{Name: "eu-west-1b", Workers: "10.250.64.0/19", Public: "10.250.96.0/20", Internal: "10.250.112.0/20"},
+					},
+					Egress: egress,
+				},
+			})
+			Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		}
+		natResources := func() []string {
+			var ids []string
+			for _, id := range awsClient.ResourceIDs() {
+				if strings.HasPrefix(id, "nat-gateway/") || strings.HasPrefix(id, "elastic-ip/") {
+					ids = append(ids, id)
+				}
+			}
+			return ids
+		}
+		defaultRouteTargets := func() []string {
+			var targets []string
+			for _, zone := range []string{"eu-west-1a", "eu-west-1b"} {
+				routeTable, err := awsClient.GetRouteTable(ctx, getStateData()[ChildIdZones+shared.Separator+zone+shared.Separator+IdentifierZoneRouteTable])
+				Expect(err).NotTo(HaveOccurred())
+				for _, route := range routeTable.Routes {
+					if ptr.Deref(route.DestinationCidrBlock, "") == "0.0.0.0/0" {
+						targets = append(targets, ptr.Deref(route.NatGatewayId, ""))
+					}
+				}
+			}
+			return targets
+		}
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(natResources()).To(HaveLen(4))
+
+		By("sharing the NAT gateway of the second zone")
+		setEgress(&awsv1alpha1.Egress{Mode: awsv1alpha1.EgressModeSingleNATGateway, NATGatewayZone: ptr.To("eu-west-1b")})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(natResources()).To(HaveLen(2))
+		Expect(infra.Status.EgressCIDRs).To(HaveLen(1))
+		targets := defaultRouteTargets()
+		Expect(targets).To(HaveLen(2))
+		Expect(targets[0]).To(HavePrefix("nat-"))
+		Expect(targets[1]).To(Equal(targets[0]))
+		Expect(newFlowContext().QuotaRequests()).To(BeEmpty())
+
+		By("removing the NAT gateway")
+		setEgress(&awsv1alpha1.Egress{Mode: awsv1alpha1.EgressModeNone})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(natResources()).To(BeEmpty())
+		Expect(defaultRouteTargets()).To(BeEmpty())
+
+		By("creating a NAT gateway per zone again")
+		setEgress(nil)
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(natResources()).To(HaveLen(4))
+		Expect(defaultRouteTargets()).To(HaveLen(2))
+
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should allocate the elastic IPs of the NAT gateways from the configured pool", func() {
+		awsClient.SetAddressPool("ipv4pool-ec2-1", 2)
+		setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+			Networks: awsv1alpha1.Networks{
+				VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+				Zones: []awsv1alpha1.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+					{Name: "eu-west-1b", Workers: "10.250.64.0/19", Public: "10.250.96.0/20", Internal: "10.250.112.0/20"},
+				},
+				ElasticIPPool: &awsv1alpha1.ElasticIPPool{PublicIPv4PoolID: ptr.To("ipv4pool-ec2-1")},
+			},
+		})
+		Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+		Expect(awsClient.GetPublicIPv4PoolFreeAddressCount(ctx, "ipv4pool-ec2-1")).To(PointTo(Equal(0)))
+		state := getStateData()
+		for _, zone := range []string{"eu-west-1a", "eu-west-1b"} {
+			eip, err := awsClient.GetElasticIP(ctx, state[ChildIdZones+shared.Separator+zone+shared.Separator+IdentifierManagedZoneNATGWElasticIP])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eip.PublicIPv4Pool).To(PointTo(Equal("ipv4pool-ec2-1")))
+		}
+
+		By("reconciling again without allocating further addresses")
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+	})
+
+	It("should reconcile the secondary elastic IPs of the NAT gateways", func() {
+		setSecondaryElasticIPs := func(secondary *awsv1alpha1.SecondaryElasticIPs) {
+			setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []awsv1alpha1.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20", SecondaryElasticIPs: secondary},
+						{Name: "eu-west-1b", Workers: "10.250.64.0/19", Public: "10.250.96.0/20", Internal: "10.250.112.0/20"},
+					},
+				},
+			})
+			Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		}
+		secondaryAllocationIDs := func() []string {
+			nat, err := awsClient.GetNATGateway(ctx, getStateData()[ChildIdZones+shared.Separator+"eu-west-1a"+shared.Separator+IdentifierZoneNATGateway])
+			Expect(err).NotTo(HaveOccurred())
+			var ids []string
+			for _, address := range nat.SecondaryAddresses {
+				ids = append(ids, address.AllocationId)
+			}
+			return ids
+		}
+		elasticIPs := func() []string {
+			var ids []string
+			for _, id := range awsClient.ResourceIDs() {
+				if strings.HasPrefix(id, "elastic-ip/") {
+					ids = append(ids, id)
+				}
+			}
+			return ids
+		}
+
+		setSecondaryElasticIPs(&awsv1alpha1.SecondaryElasticIPs{Count: ptr.To[int32](2)})
+		Expect(newFlowContext().QuotaRequests()).To(ContainElement(aws.QuotaRequest{Quota: aws.QuotaElasticIPs, Amount: 2}))
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(secondaryAllocationIDs()).To(HaveLen(2))
+		Expect(elasticIPs()).To(HaveLen(4))
+		Expect(infra.Status.EgressCIDRs).To(HaveLen(4))
+
+		By("reducing the number of secondary elastic IPs")
+		setSecondaryElasticIPs(&awsv1alpha1.SecondaryElasticIPs{Count: ptr.To[int32](1)})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(secondaryAllocationIDs()).To(HaveLen(1))
+		Expect(elasticIPs()).To(HaveLen(3))
+		Expect(infra.Status.EgressCIDRs).To(HaveLen(3))
+
+		By("switching to existing elastic IPs")
+		existing, err := awsClient.CreateElasticIP(ctx, &awsclient.ElasticIP{Vpc: true})
+		Expect(err).NotTo(HaveOccurred())
+		setSecondaryElasticIPs(&awsv1alpha1.SecondaryElasticIPs{AllocationIDs: []string{existing.AllocationId}})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(secondaryAllocationIDs()).To(ConsistOf(existing.AllocationId))
+		Expect(elasticIPs()).To(HaveLen(3))
+		Expect(getStateData()).NotTo(HaveKey(ChildIdZones + shared.Separator + "eu-west-1a" + shared.Separator + IdentifierManagedZoneNATGWSecondaryElasticIPs))
+
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(ConsistOf("elastic-ip/" + existing.AllocationId))
+	})
+
+	It("should reconcile the settings and access points of the EFS", func() {
+		setElasticFileSystem := func(config *awsv1alpha1.ElasticFileSystemConfig) {
+			setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []awsv1alpha1.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+					},
+				},
+				ElasticFileSystem: config,
+			})
+			Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		}
+		efsStatus := func() awsapi.ElasticFileSystemStatus {
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+			Expect(err).NotTo(HaveOccurred())
+			return status.ElasticFileSystem
+		}
+
+		setElasticFileSystem(&awsv1alpha1.ElasticFileSystemConfig{
+			Enabled:          true,
+			PerformanceMode:  ptr.To(awsv1alpha1.EFSPerformanceModeMaxIO),
+			KMSKeyID:         ptr.To("alias/efs"),
+			AutomaticBackups: ptr.To(true),
+			AccessPoints: []awsv1alpha1.EFSAccessPoint{
+				{Name: "data", Path: "/data", PosixUser: &awsv1alpha1.EFSPosixUser{UID: 1000, GID: 1000}},
+				{Name: "logs", Path: "/logs"},
+			},
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		status := efsStatus()
+		fs, err := awsClient.GetFileSystem(ctx, status.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.PerformanceMode).To(Equal(efstypes.PerformanceModeMaxIo))
+		Expect(fs.KmsKeyId).To(Equal(ptr.To("alias/efs")))
+		Expect(status.AccessPoints).To(HaveLen(2))
+		dataAccessPointID := status.AccessPoints[0].ID
+
+		By("updating the settings and access points in place")
+		setElasticFileSystem(&awsv1alpha1.ElasticFileSystemConfig{
+			Enabled:                      true,
+			PerformanceMode:              ptr.To(awsv1alpha1.EFSPerformanceModeMaxIO),
+			KMSKeyID:                     ptr.To("alias/efs"),
+			ThroughputMode:               ptr.To(awsv1alpha1.EFSThroughputModeProvisioned),
+			ProvisionedThroughputInMibps: ptr.To[int32](64),
+			LifecyclePolicy:              &awsv1alpha1.EFSLifecyclePolicy{TransitionToIA: ptr.To("AFTER_30_DAYS")},
+			AutomaticBackups:             ptr.To(false),
+			AccessPoints: []awsv1alpha1.EFSAccessPoint{
+				{Name: "data", Path: "/data", PosixUser: &awsv1alpha1.EFSPosixUser{UID: 1000, GID: 1000}},
+				{Name: "cache", Path: "/cache", Permissions: ptr.To("0700")},
+			},
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		fs, err = awsClient.GetFileSystem(ctx, status.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.ThroughputMode).To(Equal(efstypes.ThroughputModeProvisioned))
+		Expect(fs.ProvisionedThroughputInMibps).To(Equal(ptr.To[float64](64)))
+		policies, err := awsClient.DescribeLifecycleConfiguration(ctx, &efs.DescribeLifecycleConfigurationInput{FileSystemId: &status.ID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies).To(ConsistOf(efstypes.LifecyclePolicy{TransitionToIA: efstypes.TransitionToIARulesAfter30Days}))
+		backupPolicy, err := awsClient.DescribeBackupPolicy(ctx, &efs.DescribeBackupPolicyInput{FileSystemId: &status.ID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backupPolicy.Status).To(Equal(efstypes.StatusDisabled))
+		Expect(efsStatus().AccessPoints).To(ConsistOf(
+			awsapi.EFSAccessPointStatus{Name: "data", ID: dataAccessPointID},
+			MatchFields(IgnoreExtras, Fields{"Name": Equal("cache")}),
+		))
+
+		By("replacing an access point whose configuration has changed")
+		setElasticFileSystem(&awsv1alpha1.ElasticFileSystemConfig{
+			Enabled:         true,
+			PerformanceMode: ptr.To(awsv1alpha1.EFSPerformanceModeMaxIO),
+			KMSKeyID:        ptr.To("alias/efs"),
+			AccessPoints: []awsv1alpha1.EFSAccessPoint{
+				{Name: "data", Path: "/data", PosixUser: &awsv1alpha1.EFSPosixUser{UID: 2000, GID: 2000}},
+			},
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(efsStatus().AccessPoints).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Name": Equal("data"),
+			"ID":   Not(Equal(dataAccessPointID)),
+		})))
+
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should create, remove and delete the FSx file system", func() {
+		setFSx := func(config *awsv1alpha1.FSxConfig) {
+			setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []awsv1alpha1.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+						{Name: "eu-west-1b", Workers: "10.250.64.0/19", Public: "10.250.96.0/20", Internal: "10.250.112.0/20"},
+					},
+				},
+				FSx: config,
+			})
+			Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		}
+		infraStatus := func() *awsapi.InfrastructureStatus {
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+			Expect(err).NotTo(HaveOccurred())
+			return status
+		}
+		fsxResourceIDs := func() []string {
+			var ids []string
+			for _, id := range awsClient.ResourceIDs() {
+				if strings.HasPrefix(id, "fsx-file-system/") {
+					ids = append(ids, id)
+				}
+			}
+			return ids
+		}
+
+		setFSx(&awsv1alpha1.FSxConfig{
+			Type:               awsv1alpha1.FSxFileSystemTypeLustre,
+			Zone:               ptr.To("eu-west-1b"),
+			StorageCapacityGiB: 1200,
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		status := infraStatus()
+		Expect(status.FSx).NotTo(BeNil())
+		Expect(status.FSx.Type).To(Equal(awsapi.FSxFileSystemTypeLustre))
+		Expect(status.FSx.DNSName).NotTo(BeEmpty())
+		Expect(status.FSx.MountName).NotTo(BeEmpty())
+		subnet, err := helper.FindSubnetForPurposeAndZone(status.VPC.Subnets, awsapi.PurposeNodes, "eu-west-1b")
+		Expect(err).NotTo(HaveOccurred())
+		fs, err := awsClient.GetFSxFileSystem(ctx, status.FSx.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.SubnetIds).To(ConsistOf(subnet.ID))
+		Expect(fs.LustreConfiguration.DeploymentType).To(Equal(fsxtypes.LustreDeploymentTypeScratch2))
+		group, err := awsClient.GetSecurityGroup(ctx, status.FSx.SecurityGroupID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(group.Rules).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":       Equal(awsclient.SecurityGroupRuleTypeIngress),
+			"FromPort":   Equal(ptr.To[int32](988)),
+			"CidrBlocks": ConsistOf("10.250.0.0/19", "10.250.64.0/19"),
+		}))))
+
+		By("removing the file system")
+		setFSx(nil)
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(infraStatus().FSx).To(BeNil())
+		Expect(fsxResourceIDs()).To(BeEmpty())
+		group, err = awsClient.GetSecurityGroup(ctx, status.FSx.SecurityGroupID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(group).To(BeNil())
+
+		By("creating an OpenZFS file system")
+		setFSx(&awsv1alpha1.FSxConfig{
+			Type:               awsv1alpha1.FSxFileSystemTypeOpenZFS,
+			StorageCapacityGiB: 64,
+			OpenZFS:            &awsv1alpha1.FSxOpenZFSConfig{ThroughputCapacity: 64},
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(infraStatus().FSx).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":      Equal(awsapi.FSxFileSystemTypeOpenZFS),
+			"MountName": BeEmpty(),
+		})))
+		Expect(fsxResourceIDs()).To(HaveLen(1))
+
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should create, replace and delete the flow logs of a managed VPC", func() {
+		setFlowLogs := func(config *awsv1alpha1.FlowLogs) {
+			setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []awsv1alpha1.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+					},
+				},
+				FlowLogs: config,
+			})
+			Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		}
+		flowLogsRole := infra.Namespace + "-flow-logs"
+
+		setFlowLogs(&awsv1alpha1.FlowLogs{
+			CloudWatchLogGroup:     ptr.To("/gardener/flow-logs"),
+			MaxAggregationInterval: ptr.To[int32](60),
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		state := getStateData()
+		flowLog, err := awsClient.GetFlowLog(ctx, state[IdentifierVpcFlowLog])
+		Expect(err).NotTo(HaveOccurred())
+		role, err := awsClient.GetIAMRole(ctx, flowLogsRole)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role).NotTo(BeNil())
+		Expect(flowLog).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"ResourceId":               Equal(state[IdentifierVPC]),
+			"TrafficType":              Equal("ALL"),
+			"LogDestinationType":       Equal("cloud-watch-logs"),
+			"LogGroupName":             Equal(ptr.To("/gardener/flow-logs")),
+			"DeliverLogsPermissionArn": Equal(&role.ARN),
+			"MaxAggregationInterval":   Equal(ptr.To[int32](60)),
+		})))
+		Expect(role.AssumeRolePolicyDocument).To(ContainSubstring(`"aws:SourceAccount": "123456789012"`))
+		policy, err := awsClient.GetIAMRolePolicy(ctx, flowLogsRole, flowLogsRole)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.PolicyDocument).NotTo(ContainSubstring(`"*"`))
+		Expect(policy.PolicyDocument).NotTo(ContainSubstring("logs:CreateLogGroup"))
+		Expect(policy.PolicyDocument).To(ContainSubstring(`"arn:aws:logs:eu-west-1:123456789012:log-group:/gardener/flow-logs:*"`))
+
+		By("keeping the unchanged flow log")
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(getStateData()[IdentifierVpcFlowLog]).To(Equal(flowLog.FlowLogId))
+
+		By("replacing the flow log for a new destination")
+		setFlowLogs(&awsv1alpha1.FlowLogs{
+			S3BucketARN: ptr.To("arn:aws:s3:::my-flow-logs/my-shoot"),
+			TrafficType: ptr.To(awsv1alpha1.FlowLogTrafficTypeReject),
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		state = getStateData()
+		Expect(state[IdentifierVpcFlowLog]).NotTo(Equal(flowLog.FlowLogId))
+		flowLogs, err := awsClient.FindFlowLogsByResourceID(ctx, state[IdentifierVPC])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flowLogs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"FlowLogId":          Equal(state[IdentifierVpcFlowLog]),
+			"TrafficType":        Equal("REJECT"),
+			"LogDestinationType": Equal("s3"),
+			"LogDestination":     Equal(ptr.To("arn:aws:s3:::my-flow-logs/my-shoot")),
+		}))))
+		role, err = awsClient.GetIAMRole(ctx, flowLogsRole)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role).To(BeNil())
+
+		By("removing the flow logs")
+		setFlowLogs(nil)
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(getStateData()).NotTo(HaveKey(IdentifierVpcFlowLog))
+		flowLogs, err = awsClient.FindFlowLogsByResourceID(ctx, state[IdentifierVPC])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flowLogs).To(BeEmpty())
+
+		By("deleting the flow logs together with the VPC")
+		setFlowLogs(&awsv1alpha1.FlowLogs{CloudWatchLogGroup: ptr.To("/gardener/flow-logs")})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should report the flow logs of an existing VPC with a condition", func() {
+		vpc := createExistingVpc()
+		setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+			Networks: awsv1alpha1.Networks{
+				VPC: awsv1alpha1.VPC{ID: ptr.To(vpc.VpcId)},
+				Zones: []awsv1alpha1.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+				},
+			},
+		})
+		Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		flowLogsCondition := func() *gardencorev1beta1.Condition {
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			return gardencorev1beta1helper.GetCondition(infra.Status.Conditions, ConditionTypeExistingVPCFlowLogs)
+		}
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(flowLogsCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("FlowLogsMissing"),
+			"Message": Equal("VPC " + vpc.VpcId + " has no flow logs."),
+		})))
+
+		By("finding the flow logs created by the owner of the VPC")
+		_, err := awsClient.CreateFlowLog(ctx, &awsclient.FlowLog{
+			ResourceId:         vpc.VpcId,
+			TrafficType:        "ALL",
+			LogDestinationType: "s3",
+			LogDestination:     ptr.To("arn:aws:s3:::my-flow-logs"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(flowLogsCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal("FlowLogsFound"),
+		})))
+
+		By("reporting errors of the check")
+		state, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+		Expect(err).NotTo(HaveOccurred())
+		fctx, err := NewFlowContext(Opts{
+			Log:            logr.Discard(),
+			Infrastructure: infra,
+			State:          state,
+			AwsClient:      &failingFlowLogsClient{Client: awsClient},
+			RuntimeClient:  runtimeClient,
+			Shoot:          shoot,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.Reconcile(ctx)).To(Succeed())
+		Expect(flowLogsCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Reason":  Equal("FlowLogsCheckFailed"),
+			"Message": ContainSubstring("UnauthorizedOperation"),
+		})))
+	})
+
+	It("should create, correct and delete the network ACLs of the subnets", func() {
+		setNetworkACLs := func(config *awsv1alpha1.NetworkACLs) {
+			setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []awsv1alpha1.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+					},
+					NetworkACLs: config,
+				},
+			})
+			Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		}
+		subnetACL := func(subnetKey string) *awsclient.NetworkAcl {
+			acl, err := awsClient.FindNetworkAclBySubnetId(ctx, getStateData()[ChildIdZones+shared.Separator+"eu-west-1a"+shared.Separator+subnetKey])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acl).NotTo(BeNil())
+			return acl
+		}
+
+		setNetworkACLs(&awsv1alpha1.NetworkACLs{
+			Workers: &awsv1alpha1.NetworkACL{
+				Ingress: []awsv1alpha1.NetworkACLRule{
+					{RuleNumber: 100, Action: awsv1alpha1.NetworkACLRuleActionAllow, Protocol: "all", CIDR: "10.250.0.0/16"},
+					{RuleNumber: 200, Action: awsv1alpha1.NetworkACLRuleActionAllow, Protocol: "tcp", CIDR: "0.0.0.0/0", FromPort: ptr.To[int32](1024), ToPort: ptr.To[int32](65535)},
+				},
+				Egress: []awsv1alpha1.NetworkACLRule{
+					{RuleNumber: 100, Action: awsv1alpha1.NetworkACLRuleActionAllow, Protocol: "all", CIDR: "0.0.0.0/0"},
+				},
+			},
+			Public: &awsv1alpha1.NetworkACL{
+				Ingress: []awsv1alpha1.NetworkACLRule{
+					{RuleNumber: 100, Action: awsv1alpha1.NetworkACLRuleActionAllow, Protocol: "tcp", CIDR: "0.0.0.0/0", FromPort: ptr.To[int32](443), ToPort: ptr.To[int32](443)},
+				},
+			},
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		state := getStateData()
+		Expect(state).To(HaveKey(IdentifierNetworkACLWorkers))
+		Expect(state).To(HaveKey(IdentifierNetworkACLPublic))
+		Expect(state).NotTo(HaveKey(IdentifierNetworkACLInternal))
+		workersACL := subnetACL(IdentifierZoneSubnetWorkers)
+		Expect(workersACL.NetworkAclId).To(Equal(state[IdentifierNetworkACLWorkers]))
+		Expect(workersACL.Entries).To(ContainElements(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"RuleNumber": Equal(int32(200)),
+				"Egress":     BeFalse(),
+				"Protocol":   Equal("6"),
+				"RuleAction": Equal("allow"),
+				"FromPort":   Equal(ptr.To[int32](1024)),
+				"ToPort":     Equal(ptr.To[int32](65535)),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"RuleNumber": Equal(int32(100)),
+				"Egress":     BeTrue(),
+				"Protocol":   Equal("-1"),
+			})),
+		))
+		Expect(subnetACL(IdentifierZoneSubnetPublic).NetworkAclId).To(Equal(state[IdentifierNetworkACLPublic]))
+		Expect(subnetACL(IdentifierZoneSubnetPrivate).IsDefault).To(BeTrue())
+
+		By("correcting modified entries")
+		Expect(awsClient.DeleteNetworkAclEntry(ctx, workersACL.NetworkAclId, &awsclient.NetworkAclEntry{RuleNumber: 200})).To(Succeed())
+		Expect(awsClient.CreateNetworkAclEntry(ctx, workersACL.NetworkAclId, &awsclient.NetworkAclEntry{
+			RuleNumber: 300, Protocol: "-1", RuleAction: "allow", CidrBlock: ptr.To("0.0.0.0/0"),
+		})).To(Succeed())
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(subnetACL(IdentifierZoneSubnetWorkers).Entries).To(ConsistOf(
+			HaveField("RuleNumber", int32(100)),
+			HaveField("RuleNumber", int32(200)),
+			HaveField("RuleNumber", int32(100)),
+			HaveField("RuleNumber", awsclient.DefaultNetworkAclRuleNumber),
+			HaveField("RuleNumber", awsclient.DefaultNetworkAclRuleNumber),
+		))
+
+		By("restoring the default network ACL of removed purposes")
+		setNetworkACLs(&awsv1alpha1.NetworkACLs{
+			Workers: &awsv1alpha1.NetworkACL{
+				Egress: []awsv1alpha1.NetworkACLRule{
+					{RuleNumber: 100, Action: awsv1alpha1.NetworkACLRuleActionAllow, Protocol: "all", CIDR: "0.0.0.0/0"},
+				},
+			},
+		})
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		state = getStateData()
+		Expect(state).NotTo(HaveKey(IdentifierNetworkACLPublic))
+		Expect(subnetACL(IdentifierZoneSubnetPublic).IsDefault).To(BeTrue())
+		Expect(subnetACL(IdentifierZoneSubnetWorkers).Entries).To(HaveLen(3))
+
+		By("deleting the network ACLs together with the subnets")
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should adopt orphaned resources which are missing in the state", func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		resources := awsClient.ResourceIDs()
+		state := getStateData()
+
+		zoneKey := func(key string) string {
+			return ChildIdZones + shared.Separator + "eu-west-1a" + shared.Separator + key
+		}
+		orphanedKeys := []string{
+			zoneKey(IdentifierZoneSubnetPublic),
+			zoneKey(IdentifierZoneNATGateway),
+			zoneKey(IdentifierManagedZoneNATGWElasticIP),
+			IdentifierInternetGateway,
+		}
+
+		By("losing resources from the state")
+		data := getStateData()
+		for _, key := range orphanedKeys {
+			Expect(data).To(HaveKey(key))
+			delete(data, key)
+		}
+		setStateData(data)
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(Equal(resources))
+		for _, key := range orphanedKeys {
+			Expect(getStateData()).To(HaveKeyWithValue(key, state[key]))
+		}
+
+		By("finding an ambiguous duplicate")
+		eip, err := awsClient.CreateElasticIP(ctx, &awsclient.ElasticIP{
+			Tags: awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"},
+			Vpc:  true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		duplicate, err := awsClient.CreateNATGateway(ctx, &awsclient.NATGateway{
+			Tags:            awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"},
+			EIPAllocationId: eip.AllocationId,
+			SubnetId:        state[zoneKey(IdentifierZoneSubnetPublic)],
+		})
+		Expect(err).NotTo(HaveOccurred())
+		data = getStateData()
+		delete(data, zoneKey(IdentifierZoneNATGateway))
+		setStateData(data)
+
+		err = newFlowContext().Reconcile(ctx)
+		Expect(err).To(MatchError(ErrorMultipleMatches))
+		Expect(err.Error()).To(And(ContainSubstring(state[zoneKey(IdentifierZoneNATGateway)]), ContainSubstring(duplicate.NATGatewayId)))
+		Expect(getStateData()).NotTo(HaveKey(zoneKey(IdentifierZoneNATGateway)))
+	})
+
+	It("should not discover orphaned resources once the infrastructure has been created", func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		state := getStateData()
+
+		By("losing a resource from the state of a failed creation")
+		_, err := awsClient.CreateInternetGateway(ctx, &awsclient.InternetGateway{
+			Tags: awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1", "Name": namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		data := getStateData()
+		delete(data, IdentifierInternetGateway)
+		setStateData(data)
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+		infra.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeCreate,
+			State: gardencorev1beta1.LastOperationStateError,
+		}
+		Expect(runtimeClient.Status().Update(ctx, infra)).To(Succeed())
+
+		Expect(newFlowContext().Reconcile(ctx)).To(MatchError(ErrorMultipleMatches))
+
+		By("losing a resource from the state of a created infrastructure")
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+		infra.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeReconcile,
+			State: gardencorev1beta1.LastOperationStateSucceeded,
+		}
+		Expect(runtimeClient.Status().Update(ctx, infra)).To(Succeed())
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(getStateData()).To(HaveKeyWithValue(IdentifierInternetGateway, state[IdentifierInternetGateway]))
+	})
+
+	It("should adopt orphaned secondary elastic IPs, gateway endpoints, network ACLs and file systems", func() {
+		setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+			Networks: awsv1alpha1.Networks{
+				VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16"), GatewayEndpoints: []string{"s3"}},
+				Zones: []awsv1alpha1.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20",
+						SecondaryElasticIPs: &awsv1alpha1.SecondaryElasticIPs{Count: ptr.To[int32](2)}},
+				},
+				NetworkACLs: &awsv1alpha1.NetworkACLs{Workers: &awsv1alpha1.NetworkACL{
+					Egress: []awsv1alpha1.NetworkACLRule{
+						{RuleNumber: 100, Action: awsv1alpha1.NetworkACLRuleActionAllow, Protocol: "all", CIDR: "0.0.0.0/0"},
+					},
+				}},
+			},
+			ElasticFileSystem: &awsv1alpha1.ElasticFileSystemConfig{Enabled: true},
+			FSx:               &awsv1alpha1.FSxConfig{Type: awsv1alpha1.FSxFileSystemTypeLustre, StorageCapacityGiB: 1200},
+		})
+		Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		resources := awsClient.ResourceIDs()
+		state := getStateData()
+
+		orphanedKeys := []string{
+			ChildIdZones + shared.Separator + "eu-west-1a" + shared.Separator + IdentifierManagedZoneNATGWSecondaryElasticIPs,
+			ChildIdVPCEndpoints + shared.Separator + "s3",
+			IdentifierNetworkACLWorkers,
+			IdentifierManagedEfsID,
+			IdentifierManagedFSxID,
+			IdentifierFSxSecurityGroup,
+		}
+
+		By("losing resources from the state")
+		data := getStateData()
+		for _, key := range orphanedKeys {
+			Expect(data).To(HaveKeyWithValue(key, Not(BeEmpty())))
+			delete(data, key)
+		}
+		setStateData(data)
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(Equal(resources))
+		for _, key := range orphanedKeys {
+			Expect(getStateData()).To(HaveKeyWithValue(key, state[key]))
+		}
+
+		By("finding an ambiguous duplicate")
+		duplicate, err := awsClient.CreateNetworkAcl(ctx, &awsclient.NetworkAcl{
+			Tags:  awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1", "Name": namespace + "-nacl-workers"},
+			VpcId: ptr.To(state[IdentifierVPC]),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		data = getStateData()
+		delete(data, IdentifierNetworkACLWorkers)
+		setStateData(data)
+
+		err = newFlowContext().Reconcile(ctx)
+		Expect(err).To(MatchError(ErrorMultipleMatches))
+		Expect(err.Error()).To(And(ContainSubstring(state[IdentifierNetworkACLWorkers]), ContainSubstring(duplicate.NetworkAclId)))
+		Expect(getStateData()).NotTo(HaveKey(IdentifierNetworkACLWorkers))
+	})
+
+	Context("deletion policy", func() {
+		setDeletionPolicy := func(efs bool, policy *awsv1alpha1.DeletionPolicy) {
+			setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []awsv1alpha1.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+						{Name: "eu-west-1b", Workers: "10.250.64.0/19", Public: "10.250.96.0/20", Internal: "10.250.112.0/20"},
+					},
+				},
+				ElasticFileSystem: &awsv1alpha1.ElasticFileSystemConfig{Enabled: efs},
+				DeletionPolicy:    policy,
+			})
+			Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		}
+		retainedResources := func() []string {
+			events := &corev1.EventList{}
+			Expect(runtimeClient.List(ctx, events, client.InNamespace(namespace))).To(Succeed())
+			var messages []string
+			for _, event := range events.Items {
+				Expect(event.Reason).To(Equal(EventReasonResourcesRetained))
+				Expect(event.InvolvedObject.Name).To(Equal(infra.Name))
+				messages = append(messages, event.Message)
+			}
+			return messages
+		}
+		expectOrphaned := func(tags awsclient.Tags) {
+			ExpectWithOffset(1, tags).To(HaveKeyWithValue(TagKeyOrphanedShoot, "garden-foo/bar"))
+			ExpectWithOffset(1, tags).To(HaveKeyWithValue(TagKeyOrphanedAt, Not(BeEmpty())))
+			ExpectWithOffset(1, tags).NotTo(HaveKey("kubernetes.io/cluster/" + namespace))
+		}
+
+		BeforeEach(func() {
+			shoot.Name = "bar"
+			shoot.Namespace = "garden-foo"
+		})
+
+		It("should keep the retained file system and elastic IPs", func() {
+			setDeletionPolicy(true, &awsv1alpha1.DeletionPolicy{
+				Retain: []awsv1alpha1.RetainedResource{awsv1alpha1.RetainedResourceElasticFileSystem, awsv1alpha1.RetainedResourceElasticIPs},
+			})
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+			Expect(err).NotTo(HaveOccurred())
+			fileSystemID := status.ElasticFileSystem.ID
+			state := getStateData()
+			var allocationIDs []string
+			for _, zone := range []string{"eu-west-1a", "eu-west-1b"} {
+				allocationIDs = append(allocationIDs, state[ChildIdZones+shared.Separator+zone+shared.Separator+IdentifierManagedZoneNATGWElasticIP])
+			}
+			slices.Sort(allocationIDs)
+
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(awsClient.ResourceIDs()).To(ConsistOf(
+				"efs-file-system/"+fileSystemID,
+				"elastic-ip/"+allocationIDs[0],
+				"elastic-ip/"+allocationIDs[1],
+			))
+			for _, allocationID := range allocationIDs {
+				eip, err := awsClient.GetElasticIP(ctx, allocationID)
+				Expect(err).NotTo(HaveOccurred())
+				expectOrphaned(eip.Tags)
+			}
+			fileSystem, err := awsClient.GetFileSystem(ctx, fileSystemID)
+			Expect(err).NotTo(HaveOccurred())
+			tags := awsclient.Tags{}
+			for _, tag := range fileSystem.Tags {
+				tags[*tag.Key] = *tag.Value
+			}
+			expectOrphaned(tags)
+			Expect(retainedResources()).To(ConsistOf(
+				"Retained ElasticFileSystem "+fileSystemID+", they have to be deleted manually.",
+				"Retained ElasticIPs "+strings.Join(allocationIDs, ",")+", they have to be deleted manually.",
+			))
+		})
+
+		It("should keep the retained VPC together with the resources in it", func() {
+			setDeletionPolicy(false, &awsv1alpha1.DeletionPolicy{
+				Retain: []awsv1alpha1.RetainedResource{awsv1alpha1.RetainedResourceVPC},
+			})
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+			Expect(err).NotTo(HaveOccurred())
+			resources := awsClient.ResourceIDs()
+
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(awsClient.ResourceIDs()).To(Equal(slices.DeleteFunc(resources, func(id string) bool {
+				return strings.HasPrefix(id, "iam-") || strings.HasPrefix(id, "key-pair/")
+			})))
+			vpc, err := awsClient.GetVpc(ctx, status.VPC.ID)
+			Expect(err).NotTo(HaveOccurred())
+			expectOrphaned(vpc.Tags)
+			subnets, err := awsClient.FindSubnets(ctx, awsclient.WithFilters().WithVpcId(status.VPC.ID).WithTags(awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"}).Build())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(BeEmpty())
+			natGateways, err := awsClient.FindNATGatewaysByTags(ctx, awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(natGateways).To(BeEmpty())
+			Expect(retainedResources()).To(ConsistOf(And(HavePrefix("Retained VPC "), ContainSubstring(status.VPC.ID))))
+		})
+
+		It("should only delete the file system after the deletion was confirmed", func() {
+			setDeletionPolicy(true, &awsv1alpha1.DeletionPolicy{RequireConfirmation: true})
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			resources := awsClient.ResourceIDs()
+
+			Expect(newFlowContext().Delete(ctx)).To(MatchError(ContainSubstring(aws.AnnotationConfirmStatefulResourceDeletion)))
+			Expect(awsClient.ResourceIDs()).To(Equal(resources))
+
+			shoot.Annotations = map[string]string{aws.AnnotationConfirmStatefulResourceDeletion: "true"}
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(awsClient.ResourceIDs()).To(BeEmpty())
+			Expect(retainedResources()).To(BeEmpty())
+		})
+	})
+
+	It("should tolerate eventually consistent reads", func() {
+		awsClient = fake.New(fake.WithEventualConsistency(1))
+
+		Eventually(func() error {
+			return newFlowContext().Reconcile(ctx)
+		}).WithPolling(0).Should(Succeed())
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should delete load balancers which block the deletion of the VPC", func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+		Expect(err).NotTo(HaveOccurred())
+		awsClient.AddLoadBalancer(fake.LoadBalancer{
+			Name:  "a1b2c3",
+			VpcID: status.VPC.ID,
+			Tags:  map[string]string{"kubernetes.io/cluster/" + namespace: "owned"},
+		})
+
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(BeEmpty())
+	})
+
+	It("should report the resources which block the deletion of the subnets in an existing VPC", func() {
+		vpc := createExistingVpc()
+		existing := awsClient.ResourceIDs()
+
+		setInfrastructureConfig(infra, &awsv1alpha1.InfrastructureConfig{
+			Networks: awsv1alpha1.Networks{
+				VPC: awsv1alpha1.VPC{ID: ptr.To(vpc.VpcId)},
+				Zones: []awsv1alpha1.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.32.0/20", Internal: "10.250.48.0/20"},
+				},
+			},
+		})
+		Expect(runtimeClient.Update(ctx, infra)).To(Succeed())
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+		Expect(err).NotTo(HaveOccurred())
+		var subnetID string
+		for _, subnet := range status.VPC.Subnets {
+			if subnet.Purpose == awsapi.PurposeNodes {
+				subnetID = subnet.ID
+			}
+		}
+		eniID := awsClient.AddNetworkInterface(awsclient.NetworkInterface{
+			SubnetId:      subnetID,
+			InterfaceType: "interface",
+			Description:   "RDSNetworkInterface",
+			RequesterId:   ptr.To("amazon-rds"),
+		})
+
+		By("retrying the deletion during the grace period")
+		err = newFlowContext().Delete(ctx)
+		Expect(err).To(MatchError(ContainSubstring("network-interface %s of amazon-rds uses %s", eniID, subnetID)))
+		Expect(gardencorev1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorRetryableInfraDependencies))
+		status, err = helper.InfrastructureStatusFromInfrastructure(infra)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.DeletionBlockers).To(ConsistOf(awsapi.DeletionBlocker{
+			Type:        "network-interface",
+			ID:          eniID,
+			Owner:       "amazon-rds",
+			Description: "RDSNetworkInterface",
+			Dependency:  subnetID,
+		}))
+		Expect(awsClient.ResourceIDs()).To(ContainElement("subnet/" + subnetID))
+
+		By("stopping to retry after the grace period")
+		data := getStateData()
+		Expect(data).To(HaveKey("DeletionBlockedSince"))
+		data["DeletionBlockedSince"] = "2006-01-02T15:04:05Z"
+		setStateData(data)
+		err = newFlowContext().Delete(ctx)
+		Expect(gardencorev1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraDependencies))
+
+		By("deleting the infrastructure after the blocking resource has been removed")
+		awsClient.RemoveNetworkInterface(eniID)
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(awsClient.ResourceIDs()).To(Equal(existing))
+		status, err = helper.InfrastructureStatusFromInfrastructure(infra)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.DeletionBlockers).To(BeEmpty())
+	})
+})
+
+func setInfrastructureConfig(infra *extensionsv1alpha1.Infrastructure, config *awsv1alpha1.InfrastructureConfig) {
+	config.APIVersion = awsv1alpha1.SchemeGroupVersion.String()
+	config.Kind = "InfrastructureConfig"
+	data, err := json.Marshal(config)
+	Expect(err).NotTo(HaveOccurred())
+	infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: data}
+}
+
+// failingFlowLogsClient is a fake client which is not permitted to read flow logs.
+type failingFlowLogsClient struct {
+	*fake.Client
+}
+
+func (c *failingFlowLogsClient) FindFlowLogsByResourceID(_ context.Context, _ string) ([]*awsclient.FlowLog, error) {
+	return nil, errors.New("UnauthorizedOperation: you are not authorized to perform this operation")
+}
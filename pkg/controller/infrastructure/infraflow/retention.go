@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// EventReasonResourcesRetained is the reason of the events emitted on the infrastructure which list the IDs of the
+// resources retained on its deletion by resource type.
+const EventReasonResourcesRetained = "InfrastructureResourcesRetained"
+
+func (c *FlowContext) retains(resource awsapi.RetainedResource) bool {
+	return c.retained.Has(resource)
+}
+
+// checkDeletionConfirmation returns an error if the deletion policy requires a confirmation for deleting the file
+// systems of the infrastructure and the shoot is not annotated accordingly.
+func (c *FlowContext) checkDeletionConfirmation() error {
+	policy := c.config.DeletionPolicy
+	if policy == nil || !policy.RequireConfirmation || c.shoot.Annotations[aws.AnnotationConfirmStatefulResourceDeletion] == "true" {
+		return nil
+	}
+
+	var ids []string
+	if id := ptr.Deref(c.state.Get(IdentifierManagedEfsID), ""); id != "" && !c.retains(awsapi.RetainedResourceElasticFileSystem) {
+		ids = append(ids, id)
+	}
+	if id := ptr.Deref(c.state.Get(IdentifierManagedFSxID), ""); id != "" {
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return v1beta1helper.NewErrorWithCodes(fmt.Errorf("deletion of file systems %s must be confirmed by annotating the shoot with %s=true",
+		strings.Join(ids, ", "), aws.AnnotationConfirmStatefulResourceDeletion), v1beta1.ErrorConfigurationProblem)
+}
+
+// orphanTags returns the tags which replace the cluster tag of the retained resources.
+func (c *FlowContext) orphanTags() awsclient.Tags {
+	deletedAt := time.Now()
+	if c.infra.DeletionTimestamp != nil {
+		deletedAt = c.infra.DeletionTimestamp.Time
+	}
+	return awsclient.Tags{
+		TagKeyOrphanedShoot: client.ObjectKeyFromObject(c.shoot).String(),
+		TagKeyOrphanedAt:    deletedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// retainEC2Resources replaces the cluster tag of the given EC2 resources by the orphan tags, so that they are not found
+// by the extension anymore, and records them as retained.
+func (c *FlowContext) retainEC2Resources(ctx context.Context, resource awsapi.RetainedResource, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	LogFromContext(ctx).Info("retaining...", "resource", resource, "ids", ids)
+	if err := c.client.CreateEC2Tags(ctx, ids, c.orphanTags()); err != nil {
+		return err
+	}
+	if err := c.client.DeleteEC2Tags(ctx, ids, c.clusterTags()); err != nil {
+		return err
+	}
+	c.recordRetained(resource, ids...)
+	return nil
+}
+
+// retainFileSystem replaces the cluster tag of the EFS file system by the orphan tags and records it as retained.
+func (c *FlowContext) retainFileSystem(ctx context.Context, id string) error {
+	LogFromContext(ctx).Info("retaining...", "efsFileSystem", id)
+	if err := c.client.TagResourceEfs(ctx, &efs.TagResourceInput{
+		ResourceId: ptr.To(id),
+		Tags:       c.orphanTags().ToEfsTags(),
+	}); err != nil {
+		return err
+	}
+	if err := c.client.UntagResourceEfs(ctx, &efs.UntagResourceInput{
+		ResourceId: ptr.To(id),
+		TagKeys:    []string{c.tagKeyCluster()},
+	}); err != nil {
+		return err
+	}
+	c.recordRetained(awsapi.RetainedResourceElasticFileSystem, id)
+	return nil
+}
+
+// retainVPC retains the VPC together with the resources in it which are recorded in the state. They are removed from
+// the state afterward, so that none of the deletion tasks touches them.
+func (c *FlowContext) retainVPC(ctx context.Context) error {
+	var ids []string
+	appendIDs := func(board Whiteboard, keys ...string) {
+		for _, key := range keys {
+			if id := ptr.Deref(board.Get(key), ""); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	appendIDs(c.state, IdentifierVPC, IdentifierDHCPOptions, IdentifierInternetGateway, IdentifierEgressOnlyInternetGateway,
+		IdentifierMainRouteTable, IdentifierNodesSecurityGroup, IdentifierNetworkACLPublic, IdentifierNetworkACLInternal,
+		IdentifierNetworkACLWorkers)
+	endpoints := c.state.GetChild(ChildIdVPCEndpoints)
+	appendIDs(endpoints, endpoints.Keys()...)
+	zones := c.state.GetChild(ChildIdZones)
+	for _, zoneName := range zones.GetChildrenKeys() {
+		child := zones.GetChild(zoneName)
+		appendIDs(child, IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPublic,
+			IdentifierZoneRouteTable, IdentifierZoneNATGateway, IdentifierManagedZoneNATGWElasticIP)
+		ids = append(ids, ManagedSecondaryElasticIPs(child)...)
+	}
+
+	if err := c.retainEC2Resources(ctx, awsapi.RetainedResourceVPC, ids...); err != nil {
+		return err
+	}
+	c.state.Delete(IdentifierVPC)
+	c.state.Delete(IdentifierDHCPOptions)
+	return nil
+}
+
+func (c *FlowContext) recordRetained(resource awsapi.RetainedResource, ids ...string) {
+	child := c.state.GetChild(ChildRetained)
+	for _, id := range ids {
+		child.Set(id, string(resource))
+	}
+}
+
+// recordRetainedResources emits an event on the infrastructure for each type of retained resources listing their IDs.
+// The events are recorded before the finalizer of the infrastructure is removed, afterward the retained resources can
+// only be found by their orphan tags.
+func (c *FlowContext) recordRetainedResources(ctx context.Context) error {
+	retained := c.state.GetChild(ChildRetained).AsMap()
+	if len(retained) == 0 {
+		return nil
+	}
+
+	idsByResource := map[string][]string{}
+	for id, resource := range retained {
+		idsByResource[resource] = append(idsByResource[resource], id)
+	}
+	for _, resource := range slices.Sorted(maps.Keys(idsByResource)) {
+		ids := idsByResource[resource]
+		slices.Sort(ids)
+		now := metav1.Now()
+		event := &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: c.infra.Name + ".",
+				Namespace:    c.infra.Namespace,
+			},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion:      extensionsv1alpha1.SchemeGroupVersion.String(),
+				Kind:            extensionsv1alpha1.InfrastructureResource,
+				Name:            c.infra.Name,
+				Namespace:       c.infra.Namespace,
+				UID:             c.infra.UID,
+				ResourceVersion: c.infra.ResourceVersion,
+			},
+			Reason:         EventReasonResourcesRetained,
+			Message:        fmt.Sprintf("Retained %s %s, they have to be deleted manually.", resource, strings.Join(ids, ",")),
+			Type:           corev1.EventTypeNormal,
+			Source:         corev1.EventSource{Component: aws.Name},
+			FirstTimestamp: now,
+			LastTimestamp:  now,
+			Count:          1,
+		}
+		if err := c.runtimeClient.Create(ctx, event); err != nil {
+			return fmt.Errorf("failed to record retained resources: %w", err)
+		}
+		c.log.Info("retained infrastructure resources", "resource", resource, "ids", ids)
+	}
+	return nil
+}
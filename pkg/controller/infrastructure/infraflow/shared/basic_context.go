@@ -80,6 +80,15 @@ func (c *BasicFlowContext) PersistState(ctx context.Context) error {
 	return c.persistFn(ctx)
 }
 
+// WithPersistorLock runs the given function while the state is not persisted, e.g. to patch the status of the resource
+// from a task without racing with the state persisted after other tasks.
+func (c *BasicFlowContext) WithPersistorLock(fn func() error) error {
+	c.persistorLock.Lock()
+	defer c.persistorLock.Unlock()
+
+	return fn()
+}
+
 // AddTask adds a wrapped task for the given task function and options.
 func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, options ...TaskOption) flow.TaskIDer {
 	allOptions := TaskOption{}
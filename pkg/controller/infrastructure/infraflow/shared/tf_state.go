@@ -8,6 +8,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
 )
@@ -39,6 +41,7 @@ type TFOutput struct {
 
 // TFResource holds the attributes of a terraformer state resource.
 type TFResource struct {
+	Module    string `json:"module,omitempty"`
 	Mode      string `json:"mode"`
 	Type      string `json:"type"`
 	Name      string `json:"name"`
@@ -48,6 +51,7 @@ type TFResource struct {
 
 // TFInstance holds the attributes of a terraformer state resource instance.
 type TFInstance struct {
+	IndexKey            any                    `json:"index_key,omitempty"`
 	SchemaVersion       int                    `json:"schema_version"`
 	Attributes          map[string]interface{} `json:"attributes,omitempty"`
 	SensitiveAttributes []string               `json:"sensitive_attributes,omitempty"`
@@ -160,6 +164,51 @@ func (ts *TerraformState) GetManagedResourceInstances(tfType string) map[string]
 	return result
 }
 
+// GetManagedResourceInstanceAttributeByAddress returns the value of the given attribute key of the instance of a managed
+// resource with the given address, e.g. `module.network.aws_subnet.nodes["eu-west-1a"]`. Addresses without index refer
+// to the only instance of a resource.
+// It returns nil if either the resource or instance is not found or the attribute key is not existing.
+func (ts *TerraformState) GetManagedResourceInstanceAttributeByAddress(address, attributeKey string) (*string, error) {
+	module, tfType, resourceName, index, err := parseResourceAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range ts.Resources {
+		resource := &ts.Resources[i]
+		if resource.Mode != ModeManaged || resource.Module != module || resource.Type != tfType || resource.Name != resourceName {
+			continue
+		}
+		for _, instance := range resource.Instances {
+			if (index == nil && len(resource.Instances) == 1) || (index != nil && instance.IndexKey != nil && fmt.Sprint(instance.IndexKey) == *index) {
+				if value, ok := AttributeAsString(instance.Attributes, attributeKey); ok {
+					return &value, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// parseResourceAddress splits the given resource address into the module path, the type and name of the resource and
+// the index of the instance.
+func parseResourceAddress(address string) (module, tfType, resourceName string, index *string, err error) {
+	if i := strings.LastIndex(address, "["); i > 0 && strings.HasSuffix(address, "]") {
+		key := address[i+1 : len(address)-1]
+		if unquoted, err := strconv.Unquote(key); err == nil {
+			key = unquoted
+		}
+		index = &key
+		address = address[:i]
+	}
+
+	parts := strings.Split(address, ".")
+	if len(parts) < 2 || len(parts)%2 != 0 {
+		return "", "", "", nil, fmt.Errorf("invalid resource address %q", address)
+	}
+	return strings.Join(parts[:len(parts)-2], "."), parts[len(parts)-2], parts[len(parts)-1], index, nil
+}
+
 // AttributeAsString returns the string value for the given key. `found` is only true if the map contains the key and the value is a string.
 func AttributeAsString(attributes map[string]interface{}, key string) (svalue string, found bool) {
 	if attributes == nil {
@@ -48,8 +48,54 @@ var _ = Describe("TerraformState", func() {
 				"routetable_private_utility_z0": "rtb-77777",
 			}))
 	})
+
+	It("should find managed resource instances by address", func() {
+		tf, err := shared.UnmarshalTerraformState([]byte(tfstateWithModules))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tf.GetManagedResourceInstanceAttributeByAddress("aws_vpc.main", "id")).To(Equal(ptr.To("vpc-1")))
+		Expect(tf.GetManagedResourceInstanceAttributeByAddress(`module.network.aws_subnet.nodes["eu-west-1a"]`, "id")).To(Equal(ptr.To("subnet-a")))
+		Expect(tf.GetManagedResourceInstanceAttributeByAddress("module.network.aws_nat_gateway.nat[1]", "id")).To(Equal(ptr.To("nat-1")))
+		Expect(tf.GetManagedResourceInstanceAttributeByAddress("module.network.aws_subnet.nodes", "id")).To(BeNil())
+		Expect(tf.GetManagedResourceInstanceAttributeByAddress("aws_subnet.nodes[\"eu-west-1a\"]", "id")).To(BeNil())
+
+		_, err = tf.GetManagedResourceInstanceAttributeByAddress("module.aws_vpc.main", "id")
+		Expect(err).To(HaveOccurred())
+	})
 })
 
+const tfstateWithModules = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_vpc",
+      "name": "main",
+      "instances": [{"attributes": {"id": "vpc-1"}}]
+    },
+    {
+      "module": "module.network",
+      "mode": "managed",
+      "type": "aws_subnet",
+      "name": "nodes",
+      "instances": [
+        {"index_key": "eu-west-1a", "attributes": {"id": "subnet-a"}},
+        {"index_key": "eu-west-1b", "attributes": {"id": "subnet-b"}}
+      ]
+    },
+    {
+      "module": "module.network",
+      "mode": "managed",
+      "type": "aws_nat_gateway",
+      "name": "nat",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "nat-0"}},
+        {"index_key": 1, "attributes": {"id": "nat-1"}}
+      ]
+    }
+  ]
+}`
+
 const tfstate = `{
   "version": 4,
   "terraform_version": "0.15.5",
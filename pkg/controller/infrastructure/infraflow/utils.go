@@ -13,12 +13,14 @@ import (
 	"time"
 
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
 	"go.uber.org/atomic"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
@@ -230,6 +232,69 @@ func mountTargetsContainSubnet(mountTargets []efstypes.MountTargetDescription, s
 	return false, ""
 }
 
+func efsAccessPointSuffix(name string) string {
+	return "efs-ap-" + name
+}
+
+func efsTagValue(tags []efstypes.Tag, key string) string {
+	for _, tag := range tags {
+		if ptr.Deref(tag.Key, "") == key {
+			return ptr.Deref(tag.Value, "")
+		}
+	}
+	return ""
+}
+
+// efsAccessPointMatches checks if the root directory and POSIX user of the access point match the desired ones.
+func efsAccessPointMatches(current *efstypes.AccessPointDescription, desired *efs.CreateAccessPointInput) bool {
+	if current.RootDirectory == nil || ptr.Deref(current.RootDirectory.Path, "/") != ptr.Deref(desired.RootDirectory.Path, "/") {
+		return false
+	}
+	info, desiredInfo := current.RootDirectory.CreationInfo, desired.RootDirectory.CreationInfo
+	if info == nil || !ptr.Equal(info.OwnerUid, desiredInfo.OwnerUid) || !ptr.Equal(info.OwnerGid, desiredInfo.OwnerGid) ||
+		!ptr.Equal(info.Permissions, desiredInfo.Permissions) {
+		return false
+	}
+	if current.PosixUser == nil || desired.PosixUser == nil {
+		return current.PosixUser == nil && desired.PosixUser == nil
+	}
+	return ptr.Equal(current.PosixUser.Uid, desired.PosixUser.Uid) &&
+		ptr.Equal(current.PosixUser.Gid, desired.PosixUser.Gid) &&
+		sets.New(current.PosixUser.SecondaryGids...).Equal(sets.New(desired.PosixUser.SecondaryGids...))
+}
+
+// efsLifecyclePolicies converts the lifecycle policy of the configuration. Every transition is a separate policy.
+func efsLifecyclePolicies(policy *awsapi.EFSLifecyclePolicy) []efstypes.LifecyclePolicy {
+	policies := []efstypes.LifecyclePolicy{}
+	if policy.TransitionToIA != nil {
+		policies = append(policies, efstypes.LifecyclePolicy{TransitionToIA: efstypes.TransitionToIARules(*policy.TransitionToIA)})
+	}
+	if policy.TransitionToArchive != nil {
+		policies = append(policies, efstypes.LifecyclePolicy{TransitionToArchive: efstypes.TransitionToArchiveRules(*policy.TransitionToArchive)})
+	}
+	if policy.TransitionToPrimaryStorageClass != nil {
+		policies = append(policies, efstypes.LifecyclePolicy{TransitionToPrimaryStorageClass: efstypes.TransitionToPrimaryStorageClassRules(*policy.TransitionToPrimaryStorageClass)})
+	}
+	return policies
+}
+
+// mergeEfsLifecyclePolicies merges the lifecycle policies into one to compare them independently of their order.
+func mergeEfsLifecyclePolicies(policies []efstypes.LifecyclePolicy) efstypes.LifecyclePolicy {
+	var merged efstypes.LifecyclePolicy
+	for _, policy := range policies {
+		if policy.TransitionToIA != "" {
+			merged.TransitionToIA = policy.TransitionToIA
+		}
+		if policy.TransitionToArchive != "" {
+			merged.TransitionToArchive = policy.TransitionToArchive
+		}
+		if policy.TransitionToPrimaryStorageClass != "" {
+			merged.TransitionToPrimaryStorageClass = policy.TransitionToPrimaryStorageClass
+		}
+	}
+	return merged
+}
+
 // BuildInfrastructureStatus constructs an InfrastructureStatus from flow state and config.
 func BuildInfrastructureStatus(
 	state shared.Whiteboard,
@@ -248,6 +313,7 @@ func BuildInfrastructureStatus(
 	iamInstanceProfileName := ptr.Deref(state.Get(NameIAMInstanceProfile), "")
 	arnIAMRole := ptr.Deref(state.Get(ARNIAMRole), "")
 	efsID := ptr.Deref(state.Get(IdentifierManagedEfsID), "")
+	fsxID := ptr.Deref(state.Get(IdentifierManagedFSxID), "")
 
 	// config overrides
 	if cfg != nil {
@@ -325,6 +391,25 @@ func BuildInfrastructureStatus(
 
 	if efsID != "" {
 		status.ElasticFileSystem.ID = efsID
+		accessPoints := state.GetChild(ChildEfsAccessPoints)
+		for _, name := range accessPoints.Keys() {
+			if id := accessPoints.Get(name); id != nil {
+				status.ElasticFileSystem.AccessPoints = append(status.ElasticFileSystem.AccessPoints, awsv1alpha1.EFSAccessPointStatus{
+					Name: name,
+					ID:   *id,
+				})
+			}
+		}
+	}
+
+	if fsxID != "" && cfg != nil && cfg.FSx != nil {
+		status.FSx = &awsv1alpha1.FSxStatus{
+			ID:              fsxID,
+			Type:            awsv1alpha1.FSxFileSystemType(cfg.FSx.Type),
+			DNSName:         ptr.Deref(state.Get(IdentifierFSxDNSName), ""),
+			MountName:       ptr.Deref(state.Get(IdentifierFSxMountName), ""),
+			SecurityGroupID: ptr.Deref(state.Get(IdentifierFSxSecurityGroup), ""),
+		}
 	}
 
 	return status
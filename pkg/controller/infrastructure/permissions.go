@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// ConditionTypeCredentialsPermissions is the type of the infrastructure condition reporting whether the credentials
+	// are permitted to perform the actions the reconciliation of the infrastructure needs.
+	ConditionTypeCredentialsPermissions v1beta1.ConditionType = "CredentialsPermissions"
+	// AnnotationPermissionsChecksum is the annotation of the infrastructure with the checksum of the credentials and
+	// features the permissions were last checked for.
+	AnnotationPermissionsChecksum = "aws.provider.extensions.gardener.cloud/permissions-checksum"
+)
+
+// CheckPermissions simulates the policies of the credentials for the actions the reconciliation of the infrastructure
+// needs, so that missing permissions are found before a partial infrastructure is created. The check only runs if the
+// credentials or the features of the infrastructure changed since the last check. Missing permissions are reported in
+// the CredentialsPermissions condition of the infrastructure, the reconciliation only fails if the shoot is annotated
+// with AnnotationEnforcePermissionCheck.
+func CheckPermissions(ctx context.Context, log logr.Logger, c client.Client, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster, authConfig *awsclient.AuthConfig, awsClient awsclient.Interface) error {
+	infrastructureConfig, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return err
+	}
+
+	var ipFamilies []v1beta1.IPFamily
+	if networking := cluster.Shoot.Spec.Networking; networking != nil {
+		ipFamilies = networking.IPFamilies
+	}
+	features := aws.InfrastructureFeatures(infrastructureConfig, ipFamilies, infra.Spec.SSHPublicKey)
+	enforce, _ := strconv.ParseBool(cluster.Shoot.Annotations[aws.AnnotationEnforcePermissionCheck])
+
+	checksum, err := permissionsChecksum(authConfig, features, enforce)
+	if err != nil {
+		return err
+	}
+	if infra.Annotations[AnnotationPermissionsChecksum] == checksum {
+		return nil
+	}
+
+	var missing *aws.MissingPermissionsError
+	err = aws.CheckPermissions(ctx, awsClient, awsclient.PolicySimulationContext{
+		Region: infra.Spec.Region,
+		Tags:   awsclient.Tags{"kubernetes.io/cluster/" + infra.Namespace: "1"},
+	}, features...)
+	if err != nil && !errors.As(err, &missing) {
+		return err
+	}
+
+	status, reason, message := v1beta1.ConditionTrue, "PermissionsSufficient", "The credentials are permitted to perform the actions needed by the infrastructure."
+	if missing != nil {
+		log.Info("Credentials are missing permissions", "principal", missing.PrincipalARN, "enforced", enforce)
+		status, reason, message = v1beta1.ConditionFalse, "PermissionsMissing", missing.Error()
+	}
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, infra.Status.Conditions, ConditionTypeCredentialsPermissions)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, status, reason, message)
+	statusPatch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, condition)
+	if err := c.Status().Patch(ctx, infra, statusPatch); err != nil {
+		return fmt.Errorf("failed to report the permissions of the credentials: %w", err)
+	}
+
+	if missing != nil && enforce {
+		return v1beta1helper.NewErrorWithCodes(missing, v1beta1.ErrorInfraUnauthorized)
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	metav1.SetMetaDataAnnotation(&infra.ObjectMeta, AnnotationPermissionsChecksum, checksum)
+	return c.Patch(ctx, infra, patch)
+}
+
+// permissionsChecksum returns the checksum of the identity of the credentials and the features they are checked for.
+func permissionsChecksum(authConfig *awsclient.AuthConfig, features []aws.Feature, enforce bool) (string, error) {
+	identity := struct {
+		Region           string
+		AccessKeyID      string
+		WorkloadIdentity string
+		AssumeRoleChain  []awsclient.AssumeRole
+		Features         []aws.Feature
+		Enforce          bool
+	}{
+		Region:          authConfig.Region,
+		AssumeRoleChain: authConfig.AssumeRoleChain,
+		Features:        features,
+		Enforce:         enforce,
+	}
+	if authConfig.AccessKey != nil {
+		identity.AccessKeyID = authConfig.AccessKey.ID
+	}
+	if authConfig.WorkloadIdentity != nil {
+		identity.WorkloadIdentity = authConfig.WorkloadIdentity.RoleARN
+	}
+	data, err := json.Marshal(identity)
+	if err != nil {
+		return "", err
+	}
+	return utils.ComputeSHA256Hex(data), nil
+}
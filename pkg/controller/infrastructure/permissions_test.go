@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/fake"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure"
+)
+
+var _ = Describe("Permissions", func() {
+	var (
+		ctx           = context.Background()
+		runtimeClient client.Client
+		awsClient     *fake.Client
+		infra         *extensionsv1alpha1.Infrastructure
+		cluster       *controller.Cluster
+		authConfig    *awsclient.AuthConfig
+	)
+
+	BeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec:  extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","enableECRAccess":false}`)}},
+				Region:       "eu-west-1",
+				SSHPublicKey: []byte("ssh-rsa AAAA"),
+			},
+		}
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build()
+		awsClient = fake.New()
+		cluster = &controller.Cluster{Shoot: &gardencorev1beta1.Shoot{}}
+		authConfig = &awsclient.AuthConfig{Region: "eu-west-1", AccessKey: &awsclient.AccessKey{ID: "AKIA1", Secret: "secret"}}
+	})
+
+	check := func() error {
+		return CheckPermissions(ctx, logr.Discard(), runtimeClient, infra, cluster, authConfig, awsClient)
+	}
+	condition := func() *gardencorev1beta1.Condition {
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+		return v1beta1helper.GetCondition(infra.Status.Conditions, ConditionTypeCredentialsPermissions)
+	}
+
+	It("should simulate the policies in the region with the cluster tag", func() {
+		Expect(check()).To(Succeed())
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal("PermissionsSufficient"),
+		})))
+		Expect(awsClient.SimulationContexts()).To(ConsistOf(awsclient.PolicySimulationContext{
+			Region: "eu-west-1",
+			Tags:   awsclient.Tags{"kubernetes.io/cluster/shoot--foo--bar": "1"},
+		}))
+	})
+
+	It("should only report missing permissions in a condition", func() {
+		awsClient.DenyActions("iam:PassRole")
+
+		Expect(check()).To(Succeed())
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("PermissionsMissing"),
+			"Message": ContainSubstring("common: iam:PassRole"),
+		})))
+	})
+
+	It("should fail for missing permissions if the check is enforced", func() {
+		awsClient.DenyActions("ec2:ImportKeyPair")
+		cluster.Shoot.Annotations = map[string]string{aws.AnnotationEnforcePermissionCheck: "true"}
+
+		err := check()
+		Expect(err).To(MatchError(ContainSubstring("SSH key pair: ec2:ImportKeyPair")))
+		Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraUnauthorized))
+		Expect(condition().Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(infra.Annotations).NotTo(HaveKey(AnnotationPermissionsChecksum))
+
+		By("checking again on the next reconciliation")
+		Expect(check()).To(HaveOccurred())
+		Expect(awsClient.SimulationContexts()).To(HaveLen(2))
+	})
+
+	It("should only check again if the credentials or features change", func() {
+		Expect(check()).To(Succeed())
+		Expect(infra.Annotations).To(HaveKey(AnnotationPermissionsChecksum))
+		Expect(check()).To(Succeed())
+		Expect(awsClient.SimulationContexts()).To(HaveLen(1))
+
+		authConfig.AccessKey.ID = "AKIA2"
+		Expect(check()).To(Succeed())
+		Expect(awsClient.SimulationContexts()).To(HaveLen(2))
+
+		infra.Spec.SSHPublicKey = nil
+		Expect(check()).To(Succeed())
+		Expect(awsClient.SimulationContexts()).To(HaveLen(3))
+	})
+})
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancercleanup
+
+import (
+	"context"
+	"time"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils/predicate"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// ControllerName is the name of the load balancer cleanup controller.
+const ControllerName = "loadbalancercleanup"
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		SyncPeriod:  10 * time.Minute,
+		DrainPeriod: time.Hour,
+	}
+
+	// shootScheme is the scheme of the shoot client, which also reads the DNSEntries of the shoot-dns-service.
+	shootScheme = runtime.NewScheme()
+)
+
+func init() {
+	utilruntime.Must(kubernetesscheme.AddToScheme(shootScheme))
+	utilruntime.Must(dnsv1alpha1.AddToScheme(shootScheme))
+}
+
+// AddOptions are options to apply when adding the AWS load balancer cleanup controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// ExtensionClasses defines the extension classes this extension is responsible for.
+	ExtensionClasses []extensionsv1alpha1.ExtensionClass
+	// SyncPeriod is the period in which the Services of a shoot cluster are checked for superseded load balancers.
+	SyncPeriod time.Duration
+	// DrainPeriod is the period a superseded load balancer must be unreferenced before it is deleted. It gives clients
+	// time to follow DNS changes and established connections time to drain.
+	DrainPeriod time.Duration
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	r := newReconciler(mgr.GetClient(), awsclient.FactoryFunc(awsclient.NewInterface), newShootClient, clock.RealClock{}, opts)
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.ControlPlane{}, builder.WithPredicates(
+			predicate.HasType(aws.Type),
+			predicate.HasClass(opts.ExtensionClasses...),
+		)).
+		WithOptions(opts.Controller).
+		Complete(r)
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
+
+func newShootClient(ctx context.Context, c client.Client, namespace string) (client.Client, error) {
+	_, shootClient, err := util.NewClientForShoot(ctx, c, namespace, client.Options{Scheme: shootScheme}, extensionsconfigv1alpha1.RESTOptions{})
+	return shootClient, err
+}
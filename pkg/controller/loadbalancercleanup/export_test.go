@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancercleanup
+
+// Functions exported for testing.
+
+var NewReconciler = newReconciler
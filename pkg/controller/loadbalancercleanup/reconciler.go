@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancercleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// EventReasonLoadBalancerSuperseded is the reason of the event emitted when a superseded load balancer is no longer
+	// referenced and scheduled for deletion.
+	EventReasonLoadBalancerSuperseded = "SupersededLoadBalancerScheduledForDeletion"
+	// EventReasonLoadBalancerDeleted is the reason of the event emitted when a superseded load balancer was deleted.
+	EventReasonLoadBalancerDeleted = "SupersededLoadBalancerDeleted"
+	// EventReasonLoadBalancerDeletionFailed is the reason of the event emitted when a superseded load balancer could
+	// not be deleted.
+	EventReasonLoadBalancerDeletionFailed = "SupersededLoadBalancerDeletionFailed"
+)
+
+// annotationDNSIgnore is the annotation of the shoot-dns-service to suspend DNS updates for a resource.
+const annotationDNSIgnore = "dns.gardener.cloud/ignore"
+
+type shootClientFunc func(ctx context.Context, c client.Client, namespace string) (client.Client, error)
+
+type reconciler struct {
+	client           client.Client
+	awsClientFactory awsclient.Factory
+	newShootClient   shootClientFunc
+	clock            clock.Clock
+	syncPeriod       time.Duration
+	drainPeriod      time.Duration
+}
+
+func newReconciler(c client.Client, awsClientFactory awsclient.Factory, newShootClient shootClientFunc, clock clock.Clock, opts AddOptions) *reconciler {
+	return &reconciler{
+		client:           c,
+		awsClientFactory: awsClientFactory,
+		newShootClient:   newShootClient,
+		clock:            clock,
+		syncPeriod:       opts.SyncPeriod,
+		drainPeriod:      opts.DrainPeriod,
+	}
+}
+
+// Reconcile deletes the load balancers which were superseded by the dual-stack migration of Services in the shoot
+// cluster of the given ControlPlane once they are no longer referenced.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+	ctx = awsclient.WithCaller(ctx, ControllerName, request.Namespace)
+
+	cp := &extensionsv1alpha1.ControlPlane{}
+	if err := r.client.Get(ctx, request.NamespacedName, cp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if cp.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, cp.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get cluster: %w", err)
+	}
+	if cluster.Shoot == nil || cluster.Shoot.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+	if extensionscontroller.IsHibernationEnabled(cluster) {
+		return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+	}
+
+	shootClient, err := r.newShootClient(ctx, r.client, cp.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create shoot client: %w", err)
+	}
+
+	services := &corev1.ServiceList{}
+	if err := shootClient.List(ctx, services); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not list services in shoot cluster: %w", err)
+	}
+
+	var (
+		superseded []*corev1.Service
+		referenced = sets.New[string]()
+	)
+	for i := range services.Items {
+		service := &services.Items[i]
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			referenced.Insert(strings.ToLower(ingress.Hostname))
+		}
+		if service.Annotations[apisaws.AnnotationOldLoadBalancerName] != "" {
+			superseded = append(superseded, service)
+		}
+	}
+
+	if len(superseded) == 0 {
+		return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+	}
+
+	if err := r.collectReferencedHostnames(ctx, shootClient, cp.Namespace, referenced); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	authConfig, err := aws.GetCredentialsForNamespace(ctx, r.client, cp.Spec.SecretRef, cp.Spec.Region, cp.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get AWS credentials: %w", err)
+	}
+	awsClient, err := r.awsClientFactory.NewClient(*authConfig)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create AWS client: %w", err)
+	}
+
+	var errs []error
+	for _, service := range superseded {
+		if err := r.reconcileService(ctx, log.WithValues("service", client.ObjectKeyFromObject(service)), shootClient, awsClient, cp.Namespace, service, referenced); err != nil {
+			errs = append(errs, fmt.Errorf("failed to clean up superseded load balancer of service %s: %w", client.ObjectKeyFromObject(service), err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+// collectReferencedHostnames adds the hostnames of the shoot's Ingresses, the targets of the shoot's DNSEntries and the
+// values of the shoot's DNSRecords to the given set.
+func (r *reconciler) collectReferencedHostnames(ctx context.Context, shootClient client.Client, namespace string, referenced sets.Set[string]) error {
+	ingresses := &networkingv1.IngressList{}
+	if err := shootClient.List(ctx, ingresses); err != nil {
+		return fmt.Errorf("could not list ingresses in shoot cluster: %w", err)
+	}
+	for _, ingress := range ingresses.Items {
+		for _, lb := range ingress.Status.LoadBalancer.Ingress {
+			referenced.Insert(strings.ToLower(lb.Hostname))
+		}
+	}
+
+	// DNSEntries are only available if the shoot-dns-service extension is enabled for the shoot
+	dnsEntries := &dnsv1alpha1.DNSEntryList{}
+	if err := shootClient.List(ctx, dnsEntries); err != nil && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("could not list dnsentries in shoot cluster: %w", err)
+	}
+	for _, dnsEntry := range dnsEntries.Items {
+		for _, target := range dnsEntry.Spec.Targets {
+			referenced.Insert(strings.ToLower(target))
+		}
+	}
+
+	dnsRecords := &extensionsv1alpha1.DNSRecordList{}
+	if err := r.client.List(ctx, dnsRecords, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("could not list dnsrecords: %w", err)
+	}
+	for _, dnsRecord := range dnsRecords.Items {
+		for _, value := range dnsRecord.Spec.Values {
+			referenced.Insert(strings.ToLower(value))
+		}
+	}
+
+	return nil
+}
+
+func (r *reconciler) reconcileService(
+	ctx context.Context,
+	log logr.Logger,
+	shootClient client.Client,
+	awsClient awsclient.Interface,
+	clusterName string,
+	service *corev1.Service,
+	referenced sets.Set[string],
+) error {
+	hostname := service.Annotations[apisaws.AnnotationOldLoadBalancerName]
+	supersededAt, hasSupersededAt := service.Annotations[apisaws.AnnotationOldLoadBalancerSupersededAt]
+
+	// As long as the load balancer is referenced (this is also the case if the migration is still in progress and the
+	// Service status still points to it) it must be kept. The same applies while DNS updates of the Service are
+	// suspended, as its DNS entries may still point to the old load balancer. The drain period starts again once it
+	// is unreferenced.
+	if referenced.Has(strings.ToLower(hostname)) || service.Annotations[annotationDNSIgnore] == "true" {
+		if hasSupersededAt {
+			log.Info("Superseded load balancer is referenced again, postponing its deletion", "hostname", hostname)
+			return r.patchAnnotations(ctx, shootClient, service, func(annotations map[string]string) {
+				delete(annotations, apisaws.AnnotationOldLoadBalancerSupersededAt)
+			})
+		}
+		return nil
+	}
+
+	now := r.clock.Now()
+	since, err := time.Parse(time.RFC3339, supersededAt)
+	if !hasSupersededAt || err != nil {
+		log.Info("Superseded load balancer is no longer referenced, scheduling its deletion", "hostname", hostname, "drainPeriod", r.drainPeriod)
+		if err := r.patchAnnotations(ctx, shootClient, service, func(annotations map[string]string) {
+			annotations[apisaws.AnnotationOldLoadBalancerSupersededAt] = now.UTC().Format(time.RFC3339)
+		}); err != nil {
+			return err
+		}
+		r.recordEvent(ctx, log, shootClient, service, corev1.EventTypeNormal, EventReasonLoadBalancerSuperseded,
+			fmt.Sprintf("Load balancer %s was superseded and is no longer referenced, it will be deleted after %s", hostname, r.drainPeriod))
+		return nil
+	}
+
+	if now.Before(since.Add(r.drainPeriod)) {
+		return nil
+	}
+
+	gone, found, err := deleteLoadBalancer(ctx, log, awsClient, hostname, clusterName)
+	if err != nil {
+		r.recordEvent(ctx, log, shootClient, service, corev1.EventTypeWarning, EventReasonLoadBalancerDeletionFailed,
+			fmt.Sprintf("Failed to delete superseded load balancer %s: %v", hostname, err))
+		return err
+	}
+	if !gone {
+		log.Info("Waiting for the connections of the superseded load balancer to drain", "hostname", hostname)
+		return nil
+	}
+
+	if err := r.patchAnnotations(ctx, shootClient, service, func(annotations map[string]string) {
+		delete(annotations, apisaws.AnnotationOldLoadBalancerName)
+		delete(annotations, apisaws.AnnotationOldLoadBalancerSupersededAt)
+	}); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Deleted superseded load balancer %s", hostname)
+	if !found {
+		message = fmt.Sprintf("Superseded load balancer %s does not exist or is not owned by the cluster, nothing to delete", hostname)
+	}
+	r.recordEvent(ctx, log, shootClient, service, corev1.EventTypeNormal, EventReasonLoadBalancerDeleted, message)
+	return nil
+}
+
+// deleteLoadBalancer deletes the classic or network load balancer with the given hostname if it is owned by the
+// cluster. The targets are deregistered first and the load balancer is only deleted once their connections have been
+// drained. It returns whether the load balancer is gone and whether it was found.
+func deleteLoadBalancer(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, hostname, clusterName string) (bool, bool, error) {
+	name, err := awsClient.FindKubernetesELBByDNSName(ctx, hostname, clusterName)
+	if err != nil {
+		return false, false, err
+	}
+	if name != "" {
+		if drained, err := awsClient.DrainELB(ctx, name); err != nil || !drained {
+			return false, true, err
+		}
+		log.Info("Deleting superseded load balancer", "hostname", hostname)
+		return true, true, awsClient.DeleteELB(ctx, name)
+	}
+
+	arn, err := awsClient.FindKubernetesELBV2ByDNSName(ctx, hostname, clusterName)
+	if err != nil {
+		return false, false, err
+	}
+	if arn != "" {
+		if drained, err := awsClient.DrainELBV2(ctx, arn); err != nil || !drained {
+			return false, true, err
+		}
+		log.Info("Deleting superseded load balancer", "hostname", hostname)
+		return true, true, awsClient.DeleteELBV2(ctx, arn)
+	}
+
+	return true, false, nil
+}
+
+func (r *reconciler) patchAnnotations(ctx context.Context, shootClient client.Client, service *corev1.Service, mutate func(map[string]string)) error {
+	patch := client.MergeFrom(service.DeepCopy())
+	if service.Annotations == nil {
+		service.Annotations = map[string]string{}
+	}
+	mutate(service.Annotations)
+	return shootClient.Patch(ctx, service, patch)
+}
+
+// recordEvent creates an event for the given Service in the shoot cluster, so that the progress of the cleanup is
+// visible to the shoot owner.
+func (r *reconciler) recordEvent(ctx context.Context, log logr.Logger, shootClient client.Client, service *corev1.Service, eventType, reason, message string) {
+	now := metav1.NewTime(r.clock.Now())
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: service.Name + ".",
+			Namespace:    service.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      "v1",
+			Kind:            "Service",
+			Name:            service.Name,
+			Namespace:       service.Namespace,
+			UID:             service.UID,
+			ResourceVersion: service.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: "aws-" + ControllerName},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if err := shootClient.Create(ctx, event); err != nil {
+		log.Error(err, "Failed to record event", "reason", reason)
+	}
+}
@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancercleanup_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/loadbalancercleanup"
+)
+
+const (
+	namespace   = "shoot--foo--bar"
+	oldHostname = "a1b2c3-123456.eu-west-1.elb.amazonaws.com"
+	newHostname = "svc-1a2b3c.elb.eu-west-1.amazonaws.com"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		seedClient  client.Client
+		shootClient client.Client
+		awsClient   *mockawsclient.MockInterface
+		fakeClock   *testclock.FakeClock
+
+		reconciler reconcile.Reconciler
+		request    = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "control-plane"}}
+		serviceKey = client.ObjectKey{Namespace: "default", Name: "svc"}
+		service    *corev1.Service
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar"}}
+		shootJSON, err := json.Marshal(shoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+			&extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
+					Seed:         runtime.RawExtension{Raw: []byte("{}")},
+					Shoot:        runtime.RawExtension{Raw: shootJSON},
+				},
+			},
+			&extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "control-plane"},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+					SecretRef:   corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+					Region:      "eu-west-1",
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+				Data: map[string][]byte{
+					"accessKeyID":     []byte("access-key-id"),
+					"secretAccessKey": []byte("secret-access-key"),
+				},
+			},
+		).Build()
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   serviceKey.Namespace,
+				Name:        serviceKey.Name,
+				Annotations: map[string]string{apisaws.AnnotationOldLoadBalancerName: oldHostname},
+			},
+			Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{Hostname: newHostname}},
+			}},
+		}
+		shootScheme := runtime.NewScheme()
+		Expect(kubernetes.AddShootSchemeToScheme(shootScheme)).To(Succeed())
+		Expect(dnsv1alpha1.AddToScheme(shootScheme)).To(Succeed())
+		shootClient = fakeclient.NewClientBuilder().WithScheme(shootScheme).WithObjects(service).Build()
+
+		reconciler = NewReconciler(
+			seedClient,
+			awsclient.FactoryFunc(func(awsclient.AuthConfig) (awsclient.Interface, error) { return awsClient, nil }),
+			func(context.Context, client.Client, string) (client.Client, error) { return shootClient, nil },
+			fakeClock,
+			AddOptions{SyncPeriod: time.Minute, DrainPeriod: time.Hour},
+		)
+	})
+
+	expectEvents := func(reasons ...string) {
+		events := &corev1.EventList{}
+		Expect(shootClient.List(ctx, events, client.InNamespace(serviceKey.Namespace))).To(Succeed())
+		var actual []string
+		for _, event := range events.Items {
+			Expect(event.InvolvedObject.Name).To(Equal(serviceKey.Name))
+			actual = append(actual, event.Reason)
+		}
+		Expect(actual).To(ConsistOf(reasons))
+	}
+
+	It("should schedule the deletion of an unreferenced load balancer", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue(apisaws.AnnotationOldLoadBalancerSupersededAt, "2024-01-01T00:00:00Z"))
+		expectEvents(EventReasonLoadBalancerSuperseded)
+	})
+
+	It("should keep a load balancer which is still referenced by the service", func() {
+		service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: oldHostname}}
+		Expect(shootClient.Status().Update(ctx, service)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).NotTo(HaveKey(apisaws.AnnotationOldLoadBalancerSupersededAt))
+		expectEvents()
+	})
+
+	It("should keep a load balancer while DNS updates of the service are suspended", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, "dns.gardener.cloud/ignore", "true")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).NotTo(HaveKey(apisaws.AnnotationOldLoadBalancerSupersededAt))
+		expectEvents()
+	})
+
+	It("should restart the drain period if a DNS record references the load balancer again", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, apisaws.AnnotationOldLoadBalancerSupersededAt, "2023-12-31T00:00:00Z")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "ingress"},
+			Spec:       extensionsv1alpha1.DNSRecordSpec{Values: []string{oldHostname}},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue(apisaws.AnnotationOldLoadBalancerName, oldHostname))
+		Expect(service.Annotations).NotTo(HaveKey(apisaws.AnnotationOldLoadBalancerSupersededAt))
+	})
+
+	It("should keep a load balancer which is still the target of a DNS entry", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, apisaws.AnnotationOldLoadBalancerSupersededAt, "2023-12-31T00:00:00Z")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+		Expect(shootClient.Create(ctx, &dnsv1alpha1.DNSEntry{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "entry"},
+			Spec:       dnsv1alpha1.DNSEntrySpec{DNSName: "app.example.com", Targets: []string{oldHostname}},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue(apisaws.AnnotationOldLoadBalancerName, oldHostname))
+		Expect(service.Annotations).NotTo(HaveKey(apisaws.AnnotationOldLoadBalancerSupersededAt))
+	})
+
+	It("should not delete the load balancer before the drain period has passed", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, apisaws.AnnotationOldLoadBalancerSupersededAt, "2023-12-31T23:30:00Z")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKey(apisaws.AnnotationOldLoadBalancerName))
+		expectEvents()
+	})
+
+	It("should delete a classic load balancer after the drain period", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, apisaws.AnnotationOldLoadBalancerSupersededAt, "2023-12-31T23:00:00Z")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+
+		awsClient.EXPECT().FindKubernetesELBByDNSName(gomock.Any(), oldHostname, namespace).Return("a1b2c3", nil)
+		awsClient.EXPECT().DrainELB(gomock.Any(), "a1b2c3").Return(true, nil)
+		awsClient.EXPECT().DeleteELB(gomock.Any(), "a1b2c3")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).NotTo(HaveKey(apisaws.AnnotationOldLoadBalancerName))
+		Expect(service.Annotations).NotTo(HaveKey(apisaws.AnnotationOldLoadBalancerSupersededAt))
+		expectEvents(EventReasonLoadBalancerDeleted)
+	})
+
+	It("should delete a network load balancer after the drain period", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, apisaws.AnnotationOldLoadBalancerSupersededAt, "2023-12-31T23:00:00Z")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+
+		awsClient.EXPECT().FindKubernetesELBByDNSName(gomock.Any(), oldHostname, namespace).Return("", nil)
+		awsClient.EXPECT().FindKubernetesELBV2ByDNSName(gomock.Any(), oldHostname, namespace).Return("arn:aws:elasticloadbalancing:nlb", nil)
+		awsClient.EXPECT().DrainELBV2(gomock.Any(), "arn:aws:elasticloadbalancing:nlb").Return(true, nil)
+		awsClient.EXPECT().DeleteELBV2(gomock.Any(), "arn:aws:elasticloadbalancing:nlb")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).NotTo(HaveKey(apisaws.AnnotationOldLoadBalancerName))
+		expectEvents(EventReasonLoadBalancerDeleted)
+	})
+
+	It("should not delete the load balancer while its connections are draining", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, apisaws.AnnotationOldLoadBalancerSupersededAt, "2023-12-31T23:00:00Z")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+
+		awsClient.EXPECT().FindKubernetesELBByDNSName(gomock.Any(), oldHostname, namespace).Return("a1b2c3", nil)
+		awsClient.EXPECT().DrainELB(gomock.Any(), "a1b2c3").Return(false, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKey(apisaws.AnnotationOldLoadBalancerName))
+		Expect(service.Annotations).To(HaveKey(apisaws.AnnotationOldLoadBalancerSupersededAt))
+		expectEvents()
+	})
+
+	It("should report a failed deletion", func() {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, apisaws.AnnotationOldLoadBalancerSupersededAt, "2023-12-31T23:00:00Z")
+		Expect(shootClient.Update(ctx, service)).To(Succeed())
+
+		awsClient.EXPECT().FindKubernetesELBByDNSName(gomock.Any(), oldHostname, namespace).Return("", context.DeadlineExceeded)
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed to clean up superseded load balancer of service default/svc")))
+		Expect(result).To(BeZero())
+
+		Expect(shootClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKey(apisaws.AnnotationOldLoadBalancerName))
+		expectEvents(EventReasonLoadBalancerDeletionFailed)
+	})
+})
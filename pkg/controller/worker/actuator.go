@@ -24,24 +24,27 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 type delegateFactory struct {
-	gardenReader client.Reader
-	seedClient   client.Client
-	decoder      runtime.Decoder
-	restConfig   *rest.Config
-	scheme       *runtime.Scheme
+	gardenReader     client.Reader
+	seedClient       client.Client
+	decoder          runtime.Decoder
+	restConfig       *rest.Config
+	scheme           *runtime.Scheme
+	awsClientFactory awsclient.Factory
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actuator {
+func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster, awsClientFactory awsclient.Factory) worker.Actuator {
 	workerDelegate := &delegateFactory{
-		gardenReader: gardenCluster.GetAPIReader(),
-		seedClient:   mgr.GetClient(),
-		decoder:      serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
-		restConfig:   mgr.GetConfig(),
-		scheme:       mgr.GetScheme(),
+		gardenReader:     gardenCluster.GetAPIReader(),
+		seedClient:       mgr.GetClient(),
+		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		restConfig:       mgr.GetConfig(),
+		scheme:           mgr.GetScheme(),
+		awsClientFactory: awsClientFactory,
 	}
 
 	return genericactuator.NewActuator(
@@ -77,6 +80,7 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 
 		seedChartApplier,
 		serverVersion.GitVersion,
+		d.awsClientFactory,
 
 		worker,
 		cluster,
@@ -91,6 +95,7 @@ type WorkerDelegate struct {
 
 	seedChartApplier gardener.ChartApplier
 	serverVersion    string
+	awsClientFactory awsclient.Factory
 
 	cloudProfileConfig *api.CloudProfileConfig
 	cluster            *extensionscontroller.Cluster
@@ -109,6 +114,7 @@ func NewWorkerDelegate(
 
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
+	awsClientFactory awsclient.Factory,
 
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
@@ -124,6 +130,7 @@ func NewWorkerDelegate(
 
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
+		awsClientFactory: awsClientFactory,
 
 		cloudProfileConfig: config,
 		cluster:            cluster,
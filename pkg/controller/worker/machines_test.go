@@ -67,7 +67,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("WorkerDelegate", func() {
-		workerDelegate, _ := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate, _ := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil, nil)
 
 		DescribeTableSubtree("#GenerateMachineDeployments, #DeployMachineClasses", func(isCapabilitiesCloudProfile bool) {
 			var (
@@ -608,7 +608,7 @@ var _ = Describe("Machines", func() {
 				workerPoolHash2, _ = worker.WorkerPoolHash(w.Spec.Pools[1], cluster, nil, nil, nil)
 				workerPoolHash3, _ = worker.WorkerPoolHash(w.Spec.Pools[2], cluster, nil, nil, nil)
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, clusterWithoutImages)
 			})
 
 			expectedUserDataSecretRefRead := func() {
@@ -968,7 +968,7 @@ var _ = Describe("Machines", func() {
 				})
 
 				It("should return machine deployments with AWS CSI Label", func() {
-					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 					expectedUserDataSecretRefRead()
 
@@ -979,7 +979,7 @@ var _ = Describe("Machines", func() {
 				})
 
 				It("should return the expected machine deployments for profile image types", func() {
-					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 					expectedUserDataSecretRefRead()
 
@@ -1059,7 +1059,7 @@ var _ = Describe("Machines", func() {
 					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 						Raw: encode(infrastructureProviderStatus),
 					}
-					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 					for _, machineClass := range machineClasses["machineClasses"].([]map[string]interface{}) {
 						delete(machineClass, "keyName")
@@ -1108,7 +1108,7 @@ var _ = Describe("Machines", func() {
 						})}
 						modifyExpectedMachineClasses(map[string]interface{}{"name": iamInstanceProfileName})
 
-						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 						expectedUserDataSecretRefRead()
 
@@ -1132,7 +1132,7 @@ var _ = Describe("Machines", func() {
 						})}
 						modifyExpectedMachineClasses(map[string]interface{}{"arn": iamInstanceProfileARN})
 
-						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 						expectedUserDataSecretRefRead()
 
@@ -1152,7 +1152,7 @@ var _ = Describe("Machines", func() {
 				It("should return err when the infrastructure provider status cannot be decoded", func() {
 					// Deliberately setting InfrastructureProviderStatus to empty
 					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
-					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 					err := workerDelegate.DeployMachineClasses(context.TODO())
 					Expect(err).To(HaveOccurred())
@@ -1183,7 +1183,7 @@ var _ = Describe("Machines", func() {
 					expectedNodeTemplateCapacity := w.Spec.Pools[0].NodeTemplate.Capacity.DeepCopy()
 					maps.Copy(expectedNodeTemplateCapacity, customResources)
 
-					wd, err := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					wd, err := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 					Expect(err).NotTo(HaveOccurred())
 					expectedUserDataSecretRefRead()
 					_, err = wd.GenerateMachineDeployments(ctx)
@@ -1238,7 +1238,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1250,7 +1250,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&api.InfrastructureStatus{}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1271,7 +1271,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1281,7 +1281,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the ami for this region cannot be found", func() {
 				w.Spec.Region = "another-region"
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1295,7 +1295,7 @@ var _ = Describe("Machines", func() {
 					w.Spec.Pools[0].Architecture = ptr.To(archFAKE)
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1325,7 +1325,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				expectedUserDataSecretRefRead()
 
@@ -1337,7 +1337,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1358,7 +1358,7 @@ var _ = Describe("Machines", func() {
 					NodeConditions:         testNodeConditions,
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				expectedUserDataSecretRefRead()
 
@@ -1383,7 +1383,7 @@ var _ = Describe("Machines", func() {
 					ScaleDownUtilizationThreshold:    ptr.To("0.5"),
 				}
 				w.Spec.Pools[1].ClusterAutoscaler = nil
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				expectedUserDataSecretRefRead()
 
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// checkQuotas fails early if the on-demand vCPU quotas are too small for the minimum number of machines of the worker
+// pools, instead of failing while the machines are created. The quotas are only checked if a worker pool is added or
+// the minimum of a worker pool grows, as other changes do not require additional machines.
+func (w *WorkerDelegate) checkQuotas(ctx context.Context) error {
+	if !w.minimumGrows() {
+		return nil
+	}
+
+	ctx = awsclient.WithCaller(ctx, "worker", w.worker.Namespace)
+	authConfig, err := aws.GetCredentialsForCluster(ctx, w.client, w.worker.Spec.SecretRef, w.worker.Spec.Region, w.cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+	awsClient, err := w.awsClientFactory.NewClient(*authConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create new AWS client: %w", err)
+	}
+
+	instances := map[string]int{}
+	for _, pool := range w.worker.Spec.Pools {
+		instances[pool.MachineType] += int(pool.Minimum)
+	}
+	shootTags := awsclient.Tags{fmt.Sprintf("kubernetes.io/cluster/%s", w.cluster.Shoot.Status.TechnicalID): "1"}
+
+	requests, err := aws.OnDemandVCPURequests(ctx, awsClient, instances, shootTags)
+	if err != nil {
+		return err
+	}
+	var exceeded *aws.QuotaExceededError
+	if err := aws.CheckQuotas(ctx, awsClient, requests); errors.As(err, &exceeded) {
+		return v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorInfraQuotaExceeded)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// minimumGrows returns true if a worker pool was added or the minimum of a worker pool grows compared to the machine
+// deployments of the last reconciliation.
+func (w *WorkerDelegate) minimumGrows() bool {
+	previous := map[string]int32{}
+	for _, pool := range w.worker.Spec.Pools {
+		prefix := fmt.Sprintf("%s-%s-z", w.cluster.Shoot.Status.TechnicalID, pool.Name)
+		for _, machineDeployment := range w.worker.Status.MachineDeployments {
+			if zone, ok := strings.CutPrefix(machineDeployment.Name, prefix); ok && isZoneIndex(zone) {
+				previous[pool.Name] += machineDeployment.Minimum
+			}
+		}
+	}
+
+	// the minimum of an added worker pool grows from zero
+	return slices.ContainsFunc(w.worker.Spec.Pools, func(pool extensionsv1alpha1.WorkerPool) bool {
+		return pool.Minimum > previous[pool.Name]
+	})
+}
+
+func isZoneIndex(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 32)
+	return err == nil
+}
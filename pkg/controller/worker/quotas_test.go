@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/worker"
+)
+
+var _ = Describe("Quotas", func() {
+	const (
+		namespace   = "shoot--foo--bar"
+		technicalID = "shoot--foo--bar"
+	)
+
+	var (
+		ctx       = context.Background()
+		ctrl      *gomock.Controller
+		awsClient *mockawsclient.MockInterface
+
+		worker  *extensionsv1alpha1.Worker
+		cluster *extensionscontroller.Cluster
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+
+		worker = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "worker"},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				SecretRef: corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+				Region:    "eu-west-1",
+				Pools: []extensionsv1alpha1.WorkerPool{
+					{Name: "pool", MachineType: "m5.large", Minimum: 2, Zones: []string{"eu-west-1a", "eu-west-1b"}},
+				},
+			},
+			Status: extensionsv1alpha1.WorkerStatus{
+				MachineDeployments: []extensionsv1alpha1.MachineDeployment{
+					{Name: technicalID + "-pool-z1", Minimum: 1},
+					{Name: technicalID + "-pool-z2", Minimum: 1},
+				},
+			},
+		}
+		cluster = &extensionscontroller.Cluster{
+			Shoot: &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{TechnicalID: technicalID}},
+		}
+	})
+
+	preReconcileHook := func() error {
+		seedClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+			Data: map[string][]byte{
+				"accessKeyID":     []byte("access-key-id"),
+				"secretAccessKey": []byte("secret-access-key"),
+			},
+		}).Build()
+		awsClientFactory := awsclient.FactoryFunc(func(awsclient.AuthConfig) (awsclient.Interface, error) { return awsClient, nil })
+
+		workerDelegate, err := NewWorkerDelegate(seedClient, nil, nil, nil, "", awsClientFactory, worker, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		return workerDelegate.PreReconcileHook(ctx)
+	}
+
+	expectQuotaCheck := func(limit float64) {
+		awsClient.EXPECT().GetInstanceTypeVCPUs(gomock.Any(), []string{"m5.large"}).Return(map[string]int{"m5.large": 2}, nil)
+		awsClient.EXPECT().GetRunningOnDemandVCPUs(gomock.Any(), awsclient.Tags{"kubernetes.io/cluster/" + technicalID: "1"}).Return(map[string]int{"m5.large": 4}, nil)
+		awsClient.EXPECT().GetServiceQuota(gomock.Any(), "ec2", gomock.Any()).Return(ptr.To(limit), nil)
+		awsClient.EXPECT().GetRunningOnDemandVCPUs(gomock.Any(), nil).Return(map[string]int{"m5.large": 4}, nil)
+	}
+
+	It("should not check the quotas if the minimum does not grow", func() {
+		Expect(preReconcileHook()).To(Succeed())
+
+		worker.Spec.Pools[0].Minimum = 1
+		Expect(preReconcileHook()).To(Succeed())
+	})
+
+	It("should not check the quotas for an added worker pool without minimum", func() {
+		worker.Spec.Pools = append(worker.Spec.Pools, extensionsv1alpha1.WorkerPool{Name: "other", MachineType: "m5.large"})
+		Expect(preReconcileHook()).To(Succeed())
+	})
+
+	It("should check the quotas if the minimum grows", func() {
+		worker.Spec.Pools[0].Minimum = 3
+		expectQuotaCheck(8)
+
+		Expect(preReconcileHook()).To(Succeed())
+	})
+
+	It("should check the quotas if a worker pool is added", func() {
+		worker.Spec.Pools = append(worker.Spec.Pools, extensionsv1alpha1.WorkerPool{Name: "pool-z1", MachineType: "m5.large", Minimum: 2})
+		expectQuotaCheck(6)
+
+		err := preReconcileHook()
+		Expect(err).To(MatchError(ContainSubstring("service quota exceeded")))
+		Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded))
+	})
+})
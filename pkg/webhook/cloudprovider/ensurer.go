@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/webhook/cloudprovider"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
@@ -15,6 +17,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
@@ -49,11 +52,10 @@ func (e *ensurer) EnsureCloudProviderSecret(ctx context.Context, gctx gcontext.G
 
 		newSecret.Data[aws.RoleARN] = []byte(workloadIdentityConfig.RoleARN)
 		newSecret.Data[aws.WorkloadIdentityTokenFileKey] = []byte(aws.WorkloadIdentityMountPath + "/token")
-		newSecret.Data[aws.SharedCredentialsFile] = []byte("[default]\n" +
-			fmt.Sprintf("web_identity_token_file=%s\n", aws.WorkloadIdentityMountPath+"/token") +
-			fmt.Sprintf("role_arn=%s\n", workloadIdentityConfig.RoleARN) +
-			fmt.Sprintf("region=%s", cluster.Shoot.Spec.Region),
-		)
+		newSecret.Data[aws.SharedCredentialsFile] = sharedCredentialsFile([]string{
+			fmt.Sprintf("web_identity_token_file=%s", aws.WorkloadIdentityMountPath+"/token"),
+			fmt.Sprintf("role_arn=%s", workloadIdentityConfig.RoleARN),
+		}, workloadIdentityConfig.AssumeRoleChain, cluster.Shoot.Spec.Region)
 		return nil
 	}
 
@@ -65,11 +67,53 @@ func (e *ensurer) EnsureCloudProviderSecret(ctx context.Context, gctx gcontext.G
 	}
 
 	e.logger.V(5).Info("mutate cloudprovider secret", "namespace", newSecret.Namespace, "name", newSecret.Name)
-	newSecret.Data[aws.SharedCredentialsFile] = []byte("[default]\n" +
-		fmt.Sprintf("aws_access_key_id=%s\n", string(newSecret.Data[aws.AccessKeyID])) +
-		fmt.Sprintf("aws_secret_access_key=%s\n", string(newSecret.Data[aws.SecretAccessKey])) +
-		fmt.Sprintf("region=%s", cluster.Shoot.Spec.Region),
-	)
+	var chain []apisaws.AssumeRole
+	if data, ok := newSecret.Data[aws.AssumeRoleChain]; ok {
+		if chain, err = helper.AssumeRoleChainFromBytes(data); err != nil {
+			return fmt.Errorf("could not decode %q as list of roles: %w", aws.AssumeRoleChain, err)
+		}
+	}
+	newSecret.Data[aws.SharedCredentialsFile] = sharedCredentialsFile([]string{
+		fmt.Sprintf("aws_access_key_id=%s", string(newSecret.Data[aws.AccessKeyID])),
+		fmt.Sprintf("aws_secret_access_key=%s", string(newSecret.Data[aws.SecretAccessKey])),
+	}, chain, cluster.Shoot.Spec.Region)
 
 	return nil
 }
+
+// sharedCredentialsFile returns a shared credentials file whose default profile uses the given credentials. If a role
+// chain is given, the credentials are moved to a source profile and every role of the chain is assumed in a profile
+// sourced from the previous one, the last one being the default profile. Session policies cannot be expressed in the
+// shared credentials file and are only applied by the extension itself.
+func sharedCredentialsFile(credentials []string, chain []apisaws.AssumeRole, region string) []byte {
+	regionLine := fmt.Sprintf("region=%s", region)
+	if len(chain) == 0 {
+		return []byte("[default]\n" + strings.Join(slices.Concat(credentials, []string{regionLine}), "\n"))
+	}
+
+	sourceProfile := "gardener-source"
+	profiles := []string{fmt.Sprintf("[%s]\n%s", sourceProfile, strings.Join(slices.Concat(credentials, []string{regionLine}), "\n"))}
+	for i, role := range chain {
+		profile := fmt.Sprintf("gardener-role-%d", i)
+		if i == len(chain)-1 {
+			profile = "default"
+		}
+		lines := []string{
+			fmt.Sprintf("role_arn=%s", role.RoleARN),
+			fmt.Sprintf("source_profile=%s", sourceProfile),
+		}
+		if role.ExternalID != nil {
+			lines = append(lines, fmt.Sprintf("external_id=%s", *role.ExternalID))
+		}
+		if role.SessionName != nil {
+			lines = append(lines, fmt.Sprintf("role_session_name=%s", *role.SessionName))
+		}
+		if role.Duration != nil {
+			lines = append(lines, fmt.Sprintf("duration_seconds=%d", int(role.Duration.Seconds())))
+		}
+		lines = append(lines, regionLine)
+		profiles = append(profiles, fmt.Sprintf("[%s]\n%s", profile, strings.Join(lines, "\n")))
+		sourceProfile = profile
+	}
+	return []byte(strings.Join(profiles, "\n\n"))
+}
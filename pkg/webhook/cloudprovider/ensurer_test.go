@@ -155,5 +155,55 @@ role_arn=foo
 region=eu-west-1`),
 			}))
 		})
+
+		It("should add a profile for every role of the role chain of the WorkloadIdentityConfig", func() {
+			secret.Data = map[string][]byte{
+				"config": []byte(`
+apiVersion: aws.provider.extensions.gardener.cloud/v1alpha1
+kind: WorkloadIdentityConfig
+roleARN: "foo"
+assumeRoleChain:
+- roleARN: arn:aws:iam::111111111111:role/central
+  sessionName: gardener
+- roleARN: arn:aws:iam::222222222222:role/shoot
+  externalID: external-id
+  duration: 1h
+`)}
+			secret.Labels = map[string]string{"workloadidentity.security.gardener.cloud/provider": "aws"}
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, gctx, secret, nil)).To(Succeed())
+			Expect(string(secret.Data["credentialsFile"])).To(Equal(`[gardener-source]
+web_identity_token_file=/var/run/secrets/gardener.cloud/workload-identity/token
+role_arn=foo
+region=eu-west-1
+
+[gardener-role-0]
+role_arn=arn:aws:iam::111111111111:role/central
+source_profile=gardener-source
+role_session_name=gardener
+region=eu-west-1
+
+[default]
+role_arn=arn:aws:iam::222222222222:role/shoot
+source_profile=gardener-role-0
+external_id=external-id
+duration_seconds=3600
+region=eu-west-1`))
+		})
+
+		It("should add a profile for the role chain of static credentials", func() {
+			secret.Data[aws.AssumeRoleChain] = []byte(`[{"roleARN":"arn:aws:iam::222222222222:role/shoot","externalID":"external-id"}]`)
+
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, gctx, secret, nil)).To(Succeed())
+			Expect(string(secret.Data[aws.SharedCredentialsFile])).To(Equal(`[gardener-source]
+aws_access_key_id=access-key-id
+aws_secret_access_key=secret-access-key
+region=eu-west-1
+
+[default]
+role_arn=arn:aws:iam::222222222222:role/shoot
+source_profile=gardener-source
+external_id=external-id
+region=eu-west-1`))
+		})
 	})
 })
@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -38,14 +39,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-aws/imagevector"
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/utils"
 )
 
 const (
-	ecrCredentialConfigLocation = "/opt/gardener/ecr-credential-provider-config.json" // #nosec G101 -- No credential.
-	ecrCredentialBinLocation    = "/opt/bin/"                                         // #nosec G101 -- No credential.
+	ecrCredentialConfigLocation    = "/opt/gardener/ecr-credential-provider-config.json"    // #nosec G101 -- No credential.
+	ecrCredentialAWSConfigLocation = "/opt/gardener/ecr-credential-provider-aws-config.ini" // #nosec G101 -- No credential.
+	ecrCredentialBinLocation       = "/opt/bin/"                                            // #nosec G101 -- No credential.
+	ecrCredentialProviderName      = "ecr-credential-provider"                              // #nosec G101 -- No credential.
 )
 
 // NewEnsurer creates a new controlplane ensurer.
@@ -534,17 +538,24 @@ func (e *ensurer) credentialProviderBinaryFile(k8sVersion string) (*extensionsv1
 	return config, nil
 }
 
-func (e *ensurer) credentialProviderConfigFile() (*extensionsv1alpha1.File, error) {
+// credentialProviderConfigFiles returns the CredentialProviderConfig of the kubelet. Registries which require assuming
+// a role get a dedicated provider, as the kubelet passes the role only via the environment of the provider executable.
+// The kubelet requires unique provider names matching the executable names, hence such providers are invoked via
+// wrapper scripts calling the ecr-credential-provider binary.
+func (e *ensurer) credentialProviderConfigFiles(ecr *apisaws.ECRConfig) ([]extensionsv1alpha1.File, error) {
 	var (
-		permissions uint32 = 0755
+		permissions   uint32 = 0755
+		cacheDuration        = &metav1.Duration{Duration: time.Hour}
+		files         []extensionsv1alpha1.File
+		awsConfig     strings.Builder
 	)
-	cacheDuration, err := time.ParseDuration("1h")
-	if err != nil {
-		return nil, err
+	if ecr != nil && ecr.CacheDuration != nil {
+		cacheDuration = ecr.CacheDuration
 	}
+
 	credentialProvider := kubeletconfigv1.CredentialProvider{
 		APIVersion: "credentialprovider.kubelet.k8s.io/v1",
-		Name:       "ecr-credential-provider",
+		Name:       ecrCredentialProviderName,
 		// The hardcoded list is generated from the set between the official documentation and some kubernetes files:
 		// https://cloud-provider-aws.sigs.k8s.io/credential_provider/
 		// https://github.com/kubernetes/kubernetes/blob/master/test/e2e_node/remote/utils.go#L65
@@ -554,23 +565,57 @@ func (e *ensurer) credentialProviderConfigFile() (*extensionsv1alpha1.File, erro
 			"*.dkr.ecr-fips.*.amazonaws.com",
 			"*.dkr-ecr.*.on.aws",
 		},
-		DefaultCacheDuration: &metav1.Duration{Duration: cacheDuration},
+		DefaultCacheDuration: cacheDuration,
+	}
+	providers := []kubeletconfigv1.CredentialProvider{credentialProvider}
+
+	if ecr != nil {
+		for i, registry := range ecr.Registries {
+			if registry.RoleARN == nil {
+				providers[0].MatchImages = append(providers[0].MatchImages, registry.MatchImages...)
+				continue
+			}
+
+			var (
+				name    = fmt.Sprintf("%s-%d", ecrCredentialProviderName, i)
+				profile = fmt.Sprintf("registry-%d", i)
+			)
+			fmt.Fprintf(&awsConfig, "[profile %s]\nrole_arn = %s\ncredential_source = Ec2InstanceMetadata\n\n", profile, *registry.RoleARN)
+			providers = append(providers, kubeletconfigv1.CredentialProvider{
+				APIVersion:           credentialProvider.APIVersion,
+				Name:                 name,
+				MatchImages:          registry.MatchImages,
+				DefaultCacheDuration: cacheDuration,
+				Env: []kubeletconfigv1.ExecEnvVar{
+					{Name: "AWS_CONFIG_FILE", Value: ecrCredentialAWSConfigLocation},
+					{Name: "AWS_PROFILE", Value: profile},
+				},
+			})
+			files = append(files, extensionsv1alpha1.File{
+				Path:        ecrCredentialBinLocation + name,
+				Permissions: &permissions,
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Data: fmt.Sprintf("#!/bin/sh\nexec %s%s \"$@\"\n", ecrCredentialBinLocation, ecrCredentialProviderName),
+					},
+				},
+			})
+		}
 	}
+
 	config := kubeletconfigv1.CredentialProviderConfig{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: kubeletconfigv1.SchemeGroupVersion.String(),
 			Kind:       "CredentialProviderConfig",
 		},
-		Providers: []kubeletconfigv1.CredentialProvider{
-			credentialProvider,
-		},
+		Providers: providers,
 	}
 
 	configJson, err := json.Marshal(config)
 	if err != nil {
 		return nil, err
 	}
-	return &extensionsv1alpha1.File{
+	files = append(files, extensionsv1alpha1.File{
 		Path:        ecrCredentialConfigLocation,
 		Permissions: &permissions,
 		Content: extensionsv1alpha1.FileContent{
@@ -578,7 +623,21 @@ func (e *ensurer) credentialProviderConfigFile() (*extensionsv1alpha1.File, erro
 				Data: string(configJson),
 			},
 		},
-	}, nil
+	})
+
+	if awsConfig.Len() > 0 {
+		files = append(files, extensionsv1alpha1.File{
+			Path:        ecrCredentialAWSConfigLocation,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: awsConfig.String(),
+				},
+			},
+		})
+	}
+
+	return files, nil
 }
 
 func (e *ensurer) ensureMTUFiles() extensionsv1alpha1.File {
@@ -635,13 +694,15 @@ func (e *ensurer) EnsureAdditionalFiles(ctx context.Context, gctx gcontext.Garde
 		if err != nil {
 			return err
 		}
-		credConfig, err := e.credentialProviderConfigFile()
+		credConfigFiles, err := e.credentialProviderConfigFiles(infraConfig.ECR)
 		if err != nil {
 			return err
 		}
 
 		*newObj = extensionswebhook.EnsureFileWithPath(*newObj, *binConfig)
-		*newObj = extensionswebhook.EnsureFileWithPath(*newObj, *credConfig)
+		for _, file := range credConfigFiles {
+			*newObj = extensionswebhook.EnsureFileWithPath(*newObj, file)
+		}
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/coreos/go-systemd/v22/unit"
@@ -595,6 +596,55 @@ done
 				Expect(err).To(Not(HaveOccurred()))
 				Expect(files).To(ConsistOf(oldFile, additionalFile, ecrConfig, ecrBin))
 			})
+
+			Context("with additional registries", func() {
+				BeforeEach(func() {
+					infraConfig.EnableMTUCustomizer = ptr.To(false)
+					infraConfig.ECR = &v1alpha1.ECRConfig{
+						CacheDuration: &metav1.Duration{Duration: 6 * time.Hour},
+						Registries: []v1alpha1.ECRRegistry{
+							{MatchImages: []string{"registry.example.com"}},
+							{
+								MatchImages: []string{"123456789012.dkr.ecr.eu-west-1.amazonaws.com"},
+								RoleARN:     ptr.To("arn:aws:iam::123456789012:role/ecr-pull"),
+							},
+						},
+					}
+				})
+
+				It("should render the registries into the credential provider config", func() {
+					files := []extensionsv1alpha1.File{}
+
+					ensurer := NewEnsurer(logger, c)
+					Expect(ensurer.EnsureAdditionalFiles(ctx, eContextK8s130, &files, nil)).To(Succeed())
+
+					Expect(files).To(ContainElements(
+						extensionsv1alpha1.File{
+							Path:        "/opt/gardener/ecr-credential-provider-config.json",
+							Permissions: ptr.To(uint32(0755)),
+							Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{
+								Data: `{"kind":"CredentialProviderConfig","apiVersion":"kubelet.config.k8s.io/v1","providers":[` +
+									`{"name":"ecr-credential-provider","matchImages":["*.dkr.ecr.*.amazonaws.com","*.dkr.ecr.*.amazonaws.com.cn","*.dkr.ecr-fips.*.amazonaws.com","*.dkr-ecr.*.on.aws","registry.example.com"],"defaultCacheDuration":"6h0m0s","apiVersion":"credentialprovider.kubelet.k8s.io/v1"},` +
+									`{"name":"ecr-credential-provider-1","matchImages":["123456789012.dkr.ecr.eu-west-1.amazonaws.com"],"defaultCacheDuration":"6h0m0s","apiVersion":"credentialprovider.kubelet.k8s.io/v1","env":[{"name":"AWS_CONFIG_FILE","value":"/opt/gardener/ecr-credential-provider-aws-config.ini"},{"name":"AWS_PROFILE","value":"registry-1"}]}]}`,
+							}},
+						},
+						extensionsv1alpha1.File{
+							Path:        "/opt/gardener/ecr-credential-provider-aws-config.ini",
+							Permissions: ptr.To(uint32(0644)),
+							Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{
+								Data: "[profile registry-1]\nrole_arn = arn:aws:iam::123456789012:role/ecr-pull\ncredential_source = Ec2InstanceMetadata\n\n",
+							}},
+						},
+						extensionsv1alpha1.File{
+							Path:        "/opt/bin/ecr-credential-provider-1",
+							Permissions: ptr.To(uint32(0755)),
+							Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{
+								Data: "#!/bin/sh\nexec /opt/bin/ecr-credential-provider \"$@\"\n",
+							}},
+						},
+					))
+				})
+			})
 		})
 
 		Context("ECRAccess is disabled", func() {
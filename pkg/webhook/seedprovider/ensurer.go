@@ -6,13 +6,17 @@ package seedprovider
 
 import (
 	"context"
+	"slices"
 
 	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 )
@@ -32,36 +36,91 @@ type ensurer struct {
 }
 
 // EnsureETCD ensures that the etcd conform to the provider requirements.
-func (e *ensurer) EnsureETCD(_ context.Context, _ gcontext.GardenContext, newObj, oldObj *druidcorev1alpha1.Etcd) error {
+func (e *ensurer) EnsureETCD(ctx context.Context, gctx gcontext.GardenContext, newObj, oldObj *druidcorev1alpha1.Etcd) error {
+	// ensure the storage of old Etcds is not changed, the storage class of their volume claim template is immutable and
+	// etcd-druid does not expand the volumes of existing etcd-main members
+	if oldObj != nil && oldObj.Name == v1beta1constants.ETCDMain && e.etcdStorage != nil {
+		newObj.Spec.StorageClass = oldObj.Spec.StorageClass
+		newObj.Spec.StorageCapacity = oldObj.Spec.StorageCapacity
+		return nil
+	}
+
 	capacity := resource.MustParse("10Gi")
 	class := ""
 
 	// for newly created Etcds
 	if newObj.Name == v1beta1constants.ETCDMain && e.etcdStorage != nil {
-		if e.etcdStorage.Capacity != nil {
-			capacity = *e.etcdStorage.Capacity
+		storageClass, storageCapacity := e.storageForShoot(ctx, gctx)
+		if storageCapacity != nil {
+			capacity = *storageCapacity
 		}
-		if e.etcdStorage.ClassName != nil {
-			class = *e.etcdStorage.ClassName
+		if storageClass != nil {
+			class = *storageClass
 		}
 	}
 
-	// ensure old Etcds which are created are not resized
-	if oldObj != nil && oldObj.Name == v1beta1constants.ETCDMain && e.etcdStorage != nil {
-		if oldObj.Spec.StorageClass != nil {
-			class = *oldObj.Spec.StorageClass
-		} else if e.etcdStorage.ClassName != nil {
-			class = *e.etcdStorage.ClassName
+	newObj.Spec.StorageClass = &class
+	newObj.Spec.StorageCapacity = &capacity
+
+	return nil
+}
+
+// storageForShoot returns the storage class and capacity for the etcd-main of the shoot, taking the first matching
+// override into account. The global configuration is used if the cluster cannot be read or an override is invalid.
+func (e *ensurer) storageForShoot(ctx context.Context, gctx gcontext.GardenContext) (*string, *resource.Quantity) {
+	class, capacity := e.etcdStorage.ClassName, e.etcdStorage.Capacity
+	if len(e.etcdStorage.Overrides) == 0 {
+		return class, capacity
+	}
+
+	cluster, err := gctx.GetCluster(ctx)
+	if err != nil {
+		e.logger.Error(err, "Could not get cluster, using the default etcd storage")
+		return class, capacity
+	}
+
+	for i, override := range e.etcdStorage.Overrides {
+		matches, err := overrideMatches(override, cluster)
+		if err != nil {
+			e.logger.Error(err, "Invalid etcd storage override, skipping it", "index", i)
+			continue
+		}
+		if !matches {
+			continue
 		}
-		if oldObj.Spec.StorageCapacity != nil {
-			capacity = *oldObj.Spec.StorageCapacity
-		} else if e.etcdStorage.Capacity != nil {
-			capacity = *e.etcdStorage.Capacity
+
+		if override.ClassName != nil {
+			class = override.ClassName
+		}
+		if override.Capacity != nil {
+			capacity = override.Capacity
 		}
+		break
 	}
 
-	newObj.Spec.StorageClass = &class
-	newObj.Spec.StorageCapacity = &capacity
+	return class, capacity
+}
 
-	return nil
+func overrideMatches(override config.ETCDStorageOverride, cluster *extensionscontroller.Cluster) (bool, error) {
+	if cluster == nil || cluster.Shoot == nil {
+		return false, nil
+	}
+
+	if len(override.Purposes) > 0 {
+		if cluster.Shoot.Spec.Purpose == nil || !slices.Contains(override.Purposes, string(*cluster.Shoot.Spec.Purpose)) {
+			return false, nil
+		}
+	}
+
+	if override.ShootSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(override.ShootSelector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(cluster.Shoot.Labels)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
 }
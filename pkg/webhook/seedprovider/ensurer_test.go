@@ -9,13 +9,16 @@ import (
 	"testing"
 
 	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 )
@@ -82,6 +85,7 @@ var _ = Describe("Ensurer", func() {
 				oldEtcd = &druidcorev1alpha1.Etcd{
 					ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain},
 					Spec: druidcorev1alpha1.EtcdSpec{
+						StorageClass:    ptr.To("gardener.cloud-fast"),
 						StorageCapacity: &rOld,
 					},
 				}
@@ -109,6 +113,7 @@ var _ = Describe("Ensurer", func() {
 				oldEtcd = &druidcorev1alpha1.Etcd{
 					ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain},
 					Spec: druidcorev1alpha1.EtcdSpec{
+						StorageClass:    ptr.To("gardener.cloud-fast"),
 						StorageCapacity: &rOld,
 					},
 				}
@@ -130,6 +135,137 @@ var _ = Describe("Ensurer", func() {
 			checkNewETCDMain(modifiedEtcd)
 		})
 
+		It("should not expand the volume of an old etcd-main when the configured capacity grows", func() {
+			var (
+				oldEtcd = &druidcorev1alpha1.Etcd{
+					ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain},
+					Spec: druidcorev1alpha1.EtcdSpec{
+						StorageClass:    ptr.To("gardener.cloud-fast"),
+						StorageCapacity: ptr.To(resource.MustParse("10Gi")),
+					},
+				}
+				modifiedEtcd = oldEtcd.DeepCopy()
+			)
+
+			ensurer := NewEnsurer(etcdStorage, logger)
+
+			Expect(ensurer.EnsureETCD(ctx, dummyContext, modifiedEtcd, oldEtcd)).To(Succeed())
+			Expect(modifiedEtcd.Spec).To(Equal(oldEtcd.Spec))
+		})
+
+		It("should keep an unset storage configuration of an old etcd-main", func() {
+			var (
+				oldEtcd      = &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}
+				modifiedEtcd = &druidcorev1alpha1.Etcd{
+					ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain},
+					Spec: druidcorev1alpha1.EtcdSpec{
+						StorageClass:    ptr.To("other"),
+						StorageCapacity: ptr.To(resource.MustParse("10Gi")),
+					},
+				}
+			)
+
+			ensurer := NewEnsurer(etcdStorage, logger)
+
+			Expect(ensurer.EnsureETCD(ctx, dummyContext, modifiedEtcd, oldEtcd)).To(Succeed())
+			Expect(modifiedEtcd.Spec.StorageClass).To(BeNil())
+			Expect(modifiedEtcd.Spec.StorageCapacity).To(BeNil())
+		})
+
+		Context("overrides", func() {
+			var (
+				cluster            *extensionscontroller.Cluster
+				overrideEtcdConfig *config.ETCDStorage
+			)
+
+			BeforeEach(func() {
+				cluster = &extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"etcd": "io2"}},
+						Spec:       gardencorev1beta1.ShootSpec{Purpose: ptr.To(gardencorev1beta1.ShootPurposeProduction)},
+					},
+				}
+				overrideEtcdConfig = etcdStorage.DeepCopy()
+				overrideEtcdConfig.Overrides = []config.ETCDStorageOverride{
+					{
+						ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"etcd": "gp3"}},
+						ClassName:     ptr.To("gardener.cloud-gp3"),
+					},
+					{
+						Purposes:  []string{"production"},
+						ClassName: ptr.To("gardener.cloud-io2"),
+						Capacity:  ptr.To(resource.MustParse("50Gi")),
+					},
+				}
+			})
+
+			It("should use the first matching override for a new etcd-main", func() {
+				etcd := &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}
+
+				ensurer := NewEnsurer(overrideEtcdConfig, logger)
+
+				Expect(ensurer.EnsureETCD(ctx, gcontext.NewInternalGardenContext(cluster), etcd, nil)).To(Succeed())
+				Expect(*etcd.Spec.StorageClass).To(Equal("gardener.cloud-io2"))
+				Expect(*etcd.Spec.StorageCapacity).To(Equal(resource.MustParse("50Gi")))
+			})
+
+			It("should take unset fields from the global configuration", func() {
+				cluster.Shoot.Labels["etcd"] = "gp3"
+				etcd := &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}
+
+				ensurer := NewEnsurer(overrideEtcdConfig, logger)
+
+				Expect(ensurer.EnsureETCD(ctx, gcontext.NewInternalGardenContext(cluster), etcd, nil)).To(Succeed())
+				Expect(*etcd.Spec.StorageClass).To(Equal("gardener.cloud-gp3"))
+				Expect(*etcd.Spec.StorageCapacity).To(Equal(resource.MustParse("25Gi")))
+			})
+
+			It("should use the global configuration if no override matches", func() {
+				cluster.Shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeEvaluation)
+				etcd := &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}
+
+				ensurer := NewEnsurer(overrideEtcdConfig, logger)
+
+				Expect(ensurer.EnsureETCD(ctx, gcontext.NewInternalGardenContext(cluster), etcd, nil)).To(Succeed())
+				checkNewETCDMain(etcd)
+			})
+
+			It("should not apply an override to an old etcd-main", func() {
+				oldEtcd := &druidcorev1alpha1.Etcd{
+					ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain},
+					Spec: druidcorev1alpha1.EtcdSpec{
+						StorageClass:    ptr.To("gardener.cloud-fast"),
+						StorageCapacity: ptr.To(resource.MustParse("25Gi")),
+					},
+				}
+				modifiedEtcd := oldEtcd.DeepCopy()
+
+				ensurer := NewEnsurer(overrideEtcdConfig, logger)
+
+				Expect(ensurer.EnsureETCD(ctx, gcontext.NewInternalGardenContext(cluster), modifiedEtcd, oldEtcd)).To(Succeed())
+				checkNewETCDMain(modifiedEtcd)
+			})
+
+			It("should skip an invalid override", func() {
+				overrideEtcdConfig.Overrides[0].ShootSelector.MatchLabels = map[string]string{"etcd": "not valid"}
+				etcd := &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}
+
+				ensurer := NewEnsurer(overrideEtcdConfig, logger)
+
+				Expect(ensurer.EnsureETCD(ctx, gcontext.NewInternalGardenContext(cluster), etcd, nil)).To(Succeed())
+				Expect(*etcd.Spec.StorageClass).To(Equal("gardener.cloud-io2"))
+			})
+
+			It("should use the global configuration if the cluster cannot be read", func() {
+				etcd := &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}
+
+				ensurer := NewEnsurer(overrideEtcdConfig, logger)
+
+				Expect(ensurer.EnsureETCD(ctx, gcontext.NewGardenContext(fakeclient.NewClientBuilder().Build(), etcd), etcd, nil)).To(Succeed())
+				checkNewETCDMain(etcd)
+			})
+		})
+
 		It("should add an etcd-events storage configuration when etcd-events is new and does not specify a storage configuration", func() {
 			var (
 				etcd = &druidcorev1alpha1.Etcd{
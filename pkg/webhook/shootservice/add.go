@@ -36,7 +36,7 @@ func AddToManagerWithOptions(mgr manager.Manager, _ AddOptions) (*extensionswebh
 		Types: []extensionswebhook.Type{
 			{Obj: &corev1.Service{}},
 		},
-		Mutator: NewMutatorWithShootClient(logger),
+		Mutator: NewMutatorWithServiceDefaults(mgr.GetClient(), logger),
 		ObjectSelector: &metav1.LabelSelector{
 			MatchExpressions: []metav1.LabelSelectorRequirement{
 				{
@@ -17,9 +17,16 @@ package shootservice
 import (
 	"context"
 	"fmt"
+	"maps"
+	"net"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,18 +34,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 )
 
 // Annotation and value constants moved to pkg/apis/aws/const.go
 
+// minNLBTCPIdleTimeoutSeconds is the minimum TCP idle timeout of network load balancers.
+const minNLBTCPIdleTimeoutSeconds = 60
+
 type mutator struct {
+	client           client.Client
 	logger           logr.Logger
 	wantsShootClient bool
 }
 
 // NewMutatorWithShootClient creates a new Mutator that mutates resources in the shoot cluster.
 func NewMutatorWithShootClient(logger logr.Logger) extensionswebhook.Mutator {
-	return &mutator{logger, true}
+	return &mutator{logger: logger, wantsShootClient: true}
+}
+
+// NewMutatorWithServiceDefaults creates a new Mutator that mutates resources in the shoot cluster and additionally
+// applies the Service load balancer defaults of the shoot's ControlPlaneConfig to new Services. The Cluster is read
+// with the given (cached) seed client only for new Services of type LoadBalancer.
+func NewMutatorWithServiceDefaults(client client.Client, logger logr.Logger) extensionswebhook.Mutator {
+	return &mutator{client: client, logger: logger, wantsShootClient: true}
 }
 
 // WantsShootClient indicates that this mutator wants the shoot client to be injected into the context.
@@ -65,6 +84,78 @@ func (m *mutator) Mutate(ctx context.Context, newObj, oldObj client.Object) erro
 		return nil
 	}
 
+	var defaults *aws.ServiceLoadBalancerDefaults
+	if m.client != nil && oldObj == nil {
+		var err error
+		if defaults, err = m.serviceLoadBalancerDefaults(ctx); err != nil {
+			return err
+		}
+		if defaults != nil {
+			applyServiceLoadBalancerDefaults(service, defaults)
+		}
+	}
+
+	if err := m.mutateDualStack(ctx, log, service, oldObj); err != nil {
+		return err
+	}
+
+	// the idle timeout is applied last, as its annotation depends on the type of the load balancer, which might have
+	// been changed by the dual-stack mutation
+	if defaults != nil && defaults.IdleTimeout != nil {
+		applyIdleTimeoutDefault(service, defaults.IdleTimeout.Duration)
+	}
+	return nil
+}
+
+// serviceLoadBalancerDefaults returns the Service load balancer defaults of the ControlPlaneConfig of the shoot the
+// request comes from.
+func (m *mutator) serviceLoadBalancerDefaults(ctx context.Context) (*aws.ServiceLoadBalancerDefaults, error) {
+	namespace, err := m.shootNamespace(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not mutate: %w", err)
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, m.client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(cluster)
+	if err != nil || cpConfig == nil {
+		return nil, err
+	}
+	return cpConfig.ServiceLoadBalancerDefaults, nil
+}
+
+// shootNamespace determines the namespace of the shoot the request comes from by the kube-apiserver pod with the
+// remote address of the request, like it is done for the injected shoot client.
+func (m *mutator) shootNamespace(ctx context.Context) (string, error) {
+	remoteAddr, ok := ctx.Value(extensionswebhook.RemoteAddrContextKey{}).(string)
+	if !ok {
+		return "", fmt.Errorf("no remote address found in context")
+	}
+	ip, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return "", fmt.Errorf("remote address %s not parseable: %w", remoteAddr, err)
+	}
+
+	podList := &corev1.PodList{}
+	if err := m.client.List(ctx, podList, client.MatchingLabels{
+		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+		v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer,
+	}); err != nil {
+		return "", fmt.Errorf("failed listing kube-apiserver pods: %w", err)
+	}
+	for _, pod := range podList.Items {
+		if pod.Status.PodIP == ip {
+			return pod.Namespace, nil
+		}
+	}
+	return "", fmt.Errorf("could not find shoot namespace for remote address %s", remoteAddr)
+}
+
+// mutateDualStack sets the annotations for dual-stack network load balancers if the shoot has an IPv6 network.
+func (m *mutator) mutateDualStack(ctx context.Context, log logr.Logger, service *corev1.Service, oldObj client.Object) error {
 	shootClient, ok := ctx.Value(extensionswebhook.ShootClientContextKey{}).(client.Client)
 	if !ok {
 		return fmt.Errorf("could not mutate: no shoot client found in context")
@@ -82,11 +173,13 @@ func (m *mutator) Mutate(ctx context.Context, newObj, oldObj client.Object) erro
 	}
 
 	// For existing services, check if we should add the ignore annotation
+	var wasDualStack bool
 	if oldObj != nil {
 		oldService, ok := oldObj.(*corev1.Service)
 		if !ok {
 			return fmt.Errorf("oldObj is not of type corev1.Service")
 		}
+		wasDualStack = oldService.Annotations[aws.AnnotationAWSLBIPType] == aws.ValueDualStack
 
 		hasIgnoreAnnotation := metav1.HasAnnotation(service.ObjectMeta, aws.AnnotationIgnoreLoadBalancer) &&
 			service.Annotations[aws.AnnotationIgnoreLoadBalancer] == aws.ValueTrue
@@ -117,9 +210,12 @@ func (m *mutator) Mutate(ctx context.Context, newObj, oldObj client.Object) erro
 		return nil
 	}
 
-	// Preserve the old load balancer hostname for manual cleanup
-	if len(service.Status.LoadBalancer.Ingress) > 0 && service.Status.LoadBalancer.Ingress[0].Hostname != "" {
-		metav1.SetMetaDataAnnotation(&service.ObjectMeta, "gardener.cloud/old-load-balancer-name", service.Status.LoadBalancer.Ingress[0].Hostname)
+	// Preserve the hostname of the load balancer provisioned before the migration, it is deleted by the load balancer
+	// cleanup controller once it is no longer referenced. Once migrated, the hostname in the status belongs to the new
+	// load balancer and must not be recorded.
+	if !wasDualStack && !metav1.HasAnnotation(service.ObjectMeta, aws.AnnotationOldLoadBalancerName) &&
+		len(service.Status.LoadBalancer.Ingress) > 0 && service.Status.LoadBalancer.Ingress[0].Hostname != "" {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, aws.AnnotationOldLoadBalancerName, service.Status.LoadBalancer.Ingress[0].Hostname)
 	}
 
 	log.Info("Setting dualstack annotations for IPv6-enabled cluster")
@@ -130,3 +226,64 @@ func (m *mutator) Mutate(ctx context.Context, newObj, oldObj client.Object) erro
 
 	return nil
 }
+
+// applyServiceLoadBalancerDefaults sets the annotations for the given defaults unless they are already present on the
+// Service, i.e. explicit settings of the Service always take precedence.
+func applyServiceLoadBalancerDefaults(service *corev1.Service, defaults *aws.ServiceLoadBalancerDefaults) {
+	annotations := map[string]string{}
+
+	if defaults.Internal != nil && *defaults.Internal &&
+		!metav1.HasAnnotation(service.ObjectMeta, aws.AnnotationAWSLBScheme) {
+		annotations[aws.AnnotationAWSLBInternal] = aws.ValueTrue
+	}
+	if len(defaults.SourceRanges) > 0 && len(service.Spec.LoadBalancerSourceRanges) == 0 {
+		annotations[aws.AnnotationLBSourceRanges] = strings.Join(defaults.SourceRanges, ",")
+	}
+	if defaults.AccessLogs != nil && !metav1.HasAnnotation(service.ObjectMeta, aws.AnnotationAWSLBAccessLogEnabled) {
+		annotations[aws.AnnotationAWSLBAccessLogEnabled] = aws.ValueTrue
+		annotations[aws.AnnotationAWSLBAccessLogS3BucketName] = defaults.AccessLogs.BucketName
+		if defaults.AccessLogs.BucketPrefix != nil {
+			annotations[aws.AnnotationAWSLBAccessLogS3BucketPrefix] = *defaults.AccessLogs.BucketPrefix
+		}
+	}
+	if defaults.CrossZoneLoadBalancing != nil {
+		annotations[aws.AnnotationAWSLBCrossZoneLoadBalancingEnabled] = strconv.FormatBool(*defaults.CrossZoneLoadBalancing)
+	}
+	if len(defaults.AdditionalTags) > 0 {
+		tags := make([]string, 0, len(defaults.AdditionalTags))
+		for _, key := range slices.Sorted(maps.Keys(defaults.AdditionalTags)) {
+			tags = append(tags, key+"="+defaults.AdditionalTags[key])
+		}
+		annotations[aws.AnnotationAWSLBAdditionalResourceTags] = strings.Join(tags, ",")
+	}
+
+	for key, value := range annotations {
+		if !metav1.HasAnnotation(service.ObjectMeta, key) {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, key, value)
+		}
+	}
+}
+
+// applyIdleTimeoutDefault sets the idle timeout annotation matching the type of the load balancer unless it is
+// already present on the Service. Classic load balancers have a connection idle timeout, the network load balancers of
+// the AWS Load Balancer Controller a TCP idle timeout per listener, which must be at least 60s. The idle timeout of the
+// network load balancers of the cloud controller manager cannot be configured.
+func applyIdleTimeoutDefault(service *corev1.Service, idleTimeout time.Duration) {
+	switch service.Annotations[aws.AnnotationAWSLBType] {
+	case "":
+		if !metav1.HasAnnotation(service.ObjectMeta, aws.AnnotationAWSLBConnectionIdleTimeout) {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, aws.AnnotationAWSLBConnectionIdleTimeout, strconv.Itoa(int(idleTimeout.Seconds())))
+		}
+	case aws.ValueExternal:
+		seconds := max(int(idleTimeout.Seconds()), minNLBTCPIdleTimeoutSeconds)
+		for _, port := range service.Spec.Ports {
+			if port.Protocol != corev1.ProtocolTCP && port.Protocol != "" {
+				continue
+			}
+			key := fmt.Sprintf("%s.TCP-%d", aws.AnnotationAWSLBListenerAttributes, port.Port)
+			if !metav1.HasAnnotation(service.ObjectMeta, key) {
+				metav1.SetMetaDataAnnotation(&service.ObjectMeta, key, fmt.Sprintf("tcp.idle_timeout.seconds=%d", seconds))
+			}
+		}
+	}
+}
@@ -6,15 +6,24 @@ package shootservice
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
 )
 
 var _ = Describe("Mutator", func() {
@@ -191,6 +200,39 @@ var _ = Describe("Mutator", func() {
 			Expect(newService.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-type", "external"))
 		})
 
+		It("should record the hostname of the superseded load balancer", func() {
+			oldService := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-lb",
+					Namespace:   metav1.NamespaceSystem,
+					Annotations: map[string]string{"extensions.gardener.cloud/ignore-load-balancer": "true"},
+				},
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			}
+			newService := oldService.DeepCopy()
+			newService.Annotations = map[string]string{}
+			newService.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "old.elb.amazonaws.com"}}
+
+			Expect(mutator.Mutate(ctxWithClient, newService, oldService)).To(Succeed())
+			Expect(newService.Annotations).To(HaveKeyWithValue("gardener.cloud/old-load-balancer-name", "old.elb.amazonaws.com"))
+		})
+
+		It("should not record the hostname of the load balancer of an already migrated service", func() {
+			oldService := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-lb",
+					Namespace:   metav1.NamespaceSystem,
+					Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-ip-address-type": "dualstack"},
+				},
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			}
+			newService := oldService.DeepCopy()
+			newService.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "new.elb.amazonaws.com"}}
+
+			Expect(mutator.Mutate(ctxWithClient, newService, oldService)).To(Succeed())
+			Expect(newService.Annotations).ToNot(HaveKey("gardener.cloud/old-load-balancer-name"))
+		})
+
 		It("should skip mutation when ignore annotation is still present", func() {
 			oldService := &corev1.Service{
 				ObjectMeta: metav1.ObjectMeta{
@@ -218,4 +260,165 @@ var _ = Describe("Mutator", func() {
 			Expect(newService.Annotations).ToNot(HaveKey("service.beta.kubernetes.io/aws-load-balancer-ip-address-type"))
 		})
 	})
+
+	Context("Service load balancer defaults", func() {
+		var (
+			defaultsMutator extensionswebhook.Mutator
+			ctxWithCluster  context.Context
+			service         *corev1.Service
+		)
+
+		BeforeEach(func() {
+			Expect(fakeShootClient.Patch(context.TODO(), &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"},
+				Spec: corev1.ServiceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				},
+			}, client.MergeFrom(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"}}))).To(Succeed())
+
+			cpConfig, err := json.Marshal(&awsv1alpha1.ControlPlaneConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "ControlPlaneConfig",
+				},
+				ServiceLoadBalancerDefaults: &awsv1alpha1.ServiceLoadBalancerDefaults{
+					Internal:     ptr.To(true),
+					SourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+					AccessLogs: &awsv1alpha1.LoadBalancerAccessLogs{
+						BucketName:   "access-logs",
+						BucketPrefix: ptr.To("shoot"),
+					},
+					CrossZoneLoadBalancing: ptr.To(true),
+					IdleTimeout:            &metav1.Duration{Duration: 2 * time.Minute},
+					AdditionalTags: map[string]string{
+						"team":        "platform",
+						"cost-center": "42",
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			shoot, err := json.Marshal(&gardencorev1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+					Kind:       "Shoot",
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						ControlPlaneConfig: &runtime.RawExtension{Raw: cpConfig},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			seedClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kube-apiserver",
+						Namespace: "shoot--foo--bar",
+						Labels:    map[string]string{"app": "kubernetes", "role": "apiserver"},
+					},
+					Status: corev1.PodStatus{PodIP: "10.0.0.1"},
+				},
+				&extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+					Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: shoot}},
+				},
+			).Build()
+
+			ctxWithCluster = context.WithValue(ctxWithClient, extensionswebhook.RemoteAddrContextKey{}, "10.0.0.1:54321")
+			defaultsMutator = NewMutatorWithServiceDefaults(seedClient, logr.Discard())
+			service = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-lb", Namespace: "default"},
+				Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			}
+		})
+
+		It("should apply the defaults to new services", func() {
+			Expect(defaultsMutator.Mutate(ctxWithCluster, service, nil)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal":                          "true",
+				"service.beta.kubernetes.io/load-balancer-source-ranges":                         "10.0.0.0/8,192.168.0.0/16",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":                "true",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name":         "access-logs",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-prefix":       "shoot",
+				"service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout":           "120",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":          "cost-center=42,team=platform",
+			}))
+		})
+
+		It("should not overwrite explicitly set annotations", func() {
+			service.Annotations = map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                  "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":      "false",
+				"service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout": "60",
+			}
+			service.Spec.LoadBalancerSourceRanges = []string{"0.0.0.0/0"}
+
+			Expect(defaultsMutator.Mutate(ctxWithCluster, service, nil)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                            "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":                "false",
+				"service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout":           "60",
+				"service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":          "cost-center=42,team=platform",
+			}))
+		})
+
+		It("should set the TCP idle timeout of the listeners of network load balancers of the AWS Load Balancer Controller", func() {
+			service.Annotations = map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":                         "external",
+				"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-8443": "tcp.idle_timeout.seconds=300",
+			}
+			service.Spec.Ports = []corev1.ServicePort{
+				{Port: 443, Protocol: corev1.ProtocolTCP},
+				{Port: 8443, Protocol: corev1.ProtocolTCP},
+				{Port: 53, Protocol: corev1.ProtocolUDP},
+			}
+
+			Expect(defaultsMutator.Mutate(ctxWithCluster, service, nil)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-443", "tcp.idle_timeout.seconds=120"))
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-8443", "tcp.idle_timeout.seconds=300"))
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/aws-load-balancer-listener-attributes.UDP-53"))
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout"))
+		})
+
+		It("should set the TCP idle timeout for network load balancers of dual-stack shoots", func() {
+			Expect(fakeShootClient.Patch(context.TODO(), &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"},
+				Spec: corev1.ServiceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				},
+			}, client.MergeFrom(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"}}))).To(Succeed())
+			service.Annotations = map[string]string{"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing"}
+			service.Spec.Ports = []corev1.ServicePort{{Port: 443}}
+
+			Expect(defaultsMutator.Mutate(ctxWithCluster, service, nil)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-type", "external"))
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-443", "tcp.idle_timeout.seconds=120"))
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout"))
+		})
+
+		It("should not set an idle timeout for network load balancers of the cloud controller manager", func() {
+			service.Annotations = map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "nlb"}
+			service.Spec.Ports = []corev1.ServicePort{{Port: 443, Protocol: corev1.ProtocolTCP}}
+
+			Expect(defaultsMutator.Mutate(ctxWithCluster, service, nil)).To(Succeed())
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout"))
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-443"))
+		})
+
+		It("should not apply the defaults to existing services", func() {
+			oldService := service.DeepCopy()
+			Expect(defaultsMutator.Mutate(ctxWithCluster, service, oldService)).To(Succeed())
+			Expect(service.Annotations).To(BeEmpty())
+		})
+
+		It("should return an error if the shoot namespace cannot be determined", func() {
+			Expect(defaultsMutator.Mutate(ctxWithClient, service, nil)).To(MatchError(ContainSubstring("no remote address found in context")))
+			ctxWithUnknownAddr := context.WithValue(ctxWithClient, extensionswebhook.RemoteAddrContextKey{}, "10.0.0.2:54321")
+			Expect(defaultsMutator.Mutate(ctxWithUnknownAddr, service, nil)).To(MatchError(ContainSubstring("could not find shoot namespace")))
+		})
+	})
 })